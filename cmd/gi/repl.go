@@ -30,6 +30,11 @@ func main() {
 	cfg.DefineFlags(myflags)
 
 	err := myflags.Parse(os.Args[1:])
+	if myflags.NArg() > 0 {
+		// gi script.go args...
+		cfg.ScriptPath = myflags.Arg(0)
+		cfg.ScriptArgs = myflags.Args()[1:]
+	}
 	err = cfg.ValidateConfig()
 	if err != nil {
 		log.Fatalf("%s command line flag error: '%s'", ProgramName, err)
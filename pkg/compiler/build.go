@@ -709,6 +709,9 @@ func (s *Session) BuildPackage(pkg *PackageData, depth int) (*Archive, error) {
 	//localImportPathCache := make(map[string]*Archive)
 	importContext := &ImportContext{
 		Packages: s.Types,
+
+		BinaryPackages: s.ic.CurPkg.importContext.BinaryPackages,
+		Sandbox:        s.ic.CurPkg.importContext.Sandbox,
 		Import: func(path, pkgDir string, depth int) (*Archive, error) {
 			pp("callback to Import() in ImportContext: path='%s', pkgDir='%s'", path, pkgDir)
 			//if s.AllowImportCaching? TODO figure out balance between speed and editability.
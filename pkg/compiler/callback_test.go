@@ -0,0 +1,157 @@
+package compiler
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test1616InterpretedClosuresAsGoFuncs(t *testing.T) {
+
+	cv.Convey("an interpreted closure passed to a host func parameter becomes a Go func of that type", t, func() {
+		in, err := NewInterpreter(nil)
+		panicOn(err)
+		defer in.Close()
+		ctx := context.Background()
+
+		panicOn(in.Register("sortInts", func(xs []int, less func(a, b int) bool) []int {
+			ys := append([]int{}, xs...)
+			sort.Slice(ys, func(i, j int) bool { return less(ys[i], ys[j]) })
+			return ys
+		}))
+		panicOn(in.RegisterPackage("strs", map[string]interface{}{
+			"Map":        strings.Map,
+			"FieldsFunc": strings.FieldsFunc,
+		}))
+		_, err = in.Eval(ctx, `import "strs"`)
+		panicOn(err)
+
+		_, err = in.Eval(ctx, `k := 2`)
+		panicOn(err)
+		res, err := in.Eval(ctx, `sortInts([]int{3, 1, 2}, func(a, b int) bool { return a*k > b*k })`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(res, cv.ShouldResemble, []interface{}{[]int{3, 2, 1}})
+
+		res, err = in.Eval(ctx, `strs.Map(func(r rune) rune { return r + 1 }, "abc")`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(res, cv.ShouldResemble, []interface{}{"bcd"})
+
+		res, err = in.Eval(ctx, `len(strs.FieldsFunc("a,b;c", func(r rune) bool { return r == ',' || r == ';' }))`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(fmt.Sprint(res[0]), cv.ShouldEqual, "3")
+	})
+
+	cv.Convey("the closure runs on the interpreter's thread, whichever goroutine calls it", t, func() {
+		in, err := NewInterpreter(nil)
+		panicOn(err)
+		defer in.Close()
+		ctx := context.Background()
+
+		panicOn(in.Register("fanOut", func(f func(int) int, n int) int {
+			var wg sync.WaitGroup
+			var mu sync.Mutex
+			tot := 0
+			for i := 0; i < n; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					r := f(i)
+					mu.Lock()
+					tot += r
+					mu.Unlock()
+				}(i)
+			}
+			wg.Wait()
+			return tot
+		}))
+		res, err := in.Eval(ctx, `fanOut(func(x int) int { return x * x }, 10)`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(res, cv.ShouldResemble, []interface{}{285})
+
+		// kept by the host, and called after the Eval returns.
+		var kept func(string) string
+		panicOn(in.Register("keep", func(f func(string) string) { kept = f }))
+		_, err = in.Eval(ctx, `keep(func(s string) string { return s + "!" })`)
+		panicOn(err)
+		cv.So(kept("hi"), cv.ShouldEqual, "hi!")
+	})
+
+	cv.Convey("panics carry across, from Go to the interpreter and back", t, func() {
+		in, err := NewInterpreter(nil)
+		panicOn(err)
+		defer in.Close()
+		ctx := context.Background()
+
+		panicOn(in.Register("boom", func() int { panic("native boom") }))
+		panicOn(in.Register("call", func(f func() int) int { return f() }))
+		panicOn(in.Register("safely", func(f func()) (msg string) {
+			defer func() {
+				if r := recover(); r != nil {
+					msg = fmt.Sprint(r)
+				}
+			}()
+			f()
+			return ""
+		}))
+
+		_, err = in.Eval(ctx, `boom()`)
+		cv.So(err, cv.ShouldNotBeNil)
+		cv.So(err.Error(), cv.ShouldContainSubstring, "native boom")
+
+		res, err := in.Eval(ctx, `safely(func() { panic("inner") })`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(res[0], cv.ShouldContainSubstring, "inner")
+
+		_, err = in.Eval(ctx, `call(func() int { panic("oops") })`)
+		cv.So(err, cv.ShouldNotBeNil)
+		cv.So(err.Error(), cv.ShouldContainSubstring, "oops")
+
+		// and the interpreter carries on.
+		res, err = in.Eval(ctx, `call(func() int { return 7 })`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(res, cv.ShouldResemble, []interface{}{7})
+	})
+	cv.Convey("a Go func made from a closure frees its Lua reference once it is garbage", t, func() {
+		in, err := NewInterpreter(nil)
+		panicOn(err)
+		defer in.Close()
+		ctx := context.Background()
+		panicOn(in.Register("call", func(f func() int) int { return f() }))
+
+		loop := `for i := 0; i < 2000; i++ { call(func() int { return i }) }`
+		// a freed reference leaves a number in its slot.
+		registrySize := `__gijit_regN = 0; for _, v in pairs(debug.getregistry()) do if type(v) == "function" then __gijit_regN = __gijit_regN + 1 end end`
+		// finalizers run when they will, so give them time.
+		settle := func(cond string) error {
+			var err error
+			for i := 0; i < 100; i++ {
+				runtime.GC()
+				time.Sleep(10 * time.Millisecond)
+				// the next conversion frees what the finalizers queued.
+				_, err = in.Eval(ctx, `call(func() int { return 0 })`)
+				panicOn(err)
+				err = LuaRun(in.lvm, registrySize+"; "+cond, false)
+				if err == nil {
+					break
+				}
+			}
+			return err
+		}
+
+		_, err = in.Eval(ctx, loop)
+		panicOn(err)
+		panicOn(settle(`__gijit_regN1 = __gijit_regN`))
+		for i := 0; i < 3; i++ {
+			_, err = in.Eval(ctx, loop)
+			panicOn(err)
+		}
+		cv.So(settle(`assert(__gijit_regN < __gijit_regN1 + 100, "functions in the registry grew from ".. __gijit_regN1 .." to ".. __gijit_regN)`), cv.ShouldBeNil)
+	})
+}
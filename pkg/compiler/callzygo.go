@@ -1,10 +1,18 @@
 package compiler
 
 import (
+	"sync"
+
 	"github.com/glycerine/zygomys/zygo"
 )
 
+// zygoInitMut serializes initZygo: setting up a Zlisp
+// also sets a zygo package variable.
+var zygoInitMut sync.Mutex
+
 func initZygo() *zygo.Zlisp {
+	zygoInitMut.Lock()
+	defer zygoInitMut.Unlock()
 	env := zygo.NewZlisp()
 	env.StandardSetup()
 	return env
@@ -0,0 +1,195 @@
+package compiler
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	golua "github.com/glycerine/golua/lua"
+	"github.com/glycerine/luar"
+)
+
+// The Go half of the bridge between interpreted
+// channels and native ones; prelude/reflect_goro.lua
+// is the Lua half. None of these block but
+// chanWait, which the scheduler calls only when it
+// has nothing else to run.
+
+func registerChanBridge(vm *golua.State) {
+	luar.Register(vm, "", luar.Map{
+		"__gijit_chanKey":     chanKey,
+		"__gijit_chanMake":    chanMake,
+		"__gijit_chanTrySend": chanTrySend,
+		"__gijit_chanTryRecv": chanTryRecv,
+		"__gijit_chanClose":   chanClose,
+		"__gijit_chanWait":    chanWait,
+	})
+}
+
+// luaChan gives the native channel proxied at idx.
+func luaChan(L *golua.State, idx int) (reflect.Value, bool) {
+	if L.Type(idx) != golua.LUA_TUSERDATA {
+		return reflect.Value{}, false
+	}
+	var x interface{}
+	if _, err := luar.LuaToGo(L, idx, &x); err != nil {
+		return reflect.Value{}, false
+	}
+	v := reflect.ValueOf(x)
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Chan || v.IsNil() {
+		return reflect.Value{}, false
+	}
+	return v, true
+}
+
+func mustLuaChan(L *golua.State, idx int) reflect.Value {
+	ch, ok := luaChan(L, idx)
+	if !ok {
+		L.RaiseError(fmt.Sprintf("not a native channel: %s", L.Typename(int(L.Type(idx)))))
+	}
+	return ch
+}
+
+// chanKey returns a key that is the same for every
+// proxy of the channel at 1, and its capacity; or
+// nothing, if it is not a native channel.
+func chanKey(L *golua.State) int {
+	ch, ok := luaChan(L, 1)
+	if !ok {
+		return 0
+	}
+	L.PushString(fmt.Sprintf("%x", ch.Pointer()))
+	L.PushInteger(int64(ch.Cap()))
+	return 2
+}
+
+// chanMake returns a new channel, with both
+// directions, of the element type of the channel
+// type t.
+func chanMake(t reflect.Type, capacity int) interface{} {
+	return reflect.MakeChan(reflect.ChanOf(reflect.BothDir, t.Elem()), capacity).Interface()
+}
+
+// luaToElem converts the Lua value at idx to the
+// element type of ch.
+func luaToElem(L *golua.State, idx int, ch reflect.Value) reflect.Value {
+	val := reflect.New(ch.Type().Elem())
+	if !L.IsNoneOrNil(idx) {
+		if _, err := luar.LuaToGo(L, idx, val.Interface()); err != nil {
+			L.RaiseError(fmt.Sprintf("cannot send on %v: %v", ch.Type(), err))
+		}
+	}
+	return val.Elem()
+}
+
+// trySend is ch.TrySend(v), reporting a closed
+// channel rather than panicking.
+func trySend(ch, v reflect.Value) (sent, closed bool) {
+	defer func() {
+		if recover() != nil {
+			sent, closed = false, true
+		}
+	}()
+	return ch.TrySend(v), false
+}
+
+// chanTrySend sends the value at 2 on the channel at
+// 1, if it can without blocking. It returns whether
+// it did, and whether the channel was closed.
+func chanTrySend(L *golua.State) int {
+	ch := mustLuaChan(L, 1)
+	sent, closed := trySend(ch, luaToElem(L, 2, ch))
+	L.PushBoolean(sent)
+	L.PushBoolean(closed)
+	return 2
+}
+
+// chanTryRecv receives from the channel at 1, if it
+// can without blocking. It returns whether it did,
+// then the value and ok, as a Go receive gives them.
+func chanTryRecv(L *golua.State) int {
+	ch := mustLuaChan(L, 1)
+	v, ok := ch.TryRecv()
+	if !v.IsValid() {
+		L.PushBoolean(false)
+		return 1
+	}
+	L.PushBoolean(true)
+	luar.GoToLuaProxy(L, v)
+	L.PushBoolean(ok)
+	return 3
+}
+
+// chanClose closes the channel at 1, and reports
+// false if it was closed already.
+func chanClose(L *golua.State) int {
+	ch := mustLuaChan(L, 1)
+	closed := func() (ok bool) {
+		defer func() {
+			if recover() != nil {
+				ok = false
+			}
+		}()
+		ch.Close()
+		return true
+	}()
+	L.PushBoolean(closed)
+	return 1
+}
+
+// chanWait waits, for at most the nanoseconds at 2,
+// until one of the operations at 1 can go, and does
+// it. Each is a table {ch, isSend, value}. It returns
+// the Lua index of the one that went, or 0 if none
+// did, then, for a receive, the value and ok.
+func chanWait(L *golua.State) int {
+	n := int(L.ObjLen(1))
+	cases := make([]reflect.SelectCase, 0, n+1)
+	for i := 1; i <= n; i++ {
+		L.RawGeti(1, i)
+		L.RawGeti(-1, 1)
+		ch := mustLuaChan(L, -1)
+		L.RawGeti(-2, 2)
+		isSend := L.ToBoolean(-1)
+		c := reflect.SelectCase{Dir: reflect.SelectRecv, Chan: ch}
+		if isSend {
+			L.RawGeti(-3, 3)
+			c.Dir = reflect.SelectSend
+			c.Send = luaToElem(L, -1, ch)
+			L.Pop(1)
+		}
+		L.Pop(3)
+		cases = append(cases, c)
+	}
+	timeout := time.NewTimer(time.Duration(L.ToNumber(2)))
+	defer timeout.Stop()
+	cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(timeout.C)})
+
+	chosen, v, ok, closed := selectCatching(cases)
+	if chosen == n || closed {
+		// out of time; or a channel closed under a
+		// send, which the next try will find.
+		L.PushInteger(0)
+		return 1
+	}
+	L.PushInteger(int64(chosen + 1))
+	if cases[chosen].Dir != reflect.SelectRecv {
+		return 1
+	}
+	luar.GoToLuaProxy(L, v)
+	L.PushBoolean(ok)
+	return 3
+}
+
+func selectCatching(cases []reflect.SelectCase) (chosen int, v reflect.Value, ok, closed bool) {
+	defer func() {
+		if recover() != nil {
+			closed = true
+		}
+	}()
+	chosen, v, ok = reflect.Select(cases)
+	return
+}
@@ -0,0 +1,221 @@
+package compiler
+
+import (
+	"context"
+	"testing"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+// pumpPkg is native code that starts goroutines of
+// its own on the channels it is given, or returns.
+var pumpPkg = map[string]interface{}{
+	"Produce": func(n int) <-chan int {
+		ch := make(chan int)
+		go func() {
+			for i := 1; i <= n; i++ {
+				ch <- i
+			}
+			close(ch)
+		}()
+		return ch
+	},
+	"Feed": func(ch chan<- int, n int) {
+		go func() {
+			for i := 1; i <= n; i++ {
+				ch <- i
+			}
+			close(ch)
+		}()
+	},
+	"Relay": func(in <-chan int) <-chan int {
+		out := make(chan int)
+		go func() {
+			for v := range in {
+				out <- 2 * v
+			}
+			close(out)
+		}()
+		return out
+	},
+	"Merge": func(a, b <-chan int) <-chan int {
+		out := make(chan int)
+		go func() {
+			for a != nil || b != nil {
+				select {
+				case v, ok := <-a:
+					if !ok {
+						a = nil
+						continue
+					}
+					out <- v
+				case v, ok := <-b:
+					if !ok {
+						b = nil
+						continue
+					}
+					out <- v
+				}
+			}
+			close(out)
+		}()
+		return out
+	},
+	"Hold": func(ch chan int) {},
+}
+
+func Test1623NativeAndInterpretedGoroutinesShareChannels(t *testing.T) {
+
+	cv.Convey("native goroutines and interpreted ones send, receive and select on each other's channels, and only the goroutine that waits is parked", t, func() {
+		in, err := NewInterpreter(nil)
+		panicOn(err)
+		defer in.Close()
+		ctx := context.Background()
+
+		panicOn(in.RegisterPackage("pump", pumpPkg))
+		_, err = in.Eval(ctx, `import "pump"`)
+		panicOn(err)
+
+		get := func(expr string) interface{} {
+			res, err := in.Eval(ctx, expr)
+			panicOn(err)
+			return res[0]
+		}
+
+		// a native channel: receive, and range to its
+		// close.
+		_, err = in.Eval(ctx, `
+p := pump.Produce(3)
+first, firstOk := <-p
+rest := 0
+for v := range p {
+	rest += v
+}
+_, lastOk := <-p`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(get(`first`), cv.ShouldEqual, 1)
+		cv.So(get(`firstOk`), cv.ShouldBeTrue)
+		cv.So(get(`rest`), cv.ShouldEqual, 5)
+		cv.So(get(`lastOk`), cv.ShouldBeFalse)
+
+		// the eval waits on the native goroutine, which
+		// waits on an interpreted one: were the thread
+		// blocked, neither would get anywhere.
+		_, err = in.Eval(ctx, `
+src := make(chan int)
+doubled := pump.Relay(src)
+go func() {
+	for i := 1; i <= 10; i++ {
+		src <- i
+	}
+	close(src)
+}()
+relayed := 0
+for v := range doubled {
+	relayed += v
+}`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(get(`relayed`), cv.ShouldEqual, 110)
+
+		// what an interpreted channel has buffered, and
+		// its close, go across with it.
+		_, err = in.Eval(ctx, `
+buf := make(chan int, 3)
+buf <- 7
+buf <- 8
+fromBuf := pump.Relay(buf)
+close(buf)
+b1 := <-fromBuf
+b2 := <-fromBuf
+_, bufOk := <-fromBuf`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(get(`b1`), cv.ShouldEqual, 14)
+		cv.So(get(`b2`), cv.ShouldEqual, 16)
+		cv.So(get(`bufOk`), cv.ShouldBeFalse)
+
+		// interpreted goroutines still meet each other
+		// on a channel that Go has too.
+		_, err = in.Eval(ctx, `
+held := make(chan int)
+pump.Hold(held)
+go func() { held <- 42 }()
+met := <-held
+heldBuf := make(chan int, 2)
+pump.Hold(heldBuf)
+heldBuf <- 1
+heldBuf <- 2
+h1 := <-heldBuf
+h2 := <-heldBuf`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(get(`met`), cv.ShouldEqual, 42)
+		cv.So(get(`h1`), cv.ShouldEqual, 1)
+		cv.So(get(`h2`), cv.ShouldEqual, 2)
+
+		// the stress: native producers, a native feeder
+		// into an interpreted channel, a native relay
+		// and a native select, all drained by
+		// interpreted workers into one interpreted
+		// channel, which the eval selects on with
+		// another.
+		_, err = in.Eval(ctx, `
+out := make(chan int)
+finished := make(chan bool)
+func worker(from <-chan int) {
+	for v := range from {
+		out <- v
+	}
+	finished <- true
+}
+
+a, b := pump.Produce(300), pump.Produce(300)
+fed := make(chan int)
+pump.Feed(fed, 300)
+mid := make(chan int)
+back := pump.Relay(mid)
+left, right := make(chan int), make(chan int)
+merged := pump.Merge(left, right)
+
+go worker(a)
+go worker(a)
+go worker(b)
+go worker(fed)
+go worker(back)
+go worker(merged)
+go func() {
+	for i := 1; i <= 300; i++ {
+		mid <- i
+	}
+	close(mid)
+}()
+go func() {
+	for i := 1; i <= 300; i++ {
+		select {
+		case left <- i:
+		case right <- i:
+		}
+	}
+	close(left)
+	close(right)
+}()
+
+total, n := 0, 0
+for busy := 6; busy > 0; {
+	select {
+	case v := <-out:
+		total += v
+		n++
+	case <-finished:
+		busy--
+	}
+}`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(get(`n`), cv.ShouldEqual, 1500)
+		cv.So(get(`total`), cv.ShouldEqual, 6*45150)
+
+		// closing works from either side.
+		_, err = in.Eval(ctx, `shut := make(chan int); pump.Relay(shut); close(shut); shut <- 1`)
+		cv.So(err.Error(), cv.ShouldContainSubstring, "send on closed channel")
+		_, err = in.Eval(ctx, `fed <- 1`)
+		cv.So(err.Error(), cv.ShouldContainSubstring, "send on closed channel")
+	})
+}
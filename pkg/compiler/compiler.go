@@ -81,6 +81,11 @@ type Archive struct {
 
 	NewCodeText [][]byte
 
+	// NewDeclText is the part of NewCodeText that
+	// declares imports, types and funcs, which the
+	// workers of Interpreter.Parallel replay.
+	NewDeclText [][]byte
+
 	// save state so we can type incrementally
 	Pkg       *types.Package
 	TypesInfo *types.Info
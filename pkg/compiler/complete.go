@@ -0,0 +1,309 @@
+package compiler
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gijit/gi/pkg/ast"
+	"github.com/gijit/gi/pkg/token"
+	"github.com/gijit/gi/pkg/types"
+)
+
+// metaCommands are the special : commands that Repl.Read
+// understands, offered by tab completion at the prompt.
+var metaCommands = []string{
+	":?", ":ast", ":clear", ":do", ":doc", ":export", ":g", ":gls", ":glst", ":go",
+	":h", ":help", ":ls", ":lst", ":noast", ":prelude", ":q",
+	":r", ":reload", ":reset", ":restore", ":rm", ":snapshot", ":source", ":stacks",
+	":type", ":v", ":vv",
+}
+
+// complete is the tab completer for the prompt: it
+// handles the : commands and their file arguments, and
+// hands everything else to CompleteGo.
+func (r *Repl) complete(line string, pos int) (matches []string, start, end int) {
+	if pos > len(line) {
+		pos = len(line)
+	}
+	before := line[:pos]
+	trimmed := strings.TrimLeft(before, " \t")
+	lead := len(before) - len(trimmed)
+	if !strings.HasPrefix(trimmed, ":") || strings.HasPrefix(trimmed, "::") {
+		if r.cfg.RawLua {
+			return nil, pos, pos
+		}
+		return r.inc.CompleteGo(line, pos)
+	}
+
+	sp := strings.IndexAny(trimmed, " \t")
+	if sp < 0 {
+		for _, c := range metaCommands {
+			if strings.HasPrefix(c, trimmed) {
+				matches = append(matches, c)
+			}
+		}
+		return matches, lead, pos
+	}
+	switch trimmed[:sp] {
+	case ":do", ":source", ":export", ":snapshot", ":restore":
+		// these take a comma separated list; complete the last.
+		argBeg := lead + sp
+		if comma := strings.LastIndex(before, ","); comma >= argBeg {
+			argBeg = comma + 1
+		}
+		for argBeg < pos && (line[argBeg] == ' ' || line[argBeg] == '\t') {
+			argBeg++
+		}
+		return completePath(line[argBeg:pos]), argBeg, pos
+	}
+	return nil, pos, pos
+}
+
+// completePath lists the files and directories that
+// start with partial. Directories get a trailing slash
+// so that completion can continue into them.
+func completePath(partial string) (matches []string) {
+	dir, file := filepath.Split(partial)
+	readDir := dir
+	if strings.HasPrefix(readDir, "~/") {
+		if home := os.Getenv("HOME"); home != "" {
+			readDir = home + readDir[1:]
+		}
+	}
+	if readDir == "" {
+		readDir = "."
+	}
+	infos, err := ioutil.ReadDir(readDir)
+	if err != nil {
+		return nil
+	}
+	for _, fi := range infos {
+		name := fi.Name()
+		if !strings.HasPrefix(name, file) {
+			continue
+		}
+		if strings.HasPrefix(name, ".") && !strings.HasPrefix(file, ".") {
+			continue
+		}
+		if fi.IsDir() {
+			name += string(os.PathSeparator)
+		}
+		matches = append(matches, dir+name)
+	}
+	return
+}
+
+// CompleteGo offers the names that could finish the
+// identifier ending at byte offset pos of line. After a
+// '.', it offers the members of what precedes it: the
+// exported names of an imported package, or the fields
+// and methods of a typed expression. The text to be
+// replaced by any of the matches is line[start:end].
+func (ic *IncrState) CompleteGo(line string, pos int) (matches []string, start, end int) {
+	if pos > len(line) {
+		pos = len(line)
+	}
+	start = identStart(line, pos)
+	end = pos
+	partial := line[start:pos]
+
+	var names []string
+	if start > 0 && line[start-1] == '.' {
+		x := line[exprStart(line, start-1) : start-1]
+		names = ic.memberNames(x)
+	} else {
+		names = ic.scopeNames()
+	}
+
+	seen := make(map[string]bool)
+	for _, name := range names {
+		if strings.HasPrefix(name, partial) && !strings.HasPrefix(name, "__") && !seen[name] {
+			seen[name] = true
+			matches = append(matches, name)
+		}
+	}
+	sort.Strings(matches)
+	return
+}
+
+// scopeNames lists what is visible at top level.
+func (ic *IncrState) scopeNames() (names []string) {
+	if pkg := ic.curTypesPkg(); pkg != nil {
+		names = append(names, pkg.Scope().Names()...)
+	}
+	return append(names, types.Universe.Names()...)
+}
+
+// memberNames lists what can follow "x.".
+func (ic *IncrState) memberNames(x string) (names []string) {
+	pkg := ic.curTypesPkg()
+	if x == "" || pkg == nil {
+		return nil
+	}
+	if imp := ic.importedPackage(x); imp != nil {
+		for _, name := range imp.Scope().Names() {
+			if ast.IsExported(name) {
+				names = append(names, name)
+			}
+		}
+		return
+	}
+
+	tv, err := types.Eval(ic.CurPkg.fileSet, pkg, token.NoPos, x)
+	if err != nil || tv.Type == nil {
+		return nil
+	}
+	visible := func(obj types.Object) bool {
+		return obj.Exported() || obj.Pkg() == pkg
+	}
+	typ := tv.Type
+
+	if !tv.IsType() {
+		seen := make(map[types.Type]bool)
+		var addFields func(t types.Type)
+		addFields = func(t types.Type) {
+			if ptr, ok := t.Underlying().(*types.Pointer); ok {
+				t = ptr.Elem()
+			}
+			if seen[t] {
+				return
+			}
+			seen[t] = true
+			st, ok := t.Underlying().(*types.Struct)
+			if !ok {
+				return
+			}
+			for i := 0; i < st.NumFields(); i++ {
+				f := st.Field(i)
+				if visible(f) {
+					names = append(names, f.Name())
+				}
+				if f.Anonymous() {
+					// promoted fields
+					addFields(f.Type())
+				}
+			}
+		}
+		addFields(typ)
+
+		// values can usually call the pointer methods too.
+		_, isPtr := typ.Underlying().(*types.Pointer)
+		if !isPtr && !types.IsInterface(typ) {
+			typ = types.NewPointer(typ)
+		}
+	}
+	mset := types.NewMethodSet(typ)
+	for i := 0; i < mset.Len(); i++ {
+		if obj := mset.At(i).Obj(); visible(obj) {
+			names = append(names, obj.Name())
+		}
+	}
+	return
+}
+
+// LookupGo finds the object named by the identifier or
+// selector around byte offset pos of line.
+func (ic *IncrState) LookupGo(line string, pos int) types.Object {
+	if pos > len(line) {
+		pos = len(line)
+	}
+	// extend forward to the end of the identifier under the cursor.
+	for pos < len(line) {
+		r, w := utf8.DecodeRuneInString(line[pos:])
+		if !isIdentRune(r) {
+			break
+		}
+		pos += w
+	}
+	start := identStart(line, pos)
+	name := line[start:pos]
+	pkg := ic.curTypesPkg()
+	if name == "" || pkg == nil {
+		return nil
+	}
+	if start > 0 && line[start-1] == '.' {
+		x := line[exprStart(line, start-1) : start-1]
+		if imp := ic.importedPackage(x); imp != nil {
+			return imp.Scope().Lookup(name)
+		}
+		tv, err := types.Eval(ic.CurPkg.fileSet, pkg, token.NoPos, x)
+		if err != nil || tv.Type == nil {
+			return nil
+		}
+		obj, _, _ := types.LookupFieldOrMethod(tv.Type, true, pkg, name)
+		return obj
+	}
+	if obj := pkg.Scope().Lookup(name); obj != nil {
+		return obj
+	}
+	return types.Universe.Lookup(name)
+}
+
+func (ic *IncrState) curTypesPkg() *types.Package {
+	if ic.CurPkg == nil || ic.CurPkg.Arch == nil {
+		return nil
+	}
+	return ic.CurPkg.Arch.Pkg
+}
+
+// importedPackage returns the package imported under
+// name at top level, or nil.
+func (ic *IncrState) importedPackage(name string) *types.Package {
+	pkg := ic.curTypesPkg()
+	if pkg == nil {
+		return nil
+	}
+	pn, ok := pkg.Scope().Lookup(name).(*types.PkgName)
+	if !ok {
+		return nil
+	}
+	return pn.Imported()
+}
+
+// exprStart finds the beginning of the operand that
+// ends at byte offset end, stepping back over
+// identifiers, selectors, and bracketed calls and
+// indexes: in "y := a.b(1)[i]" it finds the 'a'.
+func exprStart(line string, end int) int {
+	depth := 0
+	i := end
+	for i > 0 {
+		c := line[i-1]
+		switch {
+		case c == ')' || c == ']':
+			depth++
+		case c == '(' || c == '[':
+			if depth == 0 {
+				return i
+			}
+			depth--
+		case depth > 0:
+		case c == '.' || c == '_' || c >= utf8.RuneSelf ||
+			('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9'):
+		default:
+			return i
+		}
+		i--
+	}
+	return i
+}
+
+func identStart(line string, pos int) int {
+	for pos > 0 {
+		r, w := utf8.DecodeLastRuneInString(line[:pos])
+		if !isIdentRune(r) {
+			break
+		}
+		pos -= w
+	}
+	return pos
+}
+
+func isIdentRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,68 @@
+package compiler
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test1601TabCompletionUsesTheLiveScope(t *testing.T) {
+
+	vm, err := NewLuaVmWithPrelude(nil)
+	panicOn(err)
+	defer vm.Close()
+	inc := NewIncrState(vm, nil)
+	r := &Repl{cfg: NewGIConfig(), lvm: vm, inc: inc}
+
+	cv.Convey("tab completion offers top level names, package members, fields and methods, : commands, and file paths", t, func() {
+		code := `
+import "github.com/gijit/gi/pkg/compiler/spkg_tst"
+type inner struct { Depth int }
+type boat struct { inner; Name string; hull int }
+func (b *boat) Sail() int { return 1 }
+func (b boat) Sink() int { return 2 }
+myBoat := &boat{Name: "minnow"}
+var myBoats []boat
+`
+		translation, err := inc.Tr([]byte(code))
+		panicOn(err)
+		LuaRunAndReport(vm, string(translation))
+
+		matches, start, end := r.complete("x := myB", 8)
+		cv.So(matches, cv.ShouldResemble, []string{"myBoat", "myBoats"})
+		cv.So(start, cv.ShouldEqual, 5)
+		cv.So(end, cv.ShouldEqual, 8)
+
+		matches, start, _ = r.complete("spkg_tst.F", 10)
+		cv.So(matches, cv.ShouldResemble, []string{"Fish"})
+		cv.So(start, cv.ShouldEqual, 9)
+
+		matches, _, _ = r.complete("myBoat.", 7)
+		cv.So(matches, cv.ShouldResemble, []string{"Depth", "Name", "Sail", "Sink", "hull", "inner"})
+
+		// an indexed value can still use the pointer method Sail.
+		matches, _, _ = r.complete("myBoats[0].S", 12)
+		cv.So(matches, cv.ShouldResemble, []string{"Sail", "Sink"})
+
+		// method expressions on the type itself.
+		matches, _, _ = r.complete("boat.", 5)
+		cv.So(matches, cv.ShouldResemble, []string{"Sink"})
+
+		matches, start, _ = r.complete(":so", 3)
+		cv.So(matches, cv.ShouldResemble, []string{":source"})
+		cv.So(start, cv.ShouldEqual, 0)
+
+		dir, err := ioutil.TempDir("", "gi-complete")
+		panicOn(err)
+		defer os.RemoveAll(dir)
+		panicOn(ioutil.WriteFile(filepath.Join(dir, "prog.go"), nil, 0644))
+		panicOn(os.Mkdir(filepath.Join(dir, "progs"), 0755))
+		line := ":source a.go, " + dir + "/pro"
+		matches, start, _ = r.complete(line, len(line))
+		cv.So(matches, cv.ShouldResemble, []string{dir + "/prog.go", dir + "/progs/"})
+		cv.So(start, cv.ShouldEqual, len(":source a.go, "))
+	})
+}
@@ -21,7 +21,6 @@ import (
 
 var _ = debug.Stack
 
-var debugNilCount int
 var pp = verb.PP
 var vv = verb.VV
 var p1 = verb.P
@@ -86,8 +85,8 @@ func (c *funcContext) translateExpr(expr ast.Expr, desiredType types.Type) (xprn
 			return c.formatExpr("%s", strconv.FormatBool(constant.BoolVal(value)))
 		case isInteger(basic):
 
-			// jea: for now, all int types are 64-bit
-			// jea: TODO: come back and handle int32, uint32, int16, uint16, int8, uint8
+			// jea: all int types are held in 64-bit cdata; the
+			// narrower ones are cut back to width by fixNumber.
 			//if is64Bit(basic) {
 			k := basic.Kind()
 			if desiredType != nil {
@@ -163,12 +162,6 @@ func (c *funcContext) translateExpr(expr ast.Expr, desiredType types.Type) (xprn
 		}
 	}
 	pp("obj is '%#v'", obj)
-	if obj == nil {
-		debugNilCount++
-		if debugNilCount > 2 {
-			//panic("where obj nil?")
-		}
-	}
 
 	// jea important location! here is
 	// where the magic happens that
@@ -276,6 +269,12 @@ func (c *funcContext) translateExpr(expr ast.Expr, desiredType types.Type) (xprn
 			return c.formatExpr("__makeMap({%s}, %s, %s, %s)", joined, keyName, eleName, xName)
 		case *types.Struct:
 			pp("in expressions.go, for *types.Struct")
+			if len(e.Elts) == 0 {
+				// a native struct's wrapper makes its zero value.
+				if isShad, shortPkgAndTyp := c.p.isShadowStruct(exprType.String()); isShad {
+					return c.formatExpr("__type__.%s()", shortPkgAndTyp)
+				}
+			}
 			elements := make([]string, t.NumFields())
 			isKeyValue := true
 			if len(e.Elts) != 0 {
@@ -291,7 +290,7 @@ func (c *funcContext) translateExpr(expr ast.Expr, desiredType types.Type) (xprn
 					// special case the shadow structs
 					tn := t.Field(i).Type().String()
 					//vv("tn = '%s'", tn)
-					isShad, shortPkgAndTyp := isShadowStruct(tn)
+					isShad, shortPkgAndTyp := c.p.isShadowStruct(tn)
 					if isShad {
 						elements[i] = fmt.Sprintf("__type__.%s()", shortPkgAndTyp)
 					} else {
@@ -452,17 +451,17 @@ func (c *funcContext) translateExpr(expr ast.Expr, desiredType types.Type) (xprn
 				return c.formatExpr("-(%1e)", e.X)
 				//return c.formatExpr("-(%1r+%1i)", e.X)
 				//return c.formatExpr("%1s(-%2r, -%2i)", c.typeName(0, t), e.X)
-			case isUnsigned(basic):
-				return c.fixNumber(c.formatExpr("-%e", e.X), basic)
-			default:
+			case isFloat(basic):
 				return c.formatExpr("-%e", e.X)
+			default:
+				return c.fixNumber(c.formatExpr("-%e", e.X), basic)
 			}
 		case token.XOR:
 			if is64Bit(basic) {
-				return c.formatExpr("__bit.bxor(%e)", e.X)
+				return c.formatExpr("__bit.bnot(%e)", e.X)
 				//return c.formatExpr("%1s(~%2h, ~%2l >>> 0)", c.typeName(0, t), e.X)
 			}
-			return c.fixNumber(c.formatExpr("~%e", e.X), basic)
+			return c.fixNumber(c.formatExpr("__bit.bnot(%e)", e.X), basic)
 		case token.NOT:
 			return c.formatExpr(" not %e", e.X)
 		default:
@@ -527,8 +526,8 @@ func (c *funcContext) translateExpr(expr ast.Expr, desiredType types.Type) (xprn
 					//	shift = ">>>"
 					//}
 
-					// jea:
-					return c.formatExpr(`__integerByZeroCheck(%1e / %2e)`, e.X, e.Y)
+					// jea: math.MinInt8 / -1 overflows, so fix.
+					return c.fixNumber(c.formatExpr(`__integerQuo(%1e, %2e)`, e.X, e.Y), basic)
 					// return c.formatExpr(`(%1s = %2e / %3e, (%1s == %1s && %1s ~= 1/0 && %1s ~= -1/0) ? %1s %4s 0 : error("integer divide by zero"))`, c.newVariable("_q"), e.X, e.Y, shift)
 				}
 				if basic.Kind() == types.Float32 {
@@ -536,7 +535,7 @@ func (c *funcContext) translateExpr(expr ast.Expr, desiredType types.Type) (xprn
 				}
 				return c.formatExpr("((%e) / (%e))", e.X, e.Y)
 			case token.REM:
-				return c.formatExpr(`__integerByZeroCheck(%1e %% %2e)`, e.X, e.Y)
+				return c.formatExpr(`__integerRem(%1e, %2e)`, e.X, e.Y)
 			case token.SHL, token.SHR:
 				op := e.Op.String()
 				if e.Op == token.SHR {
@@ -701,7 +700,7 @@ func (c *funcContext) translateExpr(expr ast.Expr, desiredType types.Type) (xprn
 
 			//return c.formatExpr(`(%1s = %2e[%3s], %1s !== undefined ? %1s.v : %4e)`, c.newVariable("_entry"), e.X, key, c.zeroValue(t.Elem()))
 		case *types.Basic:
-			return c.formatExpr("__utf8.sub(%e,%f+1,%f+1)", e.X, e.Index, e.Index)
+			return c.formatExpr("__stringByte(%e, %f)", e.X, e.Index)
 		default:
 			panic(fmt.Sprintf("Unhandled IndexExpr: %T\n", t))
 		}
@@ -1359,14 +1358,11 @@ func (c *funcContext) translateConversion(expr ast.Expr, desiredType types.Type)
 				}
 				return c.formatExpr("%s(%e)", c.typeName(desiredType, nil), expr)
 			case is64Bit(basicExprType):
-				if !isUnsigned(t) && !isUnsigned(basicExprType) {
-					return c.formatParenExpr("%e", expr)
-				}
-				return c.formatExpr("%s", c.translateExpr(expr, nil), t)
+				return c.fixNumber(c.translateExpr(expr, nil), t)
 			case isFloat(basicExprType):
 				// jea
 				//return c.formatParenExpr("%e >> 0", expr)
-				return c.formatParenExpr("int(%e)", expr)
+				return c.fixNumber(c.formatParenExpr("int(%e)", expr), t)
 			case types.Identical(exprType, types.Typ[types.UnsafePointer]):
 				return c.translateExpr(expr, nil)
 			default:
@@ -1491,7 +1487,7 @@ func (c *funcContext) translateImplicitConversionWithCloning(expr ast.Expr, desi
 			typName, isAnon, anonType, createdNm, isShadow := c.typeNameWithAnonInfo(desiredType, nil)
 			pp("debug __clone arg: c.typeName(desiredType, nil)='%s'; createdNm='%s'; isAnon='%v', anonType='%#v', isShadow=%v", typName, createdNm, isAnon, anonType, isShadow)
 			if isShadow {
-				_, shortTyp := isShadowStruct(desiredType.String())
+				_, shortTyp := c.p.isShadowStruct(desiredType.String())
 				_ = shortTyp
 				return c.formatExpr(`%e --[[ isShadow true, expressions.go:1494 --]]`, expr)
 				//return c.formatExpr(`%e = __type__.%s() --[[ isShadow true, expressions.go:1494 --]]`, expr, shortTyp)
@@ -1644,6 +1640,18 @@ func (c *funcContext) fixNumber(value *expression, basic *types.Basic) (xprn *ex
 		pp("returning from fixNumber with xprn='%s'", x2s(xprn))
 	}()
 	switch basic.Kind() {
+	case types.Int8:
+		return c.formatExpr("__truncInt8(%s)", value)
+	case types.Int16:
+		return c.formatExpr("__truncInt16(%s)", value)
+	case types.Int32:
+		return c.formatExpr("__truncInt32(%s)", value)
+	case types.Uint8:
+		return c.formatExpr("__truncUint8(%s)", value)
+	case types.Uint16:
+		return c.formatExpr("__truncUint16(%s)", value)
+	case types.Uint32:
+		return c.formatExpr("__truncUint32(%s)", value)
 	case types.Float32, types.Float64:
 		return value
 	default:
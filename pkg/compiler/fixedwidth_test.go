@@ -0,0 +1,130 @@
+package compiler
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test1618FixedWidthIntegersWrapAsInGo(t *testing.T) {
+
+	cv.Convey("int8/16/32 and uint8/16/32 arithmetic, shifts, conversions and compound assignments give the answers compiled Go does", t, func() {
+		in, err := NewInterpreter(nil)
+		panicOn(err)
+		defer in.Close()
+		ctx := context.Background()
+
+		// the same declarations, on both sides.
+		decls := `
+var i8 int8 = 127
+var m8 int8 = -128
+var i16 int16 = 32767
+var i32 int32 = 2147483647
+var u8 uint8 = 255
+var u16 uint16 = 65535
+var u32 uint32 = 4294967295
+var n64 int64 = -1
+var f float64 = -3.7
+var g float64 = 250.9
+`
+		var i8 int8 = 127
+		var m8 int8 = -128
+		var i16 int16 = 32767
+		var i32 int32 = 2147483647
+		var u8 uint8 = 255
+		var u16 uint16 = 65535
+		var u32 uint32 = 4294967295
+		var n64 int64 = -1
+		var f float64 = -3.7
+		var g float64 = 250.9
+
+		_, err = in.Eval(ctx, decls)
+		panicOn(err)
+
+		fnv := `
+func fnv32(s string) uint32 {
+	h := uint32(2166136261)
+	for i := 0; i < len(s); i++ {
+		h ^= uint32(s[i])
+		h *= 16777619
+	}
+	return h
+}`
+		fnv32 := func(s string) uint32 {
+			h := uint32(2166136261)
+			for i := 0; i < len(s); i++ {
+				h ^= uint32(s[i])
+				h *= 16777619
+			}
+			return h
+		}
+		_, err = in.Eval(ctx, fnv)
+		panicOn(err)
+
+		for _, c := range []struct {
+			stmts string // run first, if any
+			expr  string
+			want  interface{}
+		}{
+			{"", "i8 + 1", i8 + 1},
+			{"", "i8 * 2", i8 * 2},
+			{"", "m8 - 1", m8 - 1},
+			{"", "(-m8)", -m8},
+			{"", "m8 / -1", m8 / -1},
+			{"", "m8 % 3", m8 % 3},
+			{"", "(^i8)", ^i8},
+			{"", "i8 << 1", i8 << 1},
+			{"", "m8 >> 1", m8 >> 1},
+			{"", "i16 + 1", i16 + 1},
+			{"", "i16 * i16", i16 * i16},
+			{"", "i32 + 1", i32 + 1},
+			{"", "i32 * 3", i32 * 3},
+			{"", "i32 << 4", i32 << 4},
+			{"", "u8 + 1", u8 + 1},
+			{"", "u8 * u8", u8 * u8},
+			{"", "uint8(1) - u8", uint8(1) - u8},
+			{"", "(-u8)", -u8},
+			{"", "(^u8)", ^u8},
+			{"", "u8 << 1", u8 << 1},
+			{"", "u8 >> 3", u8 >> 3},
+			{"", "u16 + 1", u16 + 1},
+			{"", "u16 << 4", u16 << 4},
+			{"", "(^u16)", ^u16},
+			{"", "u32 + 1", u32 + 1},
+			{"", "u32 * u32", u32 * u32},
+			{"", "u32 << 1", u32 << 1},
+			{"", "int8(i32)", int8(i32)},
+			{"", "uint8(i32)", uint8(i32)},
+			{"", "uint16(m8)", uint16(m8)},
+			{"", "int16(u16)", int16(u16)},
+			{"", "int32(u32)", int32(u32)},
+			{"", "uint32(n64)", uint32(n64)},
+			{"", "int8(f)", int8(f)},
+			{"", "uint8(g)", uint8(g)},
+			{"c8 := i8; c8++", "c8", func() int8 { c8 := i8; c8++; return c8 }()},
+			{"d8 := u8; d8 += 10", "d8", func() uint8 { d8 := u8; d8 += 10; return d8 }()},
+			{"e16 := i16; e16 *= 3", "e16", func() int16 { e16 := i16; e16 *= 3; return e16 }()},
+			{"g32 := u32; g32 <<= 8", "g32", func() uint32 { g32 := u32; g32 <<= 8; return g32 }()},
+			{"var sum uint8; for _, b := range []byte(\"hello, gi\") { sum += b }", "sum",
+				func() uint8 {
+					var sum uint8
+					for _, b := range []byte("hello, gi") {
+						sum += b
+					}
+					return sum
+				}()},
+			{"", `fnv32("hello, gi")`, fnv32("hello, gi")},
+		} {
+			if c.stmts != "" {
+				_, err = in.Eval(ctx, c.stmts)
+				cv.So(err, cv.ShouldBeNil)
+			}
+			res, err := in.Eval(ctx, c.expr)
+			cv.So(err, cv.ShouldBeNil)
+			cv.So(len(res), cv.ShouldEqual, 1)
+			cv.So(c.expr+" = "+fmt.Sprint(res[0]), cv.ShouldEqual, c.expr+" = "+fmt.Sprint(c.want))
+		}
+	})
+}
@@ -74,7 +74,7 @@ func FullPackageCompile(importPath string, files []*ast.File, fileSet *token.Fil
 	}
 
 	pp("config.Check on importPath='%s'\n", importPath)
-	prelude := addPreludeToNewPkg
+	prelude := newPkgPrelude(importContext.Sandbox)
 	if importPath != "main" {
 		prelude = nil
 	}
@@ -140,6 +140,7 @@ func FullPackageCompile(importPath string, files []*ast.File, fileSet *token.Fil
 			typeDepend:        NewDFSState(),
 			typeDefineLuaCode: make(map[types.Object]string),
 			importedPackages:  make(map[string]*types.Package),
+			binaryPackage:     importContext.BinaryPackages,
 
 			Info:                 pkgInfo,
 			additionalSelections: make(map[*ast.SelectorExpr]selection),
@@ -427,8 +428,9 @@ func FullPackageCompile(importPath string, files []*ast.File, fileSet *token.Fil
 		d.DceDeps = collectDependencies(func() {
 			d.DeclCode = c.CatchOutput(0, func() {
 				typeName := c.objectName(o)
-				lhsPre := fmt.Sprintf("__type__.%s", typesPkg.Name())
-				lhs := fmt.Sprintf("%s.%s", lhsPre, typeName)
+				// as typeName() will refer to it: main's types
+				// live directly in __type__.
+				lhs := "__type__." + c.getPkgName() + typeName
 				// jea comment out for now... b/c getting stuff like:
 				//
 				// __type__.GONZAGA = _pkg.GONZAGA --[[ fullpkg.go:395 --]]  = __newType(16, __kindInterface, "spkg_tst.GONZAGA", true, "github.com/gijit/gi/pkg/compiler/spkg_tst", true, nil);
@@ -460,7 +462,9 @@ func FullPackageCompile(importPath string, files []*ast.File, fileSet *token.Fil
 				case *types.Basic, *types.Array, *types.Slice, *types.Chan, *types.Signature, *types.Interface, *types.Pointer, *types.Map:
 					size = sizes64.Sizeof(t)
 				}
-				c.Printf("%[1]s = %[1]s or {};\n", lhsPre)
+				if pkgName := c.getPkgName(); pkgName != "" {
+					c.Printf("%[1]s = %[1]s or {};\n", "__type__."+strings.TrimSuffix(pkgName, "."))
+				}
 				c.Printf(`%s = __newType(%d, %s, "%s.%s", %t, "%s", %t, %s);`, lhs, size, typeKind(o.Type()), o.Pkg().Name(), o.Name(), o.Name() != "", o.Pkg().Path(), o.Exported(), constructor)
 			})
 			d.MethodListCode = c.CatchOutput(0, func() {
@@ -499,7 +503,7 @@ func FullPackageCompile(importPath string, files []*ast.File, fileSet *token.Fil
 			switch t := o.Type().Underlying().(type) {
 			case *types.Array, *types.Chan, *types.Interface, *types.Map, *types.Pointer, *types.Slice, *types.Signature, *types.Struct:
 				d.TypeInitCode = c.CatchOutput(0, func() {
-					c.Printf("__type__.%s.%s.init(%s); --where: %s\n", typesPkg.Name(), c.objectName(o), c.initArgs(t), verb.FileLine(1))
+					c.Printf("__type__.%s%s.init(%s); --where: %s\n", c.getPkgName(), c.objectName(o), c.initArgs(t), verb.FileLine(1))
 				})
 			}
 		})
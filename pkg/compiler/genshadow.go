@@ -1,10 +1,13 @@
 package compiler
 
 import (
+	"bytes"
 	"fmt"
-	"os"
-
+	"io"
+	"io/ioutil"
 	"path/filepath"
+	"sort"
+	"strings"
 
 	"github.com/gijit/gi/pkg/ast"
 	"github.com/gijit/gi/pkg/importer"
@@ -36,20 +39,17 @@ func GenShadowImport(importPath, dirForVendor, residentPkg, outDir string) error
 
 	pkgName := pkg.Name()
 
-	o, err := os.Create(outDir + string(os.PathSeparator) + pkgName + ".genimp.go")
-	if err != nil {
-		return err
-	}
-
-	fmt.Fprintf(o, `package shadow_%s
-
-import "%s"
-
+	// the proxies may need other packages, so the
+	// imports are only known once the body is written.
+	imports := map[string]string{importPath: pkgName}
+	o := &bytes.Buffer{}
+	fmt.Fprintf(o, `
 var Pkg = make(map[string]interface{})
 var Ctor = make(map[string]interface{})
+var Proxy = make(map[string]interface{})
 
 func init() {
-`, base, importPath)
+`)
 
 	scope := pkg.Scope()
 	nms := scope.Names()
@@ -116,6 +116,7 @@ func init() {
 				switch obj.(type) {
 				case *types.TypeName:
 					ifaceTemplate(o, obj, nm, pkgName, oty, under, &atEnd)
+					proxyTemplate(o, pkg, nm, under.(*types.Interface), imports, &atEnd)
 				case *types.Var:
 					direct(o, nm, pkgName)
 				default:
@@ -145,7 +146,24 @@ func init() {
 	}
 	fmt.Fprintf(o, "%s", genInitLuaFinish(pkgName))
 
-	return nil
+	var paths []string
+	for path := range imports {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	header := &bytes.Buffer{}
+	fmt.Fprintf(header, "package shadow_%s\n\n", base)
+	if len(paths) == 1 {
+		fmt.Fprintf(header, "import %q\n", paths[0])
+	} else {
+		fmt.Fprintf(header, "import (\n")
+		for _, path := range paths {
+			fmt.Fprintf(header, "\t%q\n", path)
+		}
+		fmt.Fprintf(header, ")\n")
+	}
+	header.Write(o.Bytes())
+	return ioutil.WriteFile(filepath.Join(outDir, pkgName+".genimp.go"), header.Bytes(), 0644)
 }
 
 /* make a function like:
@@ -181,11 +199,11 @@ func %s(x interface{}) %s.%s {
 	return
 }
 
-func direct(o *os.File, nm, pkgName string) {
+func direct(o io.Writer, nm, pkgName string) {
 	fmt.Fprintf(o, "    Pkg[\"%s\"] = %s.%s\n", nm, pkgName, nm)
 }
 
-func ctor(o *os.File, nm, pkgName string) {
+func ctor(o io.Writer, nm, pkgName string) {
 	fmt.Fprintf(o, "    Ctor[\"%[1]s\"] = GijitShadow_NewStruct_%[1]s\n", nm)
 }
 
@@ -198,7 +216,7 @@ func GijitShadow_CtorStruct_Time(src *time.Time) *time.Time {
 	return &a
 }
 */
-func structTemplate(o *os.File, obj types.Object, nm, pkgName string, oty, under types.Type, atEnd *[]string) {
+func structTemplate(o io.Writer, obj types.Object, nm, pkgName string, oty, under types.Type, atEnd *[]string) {
 	// example from "io":
 	/*
 		type PipeReader struct {
@@ -223,7 +241,7 @@ func GijitShadow_NewStruct_%[2]s(src *%[1]s.%[2]s) *%[1]s.%[2]s {
 
 }
 
-func ifaceTemplate(o *os.File, obj types.Object, nm, pkgName string, oty, under types.Type, atEnd *[]string) {
+func ifaceTemplate(o io.Writer, obj types.Object, nm, pkgName string, oty, under types.Type, atEnd *[]string) {
 
 	//pp("ifaceTemplate:: we see Named '%s'\n. oty:'%#v',\n under:'%#v',\n, obj='%#v', \n", nm, oty, under, obj)
 
@@ -233,6 +251,89 @@ func ifaceTemplate(o *os.File, obj types.Object, nm, pkgName string, oty, under
 	//fmt.Fprintf(o, "    Pkg[\"%s\"] = %s\n", nm, funcName1)
 }
 
+/* make a proxy type like:
+type GijitShadow_Proxy_Reader struct {
+	Method_Read func(a0 []byte) (int, error)
+}
+
+func (p *GijitShadow_Proxy_Reader) Read(a0 []byte) (int, error) {
+	return p.Method_Read(a0)
+}
+
+through which an interpreted value can be passed where
+a native io.Reader is wanted: luar fills in each Method_
+field with the Lua method of the same name. An interface
+with unexported methods cannot be implemented here, and
+gets no proxy, nor does an empty one.
+*/
+func GenInterfaceProxy(pkg *types.Package, name string, iface *types.Interface, imports map[string]string) (proxyName, decl string, ok bool) {
+	qual := func(other *types.Package) string {
+		imports[other.Path()] = other.Name()
+		return other.Name()
+	}
+	n := iface.NumMethods()
+	if n == 0 {
+		// anything will do, no proxy needed.
+		return "", "", false
+	}
+	for i := 0; i < n; i++ {
+		if !iface.Method(i).Exported() {
+			return "", "", false
+		}
+	}
+
+	proxyName = fmt.Sprintf("GijitShadow_Proxy_%s", name)
+	var fields, methods bytes.Buffer
+	for i := 0; i < n; i++ {
+		m := iface.Method(i)
+		sig := m.Type().(*types.Signature)
+
+		var params, args []string
+		ps := sig.Params()
+		for j := 0; j < ps.Len(); j++ {
+			t := ps.At(j).Type()
+			ts := types.TypeString(t, qual)
+			arg := fmt.Sprintf("a%d", j)
+			if sig.Variadic() && j == ps.Len()-1 {
+				ts = "..." + types.TypeString(t.(*types.Slice).Elem(), qual)
+				arg += "..."
+			}
+			params = append(params, fmt.Sprintf("a%d %s", j, ts))
+			args = append(args, arg)
+		}
+		var results []string
+		rs := sig.Results()
+		for j := 0; j < rs.Len(); j++ {
+			results = append(results, types.TypeString(rs.At(j).Type(), qual))
+		}
+		res := strings.Join(results, ", ")
+		if len(results) > 1 {
+			res = "(" + res + ")"
+		}
+		ret := "return "
+		if len(results) == 0 {
+			ret = ""
+		}
+		fmt.Fprintf(&fields, "\tMethod_%s func(%s) %s\n", m.Name(), strings.Join(params, ", "), res)
+		fmt.Fprintf(&methods, `
+func (p *%[1]s) %[2]s(%[3]s) %[4]s {
+	%[5]sp.Method_%[2]s(%[6]s)
+}
+`, proxyName, m.Name(), strings.Join(params, ", "), res, ret, strings.Join(args, ", "))
+	}
+	decl = fmt.Sprintf("\ntype %s struct {\n%s}\n%s", proxyName, fields.String(), methods.String())
+	return proxyName, decl, true
+}
+
+func proxyTemplate(o io.Writer, pkg *types.Package, nm string, iface *types.Interface, imports map[string]string, atEnd *[]string) {
+	proxyName, decl, ok := GenInterfaceProxy(pkg, nm, iface, imports)
+	if !ok {
+		return
+	}
+	*atEnd = append(*atEnd, decl)
+	fmt.Fprintf(o, "    Proxy[\"%s\"] = (*%s)(nil)\n", nm, proxyName)
+}
+
 func genInitLuaStart(shortPkg string) string {
 
 	return fmt.Sprintf("\n\n func InitLua() string {\n  "+
@@ -2,12 +2,12 @@ package compiler
 
 import (
 	"fmt"
+	"runtime"
 	"sync"
 	"sync/atomic"
 
 	"time"
 
-	"github.com/gijit/gi/pkg/verb"
 	golua "github.com/glycerine/golua/lua"
 	"github.com/glycerine/idem"
 	"github.com/glycerine/luar"
@@ -27,6 +27,10 @@ type Goro struct {
 	mut      sync.Mutex
 	started  bool
 
+	// the OS thread that Start locks, and serves
+	// tickets on.
+	tid uintptr
+
 	manualHeartbeat chan bool
 	heartbeatsOff   chan bool
 	heartbeatsOn    chan bool
@@ -52,6 +56,10 @@ type ticket struct {
 	// what to do after any registrations, optional
 	run []byte
 
+	//input
+	// Go code to run with the vm, after run, optional
+	fn func(L *golua.State)
+
 	//input
 	// what to fetch for return, optional;
 	// one of register, run, or varname should be
@@ -68,6 +76,10 @@ type ticket struct {
 
 	// closed when txn complete
 	done chan struct{}
+
+	// a panic while running, for Do to re-panic with.
+	panicked bool
+	panicVal interface{}
 }
 
 func (r *Goro) StartBeat() {
@@ -141,25 +153,28 @@ func NewGoro(lvm *LuaVm, cfg *GoroConfig) (*Goro, error) {
 	return r, nil
 }
 
-// Start must run on the main thread
-// for LuaJIT, it only returns when
-// halted. Use this:
-/*
-    doMainAsync(func() {
-		lvm.goro.Start()
-	})
-*/
+// Start serves the tickets for r's Lua state,
+// on an OS thread of its own, until halted. Run it
+// on a new goroutine: go lvm.goro.Start().
 func (r *Goro) Start() {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	r.mut.Lock()
 	if r.started {
 		panic("cannot start goro more than once!")
 	}
 	r.started = true
+	r.tid = golua.ThreadID()
 	r.mut.Unlock()
 
 	func() {
 		defer func() {
 			r.lvm.vm.Close()
+			// the thread may serve others, once unlocked.
+			r.mut.Lock()
+			r.tid = 0
+			r.mut.Unlock()
 			r.halt.MarkDone()
 		}()
 
@@ -186,7 +201,7 @@ func (r *Goro) Start() {
 			case <-r.halt.ReqStop.Chan:
 				return
 			case t := <-r.doticket:
-				r.handleTicket(t)
+				r.serveTicket(t)
 			}
 		}
 	}()
@@ -216,6 +231,9 @@ func (r *Goro) handleTicket(t *ticket) {
 	if len(t.run) > 0 {
 		t.runErr = r.privateRun(t.run, t.useEvalCoroutine)
 	}
+	if t.runErr == nil && t.fn != nil {
+		t.fn(r.vm)
+	}
 	if t.runErr == nil && len(t.varname) > 0 {
 		for key := range t.varname {
 			if key == "" {
@@ -245,12 +263,51 @@ func (r *Goro) handleTicket(t *ticket) {
 	close(t.done)
 }
 
+// serveTicket hands a panic in t back to the
+// goroutine that is waiting on t.
+func (r *Goro) serveTicket(t *ticket) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			t.panicked = true
+			t.panicVal = rec
+			close(t.done)
+		}
+	}()
+	r.handleTicket(t)
+}
+
+// do runs t on r's own goroutine. A ticket from
+// that goroutine, which is a Go function called
+// from the Lua that r is running, runs right away.
 func (r *Goro) do(t *ticket) {
-	if reserveMainThread {
-		r.doticket <- t
-		<-t.done
-	} else {
+	r.mut.Lock()
+	tid := r.tid
+	r.mut.Unlock()
+	if tid == golua.ThreadID() {
 		r.handleTicket(t)
+		return
+	}
+	select {
+	case r.doticket <- t:
+	case <-r.halt.Done.Chan:
+		t.runErr = fmt.Errorf("the LuaJIT vm has been closed")
+		return
+	}
+	<-t.done
+	if t.panicked {
+		panic(t.panicVal)
+	}
+}
+
+// runCallback is r's luar.SetCallbackRunner: it runs a
+// Go func made from a Lua function, that Go has called
+// outside of any Lua call into Go, on r's goroutine.
+func (r *Goro) runCallback(f func(L *golua.State)) {
+	t := r.newTicket("", false)
+	t.fn = f
+	r.do(t)
+	if t.runErr != nil {
+		panic(t.runErr)
 	}
 }
 
@@ -293,7 +350,7 @@ func (goro *Goro) privateRun(run []byte, useEvalCoroutine bool) error {
 		vm.PushString(s)
 
 		//fmt.Printf("good: found __eval (0x%x). it is at -2 of the stack, our running code at -1. running '%s'\n", eval, s)
-		if verb.VerboseVerbose {
+		if goro.lvm.cfg.veryVerbose() {
 			fmt.Printf("before vm.Call(1,0), stacks are:")
 			showLuaStacks(vm)
 		}
@@ -303,7 +360,7 @@ func (goro *Goro) privateRun(run []byte, useEvalCoroutine bool) error {
 		// to check for an error: dump the Lua stack.
 		// With high probability, it will yield clues to the problem.
 
-		if verb.VerboseVerbose {
+		if goro.lvm.cfg.veryVerbose() {
 			fmt.Printf("\nafter vm.Call(1,0), stacks are:\n")
 			showLuaStacks(vm)
 		}
@@ -0,0 +1,259 @@
+package compiler
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/gijit/gi/pkg/muse"
+	"github.com/gijit/gi/pkg/token"
+	"github.com/gijit/gi/pkg/types"
+)
+
+// hostPackage is a package of host Go values, made by
+// Interpreter.RegisterPackage, that interpreted code
+// can import like a shadowed one, but without a
+// generated shadow file.
+type hostPackage struct {
+	pkg     *types.Package
+	members map[string]interface{}
+}
+
+// Register makes the host Go value named name
+// available to interpreted code, with its full static
+// type: a func is declared as a func, and anything
+// else as a package level variable that refers to
+// the host's value rather than a copy of it. The
+// types it mentions, named ones included with their
+// methods, are built from reflect; see muse.Unpun.
+//
+// Values of the basic types have nothing to refer to,
+// and are copied as by Set.
+func (in *Interpreter) Register(name string, value interface{}) error {
+	in.mut.Lock()
+	defer in.mut.Unlock()
+	if in.lvm == nil {
+		return fmt.Errorf("Register called on a closed Interpreter")
+	}
+	if value == nil {
+		return fmt.Errorf("Register: cannot register untyped nil as '%s'", name)
+	}
+	if reservedKeywords[name] {
+		return fmt.Errorf("Register: '%s' is reserved in the Lua translation; use another name", name)
+	}
+	rt := reflect.TypeOf(value)
+	if _, basic := basicKinds[rt.Kind()]; basic && rt.PkgPath() == "" {
+		return in.set(name, value)
+	}
+
+	pkg, err := in.mainPkg()
+	if err != nil {
+		return err
+	}
+	obj, err := in.inc.hostObject(pkg, name, rt)
+	if err != nil {
+		return fmt.Errorf("Register: '%s': %v", name, err)
+	}
+	t := in.lvm.goro.newTicket("", false)
+	t.regmap[name] = value
+	err = t.Do()
+	if err != nil {
+		return fmt.Errorf("Register: '%s': %v", name, err)
+	}
+	err = in.inc.declareHostTypes()
+	if err != nil {
+		return fmt.Errorf("Register: '%s': %v", name, err)
+	}
+	pkg.Scope().Replace(obj)
+	return nil
+}
+
+// RegisterPackage makes the host Go values in members
+// into a virtual package, imported by interpreted code
+// as `import "path"`. The members' types are built as
+// for Register, and any named types from the same path
+// can be named by interpreted code too, as host.T.
+// Registering the same path again replaces the members,
+// for imports that follow.
+func (in *Interpreter) RegisterPackage(path string, members map[string]interface{}) error {
+	in.mut.Lock()
+	defer in.mut.Unlock()
+	if in.lvm == nil {
+		return fmt.Errorf("RegisterPackage called on a closed Interpreter")
+	}
+	return in.registerPackage(path, members)
+}
+
+func (in *Interpreter) registerPackage(path string, members map[string]interface{}) error {
+	ic := in.inc
+	pkg := ic.getMuse().Package(path)
+	if old, ok := ic.hostPkgs[path]; ok {
+		for k := range old.members {
+			pkg.Scope().DeleteByName(k)
+		}
+	}
+
+	// sorted, so that errors come out the same each time.
+	var names []string
+	for k := range members {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	var objs []types.Object
+	for _, k := range names {
+		v := members[k]
+		if v == nil {
+			return fmt.Errorf("RegisterPackage: '%s.%s' is untyped nil", pkg.Name(), k)
+		}
+		obj, err := ic.hostObject(pkg, k, reflect.TypeOf(v))
+		if err != nil {
+			return fmt.Errorf("RegisterPackage: '%s.%s': %v", pkg.Name(), k, err)
+		}
+		objs = append(objs, obj)
+	}
+	err := ic.declareHostTypes()
+	if err != nil {
+		return fmt.Errorf("RegisterPackage: '%s': %v", path, err)
+	}
+	for _, obj := range objs {
+		pkg.Scope().Replace(obj)
+	}
+
+	if ic.hostPkgs == nil {
+		ic.hostPkgs = make(map[string]*hostPackage)
+	}
+	ic.hostPkgs[path] = &hostPackage{pkg: pkg, members: members}
+	return nil
+}
+
+// mainPkg is the interpreted main package, which
+// only exists once something has been translated.
+func (in *Interpreter) mainPkg() (*types.Package, error) {
+	if in.inc.CurPkg.Arch == nil {
+		_, err := TranslateAndCatchPanic(in.inc, []byte(""))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return in.inc.CurPkg.Arch.Pkg, nil
+}
+
+// getMuse gives the IncrState's converter of host
+// types, one per IncrState so that a named host type
+// is the same type every time it is met.
+func (ic *IncrState) getMuse() *muse.Muse {
+	if ic.muse == nil {
+		ic.muse = muse.NewMuse()
+	}
+	return ic.muse
+}
+
+// hostObject makes the object for name, of the Go
+// type rt, in pkg.
+func (ic *IncrState) hostObject(pkg *types.Package, name string, rt reflect.Type) (types.Object, error) {
+	typ, err := ic.getMuse().Unpun(rt)
+	if err != nil {
+		return nil, err
+	}
+	if sig, ok := typ.(*types.Signature); ok && rt.Name() == "" {
+		return types.NewFunc(token.NoPos, pkg, name, sig), nil
+	}
+	return types.NewVar(token.NoPos, pkg, name, typ), nil
+}
+
+// hostTypeLua declares a named host type to the Lua
+// runtime, as the shadow packages do theirs: a wrapper
+// whose call makes a new value, or copies one. The
+// translation names it __type__.__packages[path].T, or
+// __type__.pkg.T once the package is imported.
+const hostTypeLua = `
+__packages[%[1]q] = __packages[%[1]q] or {};
+__packages[%[1]q][%[2]q] = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = %[2]q,
+ __str = %[2]q,
+ exported = true,
+ __call = function(t, src)
+   return __gijit_hostCtor[%[3]q](src)
+ end,
+};
+setmetatable(__packages[%[1]q][%[2]q], __packages[%[1]q][%[2]q]);
+`
+
+// declareHostTypes declares the named types that Unpun
+// has made since it was last called.
+func (ic *IncrState) declareHostTypes() error {
+	if ic.hostTypes == nil {
+		ic.hostTypes = make(map[*types.Named]bool)
+	}
+	var todo []reflect.Type
+	named := ic.getMuse().NamedTypes()
+	for rt, nt := range named {
+		if !ic.hostTypes[nt] {
+			todo = append(todo, rt)
+		}
+	}
+	if len(todo) == 0 {
+		return nil
+	}
+	sort.Slice(todo, func(i, j int) bool {
+		return named[todo[i]].String() < named[todo[j]].String()
+	})
+
+	var lua bytes.Buffer
+	lua.WriteString("__gijit_hostCtor = __gijit_hostCtor or {};\n")
+	t := ic.goro.newTicket("", false)
+	t.regns = "__gijit_hostCtor"
+	for _, rt := range todo {
+		nt := named[rt]
+		key := nt.String()
+		fmt.Fprintf(&lua, hostTypeLua, nt.Obj().Pkg().Path(), nt.Obj().Name(), key)
+		t.regmap[key] = hostCtor(rt)
+	}
+	t.run = lua.Bytes()
+	err := t.Do()
+	if err != nil {
+		return err
+	}
+	for _, rt := range todo {
+		ic.hostTypes[named[rt]] = true
+	}
+	return nil
+}
+
+// hostCtor gives a func(src *T) *T that copies *src, or
+// makes a zero T when src is nil; see the __ctor__
+// functions of the shadow packages.
+func hostCtor(rt reflect.Type) interface{} {
+	pt := reflect.PtrTo(rt)
+	ft := reflect.FuncOf([]reflect.Type{pt}, []reflect.Type{pt}, false)
+	return reflect.MakeFunc(ft, func(args []reflect.Value) []reflect.Value {
+		p := reflect.New(rt)
+		if !args[0].IsNil() {
+			p.Elem().Set(args[0].Elem())
+		}
+		return []reflect.Value{p}
+	}).Interface()
+}
+
+// hostArchive is the type checking side of importing
+// the host package hp; see CompileTimeGiImportFunc.
+func (ic *IncrState) hostArchive(path string, hp *hostPackage, code []byte) *Archive {
+	a := &Archive{
+		SavedArchive: SavedArchive{
+			ImportPath: path,
+		},
+		NewCodeText: [][]byte{code},
+		Pkg:         hp.pkg,
+	}
+	a.Pkg.ClientExtra = a
+	ic.CurPkg.importContext.Packages[path] = hp.pkg
+	ic.Session.Archives[path] = a
+
+	// its struct values are made by the type's wrapper,
+	// as a shadow package's are; see zeroValue.
+	ic.CurPkg.importContext.BinaryPackages[path] = true
+	return a
+}
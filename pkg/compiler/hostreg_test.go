@@ -0,0 +1,87 @@
+package compiler
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+type hostCounter struct {
+	N int
+}
+
+func (c *hostCounter) Add(k int) int {
+	c.N += k
+	return c.N
+}
+
+func Test1615RegisterHostValuesWithTheirTypes(t *testing.T) {
+
+	cv.Convey("host funcs and values registered with an Interpreter are type checked, and refer to the host's", t, func() {
+		in, err := NewInterpreter(nil)
+		panicOn(err)
+		defer in.Close()
+		ctx := context.Background()
+
+		panicOn(in.Register("twice", func(x int) int { return 2 * x }))
+		res, err := in.Eval(ctx, `twice(21)`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(res, cv.ShouldResemble, []interface{}{42})
+
+		_, err = in.Eval(ctx, `twice("a")`)
+		cv.So(err, cv.ShouldNotBeNil)
+		cv.So(err.Error(), cv.ShouldContainSubstring, `cannot convert "a"`)
+
+		c := &hostCounter{}
+		panicOn(in.Register("ctr", c))
+		_, err = in.Eval(ctx, `ctr.Add(3); ctr.Add(4)`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(c.N, cv.ShouldEqual, 7)
+
+		// the method set is known: Add takes an int.
+		_, err = in.Eval(ctx, `ctr.Add(1.5)`)
+		cv.So(err, cv.ShouldNotBeNil)
+		_, err = in.Eval(ctx, `ctr.Sub(1)`)
+		cv.So(err, cv.ShouldNotBeNil)
+
+		err = in.Register("double", c)
+		cv.So(err, cv.ShouldNotBeNil)
+	})
+
+	cv.Convey("host values registered as a package can be imported, with their types", t, func() {
+		in, err := NewInterpreter(nil)
+		panicOn(err)
+		defer in.Close()
+		ctx := context.Background()
+
+		c := &hostCounter{N: 5}
+		panicOn(in.RegisterPackage("example.com/host", map[string]interface{}{
+			"Upper":      strings.ToUpper,
+			"Counter":    c,
+			"NewCounter": func(n int) *hostCounter { return &hostCounter{N: n} },
+		}))
+		_, err = in.Eval(ctx, `import "example.com/host"`)
+		cv.So(err, cv.ShouldBeNil)
+
+		res, err := in.Eval(ctx, `host.Upper("ab")`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(res, cv.ShouldResemble, []interface{}{"AB"})
+
+		_, err = in.Eval(ctx, `w := host.NewCounter(9); n := w.Add(1) + host.Counter.Add(2)`)
+		cv.So(err, cv.ShouldBeNil)
+		n, err := in.Get("n")
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(n, cv.ShouldEqual, 17)
+		cv.So(c.N, cv.ShouldEqual, 7)
+
+		// a copy of a host struct is a new host value.
+		_, err = in.Eval(ctx, `v := *host.Counter; v.Add(100)`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(c.N, cv.ShouldEqual, 7)
+
+		_, err = in.Eval(ctx, `var s string = host.NewCounter(1)`)
+		cv.So(err, cv.ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,112 @@
+package compiler
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test1617InterpretedValuesSatisfyNativeInterfaces(t *testing.T) {
+
+	cv.Convey("an interpreted type with a Read method can be handed to bufio, io.Copy and ioutil.ReadAll", t, func() {
+		in, err := NewInterpreter(nil)
+		panicOn(err)
+		defer in.Close()
+		ctx := context.Background()
+
+		var sink bytes.Buffer
+		panicOn(in.RegisterPackage("iou", map[string]interface{}{
+			"ReadAll":   ioutil.ReadAll,
+			"Copy":      io.Copy,
+			"EOF":       io.EOF,
+			"NewReader": bufio.NewReader,
+			"Sink":      func() io.Writer { return &sink },
+		}))
+		_, err = in.Eval(ctx, `import "iou"`)
+		panicOn(err)
+		_, err = in.Eval(ctx, `
+type myReader struct{ n int }
+
+func (r *myReader) Read(p []byte) (int, error) {
+	if r.n >= 3 {
+		return 0, iou.EOF
+	}
+	p[0] = 'a' + byte(r.n)
+	r.n++
+	return 1, nil
+}`)
+		panicOn(err)
+
+		_, err = in.Eval(ctx, `b, e := iou.ReadAll(&myReader{})`)
+		cv.So(err, cv.ShouldBeNil)
+		res, err := in.Eval(ctx, `string(b)`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(res, cv.ShouldResemble, []interface{}{"abc"})
+		res, err = in.Eval(ctx, `e == nil`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(res, cv.ShouldResemble, []interface{}{true})
+
+		_, err = in.Eval(ctx, `line, _ := iou.NewReader(&myReader{}).ReadString('b')`)
+		cv.So(err, cv.ShouldBeNil)
+		res, err = in.Eval(ctx, `line`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(res, cv.ShouldResemble, []interface{}{"ab"})
+
+		_, err = in.Eval(ctx, `n, _ := iou.Copy(iou.Sink(), &myReader{})`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(sink.String(), cv.ShouldEqual, "abc")
+	})
+
+	cv.Convey("interpreted Stringers and errors are seen as such by native code", t, func() {
+		in, err := NewInterpreter(nil)
+		panicOn(err)
+		defer in.Close()
+		ctx := context.Background()
+
+		panicOn(in.Register("sprint", fmt.Sprint))
+		panicOn(in.Register("errMsg", func(e error) string { return "E:" + e.Error() }))
+		_, err = in.Eval(ctx, `
+type named struct{ s string }
+
+func (v named) String() string { return "named:" + v.s }
+
+type myErr struct{ code int }
+
+func (e *myErr) Error() string { return "code " + sprint(e.code) }`)
+		panicOn(err)
+
+		res, err := in.Eval(ctx, `sprint(named{"x"}, " ", &named{"y"})`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(res, cv.ShouldResemble, []interface{}{"named:x named:y"})
+
+		res, err = in.Eval(ctx, `errMsg(&myErr{7})`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(res, cv.ShouldResemble, []interface{}{"E:code 7"})
+
+		res, err = in.Eval(ctx, `sprint(&myErr{8})`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(res, cv.ShouldResemble, []interface{}{"code 8"})
+
+		// a value with other methods goes as itself, as
+		// one without methods does, not as the proxy of
+		// some interface it happens to have.
+		_, err = in.Eval(ctx, `
+type box struct{ A int }
+
+func (b *box) Close() error { return nil }
+
+type plain struct{ A int }`)
+		panicOn(err)
+		res, err = in.Eval(ctx, `sprint(&box{A: 5})`)
+		cv.So(err, cv.ShouldBeNil)
+		asPlain, err := in.Eval(ctx, `sprint(&plain{A: 5})`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(res, cv.ShouldResemble, asPlain)
+	})
+}
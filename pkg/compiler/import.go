@@ -6,6 +6,7 @@ import (
 	"path"
 	"reflect"
 	"runtime/debug"
+	"sort"
 	"strings"
 
 	"github.com/gijit/gi/pkg/importer"
@@ -18,6 +19,8 @@ import (
 	// shadow_ imports: available inside the REPL
 
 	shadow_bytes "github.com/gijit/gi/pkg/compiler/shadow/bytes"
+	shadow_context "github.com/gijit/gi/pkg/compiler/shadow/context"
+	shadow_encoding "github.com/gijit/gi/pkg/compiler/shadow/encoding"
 	shadow_encoding_binary "github.com/gijit/gi/pkg/compiler/shadow/encoding/binary"
 	shadow_errors "github.com/gijit/gi/pkg/compiler/shadow/errors"
 	shadow_fmt "github.com/gijit/gi/pkg/compiler/shadow/fmt"
@@ -61,9 +64,6 @@ import (
 	"gonum.org/v1/gonum/unit"
 )
 
-// distinguish binary imports from source imports
-var binaryPackage = make(map[string]bool)
-
 func init() {
 	a := 1
 	b := interface{}(&a)
@@ -83,6 +83,40 @@ var _ = optimize.ArmijoConditionMet
 var _ = stat.CDF
 var _ = unit.Atto
 
+// The shadow packages' proxy types let interpreted values
+// be passed where their native interfaces are wanted.
+// fmt's go first: for a value passed as an interface{},
+// the first registered wins a tie, and fmt is likeliest
+// to be looking.
+func init() {
+	for _, proxies := range []map[string]interface{}{
+		shadow_fmt.Proxy,
+		shadow_io.Proxy,
+		shadow_context.Proxy,
+		shadow_encoding.Proxy,
+		shadow_encoding_binary.Proxy,
+		shadow_math_rand.Proxy,
+		shadow_os.Proxy,
+		shadow_runtime.Proxy,
+		shadow_sync.Proxy,
+		shadow_blas.Proxy,
+		shadow_graph.Proxy,
+		shadow_lapack.Proxy,
+		shadow_mat.Proxy,
+		shadow_optimize.Proxy,
+		shadow_unit.Proxy,
+	} {
+		var names []string
+		for nm := range proxies {
+			names = append(names, nm)
+		}
+		sort.Strings(names)
+		for _, nm := range names {
+			luar.RegisterInterfaceProxy(proxies[nm])
+		}
+	}
+}
+
 func registerLuarReqs(vm *golua.State) {
 	// channel ops need reflect, so import it always.
 
@@ -99,7 +133,7 @@ func registerLuarReqs(vm *golua.State) {
 	})
 
 	registerBasicReflectTypes(vm)
-
+	registerChanBridge(vm)
 }
 
 func (ic *IncrState) EnableImportsFromLua() {
@@ -132,6 +166,7 @@ func (ic *IncrState) EnableImportsFromLua() {
 		"__go_run_import":     goRunImportFromLua,
 		"__go_compile_import": goCompileImportFromLua,
 		"__stacks":            stacksClosure,
+		"__gijit_goLine":      ic.srcLines.goLine,
 	})
 
 	// Enable __zygo() calls. Type checking established
@@ -141,6 +176,9 @@ func (ic *IncrState) EnableImportsFromLua() {
 	// the toplevel Go scope.
 	//
 	ic.zlisp = initZygo()
+	if sb := ic.cfg.Sandbox; sb != nil && !sb.AllowZygo {
+		return
+	}
 	luar.Register(ic.goro.vm, "", luar.Map{
 		"__zygo": func(s string) (interface{}, error) {
 			return callZygo(ic.zlisp, s)
@@ -172,6 +210,14 @@ func (ic *IncrState) RunTimeGiImportFunc(path, pkgDir string, depth int) error {
 	useEvalCoroutine := false
 	t0 := ic.goro.newTicket("", useEvalCoroutine)
 
+	if hp, ok := ic.hostPkgs[path]; ok {
+		base := omitAnyShadowPathPrefix(path, true)
+		t0.regmap[base] = hp.members
+		t0.run = []byte(fmt.Sprintf("__packages[%[1]q] = __packages[%[1]q] or {};\n__type__.%[2]s = __packages[%[1]q];\n", path, base))
+		t0.run = append(t0.run, schedOverride(path, base)...)
+		return t0.Do()
+	}
+
 	var srcImport bool
 	switch path {
 	case "gitesting":
@@ -195,6 +241,12 @@ func (ic *IncrState) RunTimeGiImportFunc(path, pkgDir string, depth int) error {
 		t0.regmap["__ctor__bytes"] = shadow_bytes.Ctor
 		t0.run = append(t0.run, shadow_bytes.InitLua()...)
 
+	case "context":
+		t0.regmap["context"] = shadow_context.Pkg
+		t0.regmap["__ctor__context"] = shadow_context.Ctor
+		t0.run = append(t0.run, shadow_context.InitLua()...)
+		t0.run = append(t0.run, schedOverride(path, "context")...)
+
 	case "encoding/binary":
 		t0.regmap["binary"] = shadow_encoding_binary.Pkg
 		t0.regmap["__ctor__binary"] = shadow_encoding_binary.Ctor
@@ -207,7 +259,7 @@ func (ic *IncrState) RunTimeGiImportFunc(path, pkgDir string, depth int) error {
 
 	case "fmt":
 		pp("RunTimeGiImportFunc sees 'fmt', known and shadowed.")
-		t0.regmap["fmt"] = shadow_fmt.Pkg
+		t0.regmap["fmt"] = shadowFmtFor(ic.cfg)
 		t0.regmap["__ctor__fmt"] = shadow_fmt.Ctor
 		t0.run = append(t0.run, shadow_fmt.InitLua()...)
 	case "io":
@@ -223,7 +275,7 @@ func (ic *IncrState) RunTimeGiImportFunc(path, pkgDir string, depth int) error {
 		t0.regmap["__ctor__math_rand"] = shadow_math_rand.Ctor
 		t0.run = append(t0.run, shadow_math_rand.InitLua()...)
 	case "os":
-		t0.regmap["os"] = shadow_os.Pkg
+		t0.regmap["os"] = shadowOsFor(ic.cfg, ic.args)
 		t0.regmap["__ctor__os"] = shadow_os.Ctor
 		t0.run = append(t0.run, shadow_os.InitLua()...)
 
@@ -241,16 +293,19 @@ func (ic *IncrState) RunTimeGiImportFunc(path, pkgDir string, depth int) error {
 		t0.regmap["sync"] = shadow_sync.Pkg
 		t0.regmap["__ctor__sync"] = shadow_sync.Ctor
 		t0.run = append(t0.run, shadow_sync.InitLua()...)
+		t0.run = append(t0.run, schedOverride(path, "sync")...)
 
 	case "sync/atomic":
 		t0.regmap["atomic"] = shadow_sync_atomic.Pkg
 		t0.regmap["__ctor__atomic"] = shadow_sync_atomic.Ctor
 		t0.run = append(t0.run, shadow_sync_atomic.InitLua()...)
+		t0.run = append(t0.run, schedOverride(path, "atomic")...)
 
 	case "time":
 		t0.regmap["time"] = shadow_time.Pkg
 		t0.regmap["__ctor__time"] = shadow_time.Ctor
 		t0.run = append(t0.run, shadow_time.InitLua()...)
+		t0.run = append(t0.run, schedOverride(path, "time")...)
 
 	case "runtime":
 		t0.regmap["runtime"] = shadow_runtime.Pkg
@@ -306,7 +361,7 @@ func (ic *IncrState) RunTimeGiImportFunc(path, pkgDir string, depth int) error {
 		t0.run = []byte(fmt.Sprintf("%s.__init();", omitAnyShadowPathPrefix(path, true)))
 	}
 	if !srcImport {
-		binaryPackage[path] = true
+		ic.CurPkg.importContext.BinaryPackages[path] = true
 	}
 	err := t0.Do()
 	pp("RunTimeGiImportFunc executed t0.Do() to run: '%s', got back err='%v'", string(t0.run), err)
@@ -325,6 +380,10 @@ func (ic *IncrState) CompileTimeGiImportFunc(path, pkgDir string, depth int) (*A
 
 	// `import "fmt"` means that path == "fmt", for example.
 
+	if err := ic.cfg.Sandbox.checkImport(path); err != nil {
+		return nil, err
+	}
+
 	// check cache first
 	arch, ok := ic.Session.Archives[path]
 	_, _ = arch, ok
@@ -339,12 +398,17 @@ func (ic *IncrState) CompileTimeGiImportFunc(path, pkgDir string, depth int) (*A
 	code := []byte(fmt.Sprintf("\t __go_run_import(\"%[1]s\");\n\t __type__.%[2]s = __type__.%[2]s or {};\n", omitAnyShadowPathPrefix(path, false), omitAnyShadowPathPrefix(path, true)))
 	//code := []byte(fmt.Sprintf("\t __go_run_import(\"%[1]s\");\n\t __type__.%[2]s = __type__.%[2]s or {};\n\t local %[2]s = _G.%[2]s;\n", omitAnyShadowPathPrefix(path, false), omitAnyShadowPathPrefix(path, true)))
 
+	if hp, ok := ic.hostPkgs[path]; ok {
+		return ic.hostArchive(path, hp, code), nil
+	}
+
 	switch path {
 
 	// all these are shadowed, see below for the load of type checking info.
 
 	// gen-gijit-shadow outputs to pkg/compiler/shadow/...
 	case "bytes":
+	case "context":
 	case "encoding/binary":
 	case "errors":
 	case "fmt":
@@ -83,6 +83,8 @@ type GONZAGA interface {
 	error
 }
 
+// Fish reports how many fish are caught
+// with numPole poles in the water.
 func Fish(numPole int) (fishCaught int) {
 	return numPole * %v
 }
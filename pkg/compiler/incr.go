@@ -22,6 +22,13 @@ import (
 )
 
 func addPreludeToNewPkg(pkg *types.Package) {
+	addSandboxedPrelude(pkg, nil)
+}
+
+// addSandboxedPrelude leaves out __lua and __zygo if
+// the sandbox sb forbids them, so using them is an
+// undeclared name; sb may be nil, meaning no sandbox.
+func addSandboxedPrelude(pkg *types.Package, sb *SandboxPolicy) {
 	//
 	// allow static type checking of the __gijit_printQuoted
 	// REPL utility function. It wraps strings
@@ -30,8 +37,12 @@ func addPreludeToNewPkg(pkg *types.Package) {
 	scope := pkg.Scope()
 	scope.Insert(getFunForGijitPrintQuoted(pkg))
 
-	scope.Insert(getFunFor__callLua(pkg))
-	scope.Insert(getFunFor__callZygo(pkg))
+	if sb == nil || sb.AllowLua {
+		scope.Insert(getFunFor__callLua(pkg))
+	}
+	if sb == nil || sb.AllowZygo {
+		scope.Insert(getFunFor__callZygo(pkg))
+	}
 
 	// allow tostring from Go, to call the Lua builtin.
 	scope.Insert(getFunFor__tostring(pkg))
@@ -54,6 +65,7 @@ func IncrementallyCompile(a *Archive, importPath string, files []*ast.File, file
 	}
 
 	var newCodeText [][]byte
+	var newDeclText [][]byte
 	var funcSrcCache map[string]string
 
 	var typesInfo *types.Info
@@ -108,7 +120,7 @@ func IncrementallyCompile(a *Archive, importPath string, files []*ast.File, file
 		check = a.Check
 	}
 	var err error
-	prelude := addPreludeToNewPkg
+	prelude := newPkgPrelude(importContext.Sandbox)
 	if importPath != "main" {
 		prelude = nil
 	}
@@ -182,6 +194,7 @@ func IncrementallyCompile(a *Archive, importPath string, files []*ast.File, file
 			typeDepend:        NewDFSState(),
 			typeDefineLuaCode: make(map[types.Object]string),
 			importedPackages:  make(map[string]*types.Package),
+			binaryPackage:     importContext.BinaryPackages,
 
 			Info:                 pkgInfo,
 			additionalSelections: make(map[*ast.SelectorExpr]selection),
@@ -263,6 +276,7 @@ func IncrementallyCompile(a *Archive, importPath string, files []*ast.File, file
 		n := len(importDecls)
 		pp("latest Decl's DeclCode is '%s'", string(importDecls[n-1].DeclCode))
 		newCodeText = append(newCodeText, newCode.Bytes())
+		newDeclText = append(newDeclText, newCode.Bytes())
 	}
 
 	collectDependencies := func(f func()) []string {
@@ -328,8 +342,14 @@ func IncrementallyCompile(a *Archive, importPath string, files []*ast.File, file
 				var by bytes.Buffer
 				err = printer.Fprint(&by, fileSet, d)
 				panicOn(err)
-				funcSrcCache[d.Name.Name] = by.String()
-				pp("stored in c.p.funcSrcCache['%s'] the value '%s'", d.Name.Name, funcSrcCache[d.Name.Name])
+				// methods are kept as Type.Method, so they
+				// don't replace funcs of the same name.
+				srcKey := d.Name.Name
+				if d.Recv != nil && len(d.Recv.List) == 1 {
+					srcKey = recvTypeName(d.Recv.List[0].Type) + "." + srcKey
+				}
+				funcSrcCache[srcKey] = by.String()
+				pp("stored in c.p.funcSrcCache['%s'] the value '%s'", srcKey, funcSrcCache[srcKey])
 
 				//pp("with AST:")
 				//if verb.Verbose {
@@ -399,6 +419,7 @@ func IncrementallyCompile(a *Archive, importPath string, files []*ast.File, file
 					funcDecls = append(funcDecls, &de)
 					pp("place3, appending to newCodeText: de.DeclCode='%s'", string(de.DeclCode))
 					newCodeText = append(newCodeText, de.DeclCode)
+					newDeclText = append(newDeclText, de.DeclCode)
 
 					// end of function codegen now
 				}
@@ -436,6 +457,7 @@ func IncrementallyCompile(a *Archive, importPath string, files []*ast.File, file
 						// interface Dog codegen here
 						decl, by := c.oneNamedType(collectDependencies, o)
 						newCodeText = append(newCodeText, by)
+						newDeclText = append(newDeclText, by)
 						typeDecls = append(typeDecls, decl)
 						pp("named type codegen for '%s' generated: '%s'", o, string(by))
 					}
@@ -474,7 +496,7 @@ func IncrementallyCompile(a *Archive, importPath string, files []*ast.File, file
 										// test 923, name='tm'
 										var x string
 										typStr := o.Type().String()
-										if isShad, typShortName := isShadowStruct(typStr); isShad {
+										if isShad, typShortName := c.p.isShadowStruct(typStr); isShad {
 											//vv("type '%s' is a binary struct", typStr)
 											// binary, call the ctor
 											// ex: __type__.time.Time()
@@ -752,6 +774,7 @@ func IncrementallyCompile(a *Archive, importPath string, files []*ast.File, file
 				Minified:     minify,
 			},
 			NewCodeText:  newCodeText,
+			NewDeclText:  newDeclText,
 			TypesInfo:    typesInfo,
 			Config:       config,
 			Check:        check,
@@ -762,6 +785,7 @@ func IncrementallyCompile(a *Archive, importPath string, files []*ast.File, file
 		a.Pkg = pkg
 		a.Check = check
 		a.NewCodeText = newCodeText
+		a.NewDeclText = newDeclText
 		a.FuncSrcCache = funcSrcCache
 	}
 	return a, nil
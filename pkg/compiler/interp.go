@@ -0,0 +1,499 @@
+package compiler
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gijit/gi/pkg/types"
+	"github.com/glycerine/luar"
+)
+
+// Interpreter is gijit for embedding: Go source goes in
+// through Eval, and Go values come back out, rather than
+// printed text. Set and Get move values between the host
+// program and the interpreter's globals.
+//
+// An Interpreter is safe for use by multiple
+// goroutines; its calls run one at a time.
+type Interpreter struct {
+	mut sync.Mutex
+	cfg *GIConfig
+	lvm *LuaVm
+	inc *IncrState
+
+	// the workers for gi.Go, once Parallel is called.
+	pool *parallelPool
+}
+
+// interpLuaSetup quiets the interpreter: an expression's
+// value is returned by Eval instead of printed, and an
+// error reaches Eval as text, without a traceback on
+// stdout.
+const interpLuaSetup = `
+__gijit_printQuoted = function(...) end
+
+__errHandlerForEval = function(err)
+   __lastEvalErr = tostring(err)
+   return err
+end
+
+__evalDeadlocked = function(co)
+   __lastEvalErr = __deadlockReport()
+end
+`
+
+// NewInterpreter starts an interpreter; cfg may be nil,
+// for the defaults. Call Close when done with it.
+func NewInterpreter(cfg *GIConfig) (*Interpreter, error) {
+	if cfg == nil {
+		cfg = NewGIConfig()
+	}
+	lvm, err := NewLuaVmWithPrelude(cfg)
+	if err != nil {
+		return nil, err
+	}
+	err = LuaRun(lvm, interpLuaSetup, false)
+	if err != nil {
+		lvm.Close()
+		return nil, fmt.Errorf("could not set up the interpreter: '%v'", err)
+	}
+	return &Interpreter{
+		cfg: cfg,
+		lvm: lvm,
+		inc: NewIncrState(lvm, cfg),
+	}, nil
+}
+
+// Close frees the interpreter's LuaJIT state.
+func (in *Interpreter) Close() {
+	in.mut.Lock()
+	defer in.mut.Unlock()
+	if in.pool != nil {
+		in.pool.close()
+		in.pool = nil
+	}
+	if in.lvm != nil {
+		in.lvm.Close()
+		in.lvm = nil
+	}
+}
+
+// Eval type checks and runs src, a Go statement,
+// expression, or declarations, as typed at the gi
+// prompt. If src is an expression, Eval returns its
+// values. Compile errors and runtime panics are
+// returned as errors. If ctx is done before src
+// finishes, the running code panics("interrupted"), so
+// its defers run, and Eval returns ctx.Err(); the
+// interpreter stays usable.
+func (in *Interpreter) Eval(ctx context.Context, src string) ([]interface{}, error) {
+	in.mut.Lock()
+	defer in.mut.Unlock()
+	if in.lvm == nil {
+		return nil, fmt.Errorf("Eval called on a closed Interpreter")
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	translation, err := TranslateAndCatchPanic(in.inc, []byte(src))
+	if err != nil {
+		return nil, err
+	}
+	err = LuaRun(in.lvm, "__gijit_ans = nil", false)
+	if err != nil {
+		return nil, err
+	}
+	err = in.runUntilDone(ctx, translation)
+	if err != nil {
+		return nil, err
+	}
+	return in.answers()
+}
+
+// runUntilDone runs translation on the eval coroutine,
+// interrupting it if ctx is done first, or a worker of
+// gi.Go panics.
+func (in *Interpreter) runUntilDone(ctx context.Context, translation string) error {
+	in.pool.learn(in.inc)
+	var mut sync.Mutex
+	running := true
+	interrupted := false
+	done := make(chan struct{})
+	failed := in.pool.failure()
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-failed:
+		case <-done:
+			return
+		}
+		mut.Lock()
+		if running {
+			in.lvm.vm.Interrupt()
+			interrupted = true
+		}
+		mut.Unlock()
+	}()
+
+	err := LuaRun(in.lvm, translation, true)
+
+	mut.Lock()
+	running = false
+	in.lvm.vm.ClearHook()
+	mut.Unlock()
+	close(done)
+
+	if interrupted {
+		rerr := LuaRun(in.lvm, "__task.reset_scheduler()", false)
+		if perr := in.pool.takeErr(); perr != nil {
+			err = perr
+		}
+		if err == nil {
+			err = rerr
+		}
+		if err == nil {
+			err = ctx.Err()
+		}
+	}
+	if err != nil {
+		return err
+	}
+	lastErr, err := lastEvalErr(in.lvm)
+	if err != nil {
+		return err
+	}
+	if lastErr != "" {
+		return fmt.Errorf("%s", lastErr)
+	}
+	return nil
+}
+
+// answers converts __gijit_ans, the values of an
+// expression just run, to Go, each with the Go type of
+// its checked type, as Get does, where there is one.
+func (in *Interpreter) answers() ([]interface{}, error) {
+	vm := in.lvm.vm
+	top := vm.GetTop()
+	defer vm.SetTop(top)
+	vm.GetGlobal("__gijit_ans")
+	if vm.IsNil(-1) {
+		return nil, nil
+	}
+	vm.GetField(-1, "__length")
+	n := vm.ToInteger(-1)
+	vm.GetField(-2, "__offset")
+	offset := vm.ToInteger(-1)
+	vm.GetField(-3, "__array")
+	array := vm.GetTop()
+
+	tys := in.inc.ansTypes
+	anyType := reflect.TypeOf((*interface{})(nil)).Elem()
+	ans := make([]interface{}, n)
+	for i := range ans {
+		rt := anyType
+		if len(tys) == n {
+			if _, isIface := tys[i].Underlying().(*types.Interface); !isIface {
+				if t, err := reflectTypeOf(tys[i]); err == nil {
+					rt = t
+				}
+			}
+		}
+		vm.RawGeti(array, offset+i)
+		val := reflect.New(rt)
+		// luar needs an absolute index for a slice.
+		_, err := luar.LuaToGo(vm, vm.GetTop(), val.Interface())
+		if err != nil {
+			return nil, fmt.Errorf("could not convert the value of the expression to Go: '%v'", err)
+		}
+		ans[i] = val.Elem().Interface()
+		vm.SetTop(array)
+	}
+	return ans, nil
+}
+
+// Get returns the value of the package level variable
+// name, converted to Go. Basic types, and slices,
+// arrays, and maps of them, come back with their Go
+// type; other values come back as luar makes them.
+func (in *Interpreter) Get(name string) (interface{}, error) {
+	in.mut.Lock()
+	defer in.mut.Unlock()
+	if in.lvm == nil {
+		return nil, fmt.Errorf("Get called on a closed Interpreter")
+	}
+	pkg, err := in.mainPkg()
+	if err != nil {
+		return nil, err
+	}
+	obj := pkg.Scope().Lookup(name)
+	v, ok := obj.(*types.Var)
+	if !ok {
+		return nil, fmt.Errorf("Get: no variable named '%s'", name)
+	}
+
+	rt, err := reflectTypeOf(v.Type())
+	if err != nil {
+		rt = reflect.TypeOf((*interface{})(nil)).Elem()
+	}
+	vm := in.lvm.vm
+	top := vm.GetTop()
+	defer vm.SetTop(top)
+	vm.GetGlobal(name)
+	val := reflect.New(rt)
+	_, err = luar.LuaToGo(vm, vm.GetTop(), val.Interface())
+	if err != nil {
+		return nil, fmt.Errorf("Get: could not convert '%s' to Go: '%v'", name, err)
+	}
+	return val.Elem().Interface(), nil
+}
+
+// Set declares the package level variable name, with
+// the Go type of value, and sets it to value, as if
+// `var name T = value` had been typed at the prompt;
+// the type checker knows it from then on. The value
+// must be of a basic type, or a slice, array, or map
+// of such, and is copied.
+func (in *Interpreter) Set(name string, value interface{}) error {
+	in.mut.Lock()
+	defer in.mut.Unlock()
+	if in.lvm == nil {
+		return fmt.Errorf("Set called on a closed Interpreter")
+	}
+	return in.set(name, value)
+}
+
+func (in *Interpreter) set(name string, value interface{}) error {
+	if value == nil {
+		return fmt.Errorf("Set: cannot set '%s' to untyped nil", name)
+	}
+	v := reflect.ValueOf(value)
+	typ, err := typeOfReflect(v.Type())
+	if err != nil {
+		return fmt.Errorf("Set: '%s': %v", name, err)
+	}
+	lit, err := goLiteral(v, typ)
+	if err != nil {
+		return fmt.Errorf("Set: '%s': %v", name, err)
+	}
+	src := fmt.Sprintf("var %s %s = %s", name, types.TypeString(typ, nil), lit)
+	translation, err := TranslateAndCatchPanic(in.inc, []byte(src))
+	if err != nil {
+		return err
+	}
+	return in.runUntilDone(context.Background(), translation)
+}
+
+var basicKinds = map[reflect.Kind]types.BasicKind{
+	reflect.Bool:       types.Bool,
+	reflect.Int:        types.Int,
+	reflect.Int8:       types.Int8,
+	reflect.Int16:      types.Int16,
+	reflect.Int32:      types.Int32,
+	reflect.Int64:      types.Int64,
+	reflect.Uint:       types.Uint,
+	reflect.Uint8:      types.Uint8,
+	reflect.Uint16:     types.Uint16,
+	reflect.Uint32:     types.Uint32,
+	reflect.Uint64:     types.Uint64,
+	reflect.Uintptr:    types.Uintptr,
+	reflect.Float32:    types.Float32,
+	reflect.Float64:    types.Float64,
+	reflect.Complex64:  types.Complex64,
+	reflect.Complex128: types.Complex128,
+	reflect.String:     types.String,
+}
+
+// typeOfReflect gives the type checker's type for the
+// unnamed Go type rt.
+func typeOfReflect(rt reflect.Type) (types.Type, error) {
+	if rt.Name() != "" && rt.PkgPath() != "" {
+		return nil, fmt.Errorf("named type %v is not known to the interpreter", rt)
+	}
+	if k, ok := basicKinds[rt.Kind()]; ok {
+		return types.Typ[k], nil
+	}
+	switch rt.Kind() {
+	case reflect.Slice:
+		elem, err := typeOfReflect(rt.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return types.NewSlice(elem), nil
+	case reflect.Array:
+		elem, err := typeOfReflect(rt.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return types.NewArray(elem, int64(rt.Len())), nil
+	case reflect.Map:
+		key, err := typeOfReflect(rt.Key())
+		if err != nil {
+			return nil, err
+		}
+		elem, err := typeOfReflect(rt.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return types.NewMap(key, elem), nil
+	}
+	return nil, fmt.Errorf("values of type %v cannot be copied into the interpreter", rt)
+}
+
+// reflectTypeOf is the inverse of typeOfReflect.
+func reflectTypeOf(t types.Type) (reflect.Type, error) {
+	switch t := t.(type) {
+	case *types.Basic:
+		for rk, k := range basicKinds {
+			if t.Kind() == k {
+				return reflectBasic(rk), nil
+			}
+		}
+	case *types.Slice:
+		elem, err := reflectTypeOf(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return reflect.SliceOf(elem), nil
+	case *types.Array:
+		elem, err := reflectTypeOf(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return reflect.ArrayOf(int(t.Len()), elem), nil
+	case *types.Map:
+		key, err := reflectTypeOf(t.Key())
+		if err != nil {
+			return nil, err
+		}
+		elem, err := reflectTypeOf(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return reflect.MapOf(key, elem), nil
+	}
+	return nil, fmt.Errorf("no Go type for %v", t)
+}
+
+func reflectBasic(k reflect.Kind) reflect.Type {
+	switch k {
+	case reflect.Bool:
+		return reflect.TypeOf(false)
+	case reflect.Int:
+		return reflect.TypeOf(int(0))
+	case reflect.Int8:
+		return reflect.TypeOf(int8(0))
+	case reflect.Int16:
+		return reflect.TypeOf(int16(0))
+	case reflect.Int32:
+		return reflect.TypeOf(int32(0))
+	case reflect.Int64:
+		return reflect.TypeOf(int64(0))
+	case reflect.Uint:
+		return reflect.TypeOf(uint(0))
+	case reflect.Uint8:
+		return reflect.TypeOf(uint8(0))
+	case reflect.Uint16:
+		return reflect.TypeOf(uint16(0))
+	case reflect.Uint32:
+		return reflect.TypeOf(uint32(0))
+	case reflect.Uint64:
+		return reflect.TypeOf(uint64(0))
+	case reflect.Uintptr:
+		return reflect.TypeOf(uintptr(0))
+	case reflect.Float32:
+		return reflect.TypeOf(float32(0))
+	case reflect.Float64:
+		return reflect.TypeOf(float64(0))
+	case reflect.Complex64:
+		return reflect.TypeOf(complex64(0))
+	case reflect.Complex128:
+		return reflect.TypeOf(complex128(0))
+	}
+	return reflect.TypeOf("")
+}
+
+// goLiteral writes v, of type typ, as Go source.
+func goLiteral(v reflect.Value, typ types.Type) (string, error) {
+	ts := types.TypeString(typ, nil)
+	switch v.Kind() {
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return fmt.Sprintf("%s(%d)", ts, v.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return fmt.Sprintf("%s(%d)", ts, v.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		f, err := floatLiteral(v.Float())
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s(%s)", ts, f), nil
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		re, err := floatLiteral(real(c))
+		if err != nil {
+			return "", err
+		}
+		im, err := floatLiteral(imag(c))
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s(complex(%s, %s))", ts, re, im), nil
+	case reflect.String:
+		return strconv.Quote(v.String()), nil
+	case reflect.Slice, reflect.Map:
+		if v.IsNil() {
+			return ts + "(nil)", nil
+		}
+	}
+
+	var elems []string
+	switch t := typ.(type) {
+	case *types.Slice, *types.Array:
+		var elemTyp types.Type
+		if s, ok := t.(*types.Slice); ok {
+			elemTyp = s.Elem()
+		} else {
+			elemTyp = t.(*types.Array).Elem()
+		}
+		for i := 0; i < v.Len(); i++ {
+			e, err := goLiteral(v.Index(i), elemTyp)
+			if err != nil {
+				return "", err
+			}
+			elems = append(elems, e)
+		}
+	case *types.Map:
+		for _, key := range v.MapKeys() {
+			k, err := goLiteral(key, t.Key())
+			if err != nil {
+				return "", err
+			}
+			e, err := goLiteral(v.MapIndex(key), t.Elem())
+			if err != nil {
+				return "", err
+			}
+			elems = append(elems, k+": "+e)
+		}
+		// keep the source, and so the translation, the
+		// same from run to run.
+		sort.Strings(elems)
+	default:
+		return "", fmt.Errorf("values of type %v cannot be copied into the interpreter", v.Type())
+	}
+	return ts + "{" + strings.Join(elems, ", ") + "}", nil
+}
+
+func floatLiteral(f float64) (string, error) {
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return "", fmt.Errorf("%v has no Go literal", f)
+	}
+	s := strconv.FormatFloat(f, 'g', -1, 64)
+	return s, nil
+}
@@ -0,0 +1,148 @@
+package compiler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test1612InterpreterEvalGetSet(t *testing.T) {
+
+	cv.Convey("an Interpreter returns the Go values of expressions, and moves variables in and out with Get and Set", t, func() {
+		in, err := NewInterpreter(nil)
+		panicOn(err)
+		defer in.Close()
+		ctx := context.Background()
+
+		res, err := in.Eval(ctx, `x := 20; s := "hi"; fl := 1.5`)
+		panicOn(err)
+		cv.So(len(res), cv.ShouldEqual, 0)
+
+		res, err = in.Eval(ctx, `x + 22`)
+		panicOn(err)
+		cv.So(res, cv.ShouldResemble, []interface{}{42})
+
+		res, err = in.Eval(ctx, `s + " there"`)
+		panicOn(err)
+		cv.So(res, cv.ShouldResemble, []interface{}{"hi there"})
+
+		x, err := in.Get("x")
+		panicOn(err)
+		cv.So(x, cv.ShouldEqual, 20)
+		s, err := in.Get("s")
+		panicOn(err)
+		cv.So(s, cv.ShouldEqual, "hi")
+
+		panicOn(in.Set("n", 7))
+		panicOn(in.Set("list", []int{1, 2, 3}))
+		panicOn(in.Set("ages", map[string]float64{"a": 1.5, "b": 2}))
+		res, err = in.Eval(ctx, `n * 6`)
+		panicOn(err)
+		cv.So(res, cv.ShouldResemble, []interface{}{42})
+
+		_, err = in.Eval(ctx, `tot := 0; for _, v := range list { tot += v }; nage := len(ages); a := ages["a"]`)
+		panicOn(err)
+		tot, err := in.Get("tot")
+		panicOn(err)
+		cv.So(tot, cv.ShouldEqual, 6)
+		nage, err := in.Get("nage")
+		panicOn(err)
+		cv.So(nage, cv.ShouldEqual, 2)
+		a, err := in.Get("a")
+		panicOn(err)
+		cv.So(a, cv.ShouldEqual, 1.5)
+		list, err := in.Get("list")
+		panicOn(err)
+		cv.So(list, cv.ShouldResemble, []int{1, 2, 3})
+
+		// the checker knows the type from Set.
+		_, err = in.Eval(ctx, `n = "seven"`)
+		cv.So(err, cv.ShouldNotBeNil)
+		_, err = in.Get("nope")
+		cv.So(err.Error(), cv.ShouldContainSubstring, "no variable named 'nope'")
+	})
+
+	cv.Convey("Eval gives an expression's values the Go types of their checked types, as Get does", t, func() {
+		in, err := NewInterpreter(nil)
+		panicOn(err)
+		defer in.Close()
+		ctx := context.Background()
+
+		_, err = in.Eval(ctx, `var b byte = 7; var f32 float32 = 1.5; xs := []int{1, 2}; var any interface{} = 3`)
+		panicOn(err)
+		for _, name := range []string{"b", "f32", "xs"} {
+			res, err := in.Eval(ctx, name)
+			panicOn(err)
+			v, err := in.Get(name)
+			panicOn(err)
+			cv.So(res, cv.ShouldResemble, []interface{}{v})
+		}
+		res, err := in.Eval(ctx, `= b, f32, xs, len(xs)`)
+		panicOn(err)
+		cv.So(res, cv.ShouldResemble, []interface{}{byte(7), float32(1.5), []int{1, 2}, 2})
+
+		// what an interface holds keeps the type luar gives it.
+		res, err = in.Eval(ctx, `any`)
+		panicOn(err)
+		cv.So(res, cv.ShouldResemble, []interface{}{int64(3)})
+	})
+
+	cv.Convey("Eval returns runtime panics as errors, and stops when its context is done", t, func() {
+		in, err := NewInterpreter(nil)
+		panicOn(err)
+		defer in.Close()
+
+		_, err = in.Eval(context.Background(), `panic("boom")`)
+		cv.So(err, cv.ShouldNotBeNil)
+		cv.So(err.Error(), cv.ShouldContainSubstring, "boom")
+
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+		_, err = in.Eval(ctx, `n := 0; for { n++ }`)
+		cv.So(err == context.DeadlineExceeded, cv.ShouldBeTrue)
+
+		res, err := in.Eval(context.Background(), `n > 0`)
+		panicOn(err)
+		cv.So(res, cv.ShouldResemble, []interface{}{true})
+	})
+}
+
+func Test1613ManyInterpretersRunInParallel(t *testing.T) {
+
+	cv.Convey("dozens of interpreters, each on its own thread, run at once without seeing each other's globals", t, func() {
+		const n = 32
+		errs := make(chan error, n)
+		got := make([]interface{}, n)
+		for i := 0; i < n; i++ {
+			go func(i int) {
+				in, err := NewInterpreter(nil)
+				if err != nil {
+					errs <- err
+					return
+				}
+				defer in.Close()
+				ctx := context.Background()
+				err = in.Set("me", i)
+				if err == nil {
+					_, err = in.Eval(ctx, `type acc struct { sum int }; a := &acc{}; for j := 0; j < 1000; j++ { a.sum += me }`)
+				}
+				if err == nil {
+					var res []interface{}
+					res, err = in.Eval(ctx, `a.sum`)
+					if err == nil {
+						got[i] = res[0]
+					}
+				}
+				errs <- err
+			}(i)
+		}
+		for i := 0; i < n; i++ {
+			cv.So(<-errs, cv.ShouldBeNil)
+		}
+		for i := 0; i < n; i++ {
+			cv.So(got[i], cv.ShouldEqual, 1000*i)
+		}
+	})
+}
@@ -0,0 +1,202 @@
+package compiler
+
+import (
+	"fmt"
+	"time"
+)
+
+// limitsLuaSetup checks an eval's instruction and heap
+// budget, from the count hook that runLimited sets.
+// A limit broken is a runtime error naming the limit,
+// which the eval may recover from to run its defers,
+// but the limit stays broken: every check after raises
+// it again, until the eval returns. The time limit
+// arrives as an interrupt, which runLimited repeats.
+// An interrupt takes the count hook's place, so
+// __gijit_interrupt puts it back.
+//
+// The panic is marked __gijitLimit, so that, raised in
+// the scheduler, say while the eval sleeps, it is passed
+// up as an interrupt is; see is_interrupt in chan.lua.
+// __gijit_endLimits then resets the scheduler, and
+// reports a breach that escaped the eval coroutine,
+// which the eval could not have recovered from.
+const limitsLuaSetup = `
+__gijit_limits = nil
+
+local limitMT = {__tostring = function(v) return v[1] end}
+
+local function breach(lim, msg)
+   lim.broken = msg
+   if coroutine.running() ~= __gijitEvalCoro then
+      lim.escaped = true
+   end
+   __recoverVal = setmetatable({msg, __gijitLimit = true}, limitMT)
+   error(__recoverVal)
+end
+
+__gijit_checkLimits = function()
+   local lim = __gijit_limits
+   if lim == nil then return end
+   if lim.broken ~= nil then
+      breach(lim, lim.broken)
+   end
+   if lim.instr > 0 then
+      lim.left = lim.left - lim.period
+      if lim.left <= 0 then
+         breach(lim, "eval exceeded its limit of " .. lim.instr .. " instructions")
+      end
+   end
+   if lim.heapKB > 0 and collectgarbage("count") > lim.heapKB then
+      collectgarbage()
+      if collectgarbage("count") > lim.heapKB then
+         breach(lim, "eval exceeded its heap limit of " .. lim.heap .. " bytes")
+      end
+   end
+end
+
+__gijit_ctrlC = __gijit_interrupt
+__gijit_interrupt = function()
+   __gijit_rearmLimits()
+   local hit = __gijit_limitHit()
+   if hit ~= "" and __gijit_limits ~= nil then
+      breach(__gijit_limits, hit)
+   end
+   __gijit_ctrlC()
+end
+
+__gijit_endLimits = function()
+   local lim = __gijit_limits
+   __gijit_limits = nil
+   if lim == nil or lim.broken == nil then return end
+   __task.reset_scheduler()
+   if type(__lastEvalErr) == "table" then
+      __lastEvalErr = tostring(__lastEvalErr)
+   elseif lim.escaped and (__lastEvalErr == nil or __lastEvalErr == "") then
+      __errHandlerForEval(lim.broken)
+   end
+end
+`
+
+// limitCheckEvery is how many instructions run between
+// checks of the instruction and heap limits. So the
+// heap limit is a soft cap: an eval may allocate past
+// it by what it can in that many instructions, before
+// it is stopped.
+const limitCheckEvery = 10000
+
+// limitRefire is how often the time limit's interrupt
+// is repeated, once the limit is broken, until the
+// eval returns.
+const limitRefire = 20 * time.Millisecond
+
+func (c *GIConfig) hasEvalLimits() bool {
+	return c != nil && (c.EvalTimeout > 0 || c.EvalMaxInstructions > 0 || c.EvalMaxHeapBytes > 0)
+}
+
+// limitHit tells __gijit_interrupt which limit, if any,
+// the interrupt is for; "" means Ctrl-C.
+func (lvm *LuaVm) limitHit() string {
+	lvm.mut.Lock()
+	defer lvm.mut.Unlock()
+	return lvm.hit
+}
+
+// rearmLimits puts the count hook back, if the eval
+// running has one, after an interrupt took its place.
+func (lvm *LuaVm) rearmLimits() {
+	lvm.mut.Lock()
+	defer lvm.mut.Unlock()
+	if lvm.running && lvm.checkEvery > 0 {
+		lvm.vm.SetLimitCheck(lvm.checkEvery)
+	}
+}
+
+func (lvm *LuaVm) setupLimits() error {
+	if lvm.limitsReady {
+		return nil
+	}
+	tk := lvm.goro.newTicket(limitsLuaSetup, false)
+	tk.regmap["__gijit_limitHit"] = lvm.limitHit
+	tk.regmap["__gijit_rearmLimits"] = lvm.rearmLimits
+	err := tk.Do()
+	if err != nil {
+		return fmt.Errorf("could not set up eval limits: '%v'", err)
+	}
+	lvm.limitsReady = true
+	return nil
+}
+
+// runLimited runs code on the eval coroutine within the
+// limits in lvm.cfg.
+func (lvm *LuaVm) runLimited(code string) error {
+	err := lvm.setupLimits()
+	if err != nil {
+		return err
+	}
+	cfg := lvm.cfg
+	period := limitCheckEvery
+	if cfg.EvalMaxInstructions > 0 && cfg.EvalMaxInstructions < int64(period) {
+		period = int(cfg.EvalMaxInstructions)
+	}
+	err = runOnGoro(lvm, fmt.Sprintf(
+		"__gijit_limits = {instr=%d, left=%d, period=%d, heap=%d, heapKB=%d}",
+		cfg.EvalMaxInstructions, cfg.EvalMaxInstructions, period,
+		cfg.EvalMaxHeapBytes, cfg.EvalMaxHeapBytes/1024), false)
+	if err != nil {
+		return err
+	}
+
+	counting := cfg.EvalMaxInstructions > 0 || cfg.EvalMaxHeapBytes > 0
+	if counting {
+		// LuaJIT's compiled traces never call the count
+		// hook, so interpret while there's a budget.
+		err = runOnGoro(lvm, "jit.off(); jit.flush()", false)
+		if err != nil {
+			return err
+		}
+		defer runOnGoro(lvm, "jit.on()", false)
+	}
+
+	lvm.mut.Lock()
+	lvm.hit = ""
+	lvm.running = true
+	lvm.checkEvery = 0
+	if counting {
+		lvm.checkEvery = period
+		lvm.vm.SetLimitCheck(period)
+	}
+	lvm.mut.Unlock()
+
+	if cfg.EvalTimeout > 0 {
+		hit := fmt.Sprintf("eval exceeded its time limit of %v", cfg.EvalTimeout)
+		var timer *time.Timer
+		lvm.mut.Lock()
+		timer = time.AfterFunc(cfg.EvalTimeout, func() {
+			lvm.mut.Lock()
+			defer lvm.mut.Unlock()
+			if lvm.running {
+				// again and again, lest the eval recover.
+				lvm.hit = hit
+				lvm.vm.Interrupt()
+				timer.Reset(limitRefire)
+			}
+		})
+		lvm.mut.Unlock()
+		defer timer.Stop()
+	}
+
+	err = runOnGoro(lvm, code, true)
+
+	lvm.mut.Lock()
+	lvm.running = false
+	lvm.checkEvery = 0
+	lvm.vm.ClearHook()
+	lvm.mut.Unlock()
+
+	rerr := runOnGoro(lvm, "__gijit_endLimits()", false)
+	if err == nil {
+		err = rerr
+	}
+	return err
+}
@@ -0,0 +1,123 @@
+package compiler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test1610EvalLimitsAreRecoverableRuntimeErrors(t *testing.T) {
+
+	cfg := NewGIConfig()
+	vm, err := NewLuaVmWithPrelude(cfg)
+	panicOn(err)
+	defer vm.Close()
+	inc := NewIncrState(vm, cfg)
+
+	// run returns the eval's error, if any.
+	run := func(src string) string {
+		translation, err := TranslateAndCatchPanic(inc, []byte(src))
+		panicOn(err)
+		panicOn(LuaRun(vm, translation, true))
+		lastErr, err := lastEvalErr(vm)
+		panicOn(err)
+		return lastErr
+	}
+	noLimits := func() {
+		cfg.EvalTimeout = 0
+		cfg.EvalMaxInstructions = 0
+		cfg.EvalMaxHeapBytes = 0
+	}
+
+	cv.Convey("an eval that runs too long is stopped by the wall-clock limit, and the interpreter carries on", t, func() {
+		defer noLimits()
+		cv.So(run(`n := 0; caught := false`), cv.ShouldEqual, "")
+		cfg.EvalTimeout = 200 * time.Millisecond
+		t0 := time.Now()
+		cv.So(run(`for { n++ }`), cv.ShouldContainSubstring, "eval exceeded its time limit of 200ms")
+		cv.So(time.Since(t0), cv.ShouldBeLessThan, 5*time.Second)
+		cv.So(run(`n = 3`), cv.ShouldEqual, "")
+		LuaMustInt64(vm, "n", 3)
+	})
+
+	cv.Convey("the instruction budget is a runtime error the eval can recover from", t, func() {
+		defer noLimits()
+		cfg.EvalMaxInstructions = 1000000
+		cv.So(run(`for { n++ }`), cv.ShouldContainSubstring, "eval exceeded its limit of 1000000 instructions")
+		cv.So(run(`func spin() { defer func() { caught = recover() != nil }(); for { n++ } }; spin()`), cv.ShouldEqual, "")
+		LuaMustBool(vm, "caught", true)
+
+		// a short eval fits in the budget.
+		cv.So(run(`n = 0; for i := 0; i < 100; i++ { n++ }`), cv.ShouldEqual, "")
+		LuaMustInt64(vm, "n", 100)
+	})
+
+	cv.Convey("an eval that grows the heap past the cap is stopped", t, func() {
+		defer noLimits()
+		cfg.EvalMaxHeapBytes = 64 << 20
+		cv.So(run(`func hog() { var keep [][]int; for { keep = append(keep, make([]int, 10000)) } }; hog()`),
+			cv.ShouldContainSubstring, "eval exceeded its heap limit of 67108864 bytes")
+		cv.So(run(`m := len(make([]int, 10))`), cv.ShouldEqual, "")
+	})
+	cv.Convey("a broken limit stays broken: an eval that recovers meets it again at once, until it returns", t, func() {
+		defer noLimits()
+		cv.So(run(`k := 0; func stubborn() { defer func() { recover(); k++ }(); for { n++ } }`), cv.ShouldEqual, "")
+
+		cfg.EvalTimeout = 200 * time.Millisecond
+		t0 := time.Now()
+		cv.So(run(`for k < 3 { stubborn() }; for { n++ }`), cv.ShouldContainSubstring, "eval exceeded its time limit of 200ms")
+		cv.So(time.Since(t0), cv.ShouldBeLessThan, 5*time.Second)
+		LuaMustInt64(vm, "k", 3)
+		noLimits()
+
+		cfg.EvalMaxInstructions = 1000000
+		cv.So(run(`k = 0; for k < 3 { stubborn() }; for { n++ }`), cv.ShouldContainSubstring, "eval exceeded its limit of 1000000 instructions")
+		LuaMustInt64(vm, "k", 3)
+	})
+
+	cv.Convey("an interrupt does not take the instruction limit's place", t, func() {
+		defer noLimits()
+		cv.So(run(`func catchOne() { defer func() { recover() }(); for { n++ } }`), cv.ShouldEqual, "")
+
+		// the time limit is only a backstop here.
+		cfg.EvalTimeout = 10 * time.Second
+		cfg.EvalMaxInstructions = 100000000
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			vm.vm.Interrupt()
+		}()
+		cv.So(run(`catchOne(); for { n++ }`), cv.ShouldContainSubstring, "eval exceeded its limit of 100000000 instructions")
+	})
+}
+
+func Test1626EvalLimitBrokenWhileParkedLeavesTheSchedulerUsable(t *testing.T) {
+
+	cv.Convey("a time limit that fires while the eval sleeps ends that eval with the limit's error, and the next eval runs", t, func() {
+		cfg := NewGIConfig()
+		in, err := NewInterpreter(cfg)
+		panicOn(err)
+		defer in.Close()
+		ctx := context.Background()
+
+		panicOn(in.RegisterPackage("time", map[string]interface{}{
+			"Sleep": time.Sleep,
+		}))
+		_, err = in.Eval(ctx, `import "time"`)
+		panicOn(err)
+
+		cfg.EvalTimeout = 200 * time.Millisecond
+		t0 := time.Now()
+		_, err = in.Eval(ctx, `go func() { time.Sleep(10e9) }(); time.Sleep(5e9)`)
+		cv.So(err, cv.ShouldNotBeNil)
+		cv.So(err.Error(), cv.ShouldContainSubstring, "eval exceeded its time limit of 200ms")
+		cv.So(time.Since(t0), cv.ShouldBeLessThan, 4*time.Second)
+
+		for i := 0; i < 2; i++ {
+			res, err := in.Eval(ctx, `1 + 1`)
+			cv.So(err, cv.ShouldBeNil)
+			cv.So(res, cv.ShouldResemble, []interface{}{2})
+		}
+	})
+}
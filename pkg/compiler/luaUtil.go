@@ -18,21 +18,13 @@ import (
 	"github.com/glycerine/luar"
 )
 
-// If reserveMainThread is true, we will
-// attempt to reserve the main starting
-// C thread for the LuaVM. This makes
-// things much more complicated, because
-// we run everything else on other
-// goroutines. For simplicity, we leave
-// it false at the moment.
-const reserveMainThread = false
-
 // shortcut; do
 // *dbg = true
 // to turn on -vv very verbose debug printing.
 var dbg = &verb.VerboseVerbose
 
 var nyc *time.Location
+var nycOnce sync.Once
 
 type LuaVm struct {
 	cfg *GIConfig
@@ -40,21 +32,28 @@ type LuaVm struct {
 
 	goro *Goro
 	mut  sync.Mutex
+
+	// for the eval limits, in limits.go; under mut.
+	limitsReady bool
+	running     bool
+	hit         string
+	checkEvery  int
 }
 
 func (lvm *LuaVm) Close() {
 	lvm.goro.halt.RequestStop()
 	<-lvm.goro.halt.Done.Chan
+	luar.SetCallbackRunner(lvm.vm, nil)
 }
 
 func (lvm *LuaVm) GetGoluaState() *golua.State {
 	return lvm.vm
 }
 
-// can't be called on main thread
-// and MainCThread() must have already
-// been started.
-//
+// NewLuaVmWithPrelude starts a LuaJIT state, on
+// its own OS thread, and loads the prelude into it.
+// Each LuaVm is independent of any others, so
+// many may run at once.
 func NewLuaVmWithPrelude(cfg *GIConfig) (lvm *LuaVm, err error) {
 
 	var vm *golua.State
@@ -100,14 +99,8 @@ func NewLuaVmWithPrelude(cfg *GIConfig) (lvm *LuaVm, err error) {
 	if err != nil {
 		return nil, err
 	}
-	if reserveMainThread {
-		doMainAsync(func() {
-			lvm.goro.Start()
-		})
-
-	} else {
-		go lvm.goro.Start()
-	}
+	go lvm.goro.Start()
+	luar.SetCallbackRunner(vm, lvm.goro.runCallback)
 
 	// establish prelude location so prelude can know itself.
 	// __preludePath must be terminated with a '/' character.
@@ -138,12 +131,14 @@ func NewLuaVmWithPrelude(cfg *GIConfig) (lvm *LuaVm, err error) {
 			// also load timezone, for windows
 			if nm == "zoneinfo" {
 				//fmt.Printf("loading zoneinfo/\n")
-				f, err := preludeFiles.Open("zoneinfo/America/New_York")
-				panicOn(err)
-				nyctzdata, err := ioutil.ReadAll(f)
-				panicOn(err)
-				nyc, err = time.LoadLocationFromTZData("America/New_York", nyctzdata)
-				panicOn(err)
+				nycOnce.Do(func() {
+					f, err := preludeFiles.Open("zoneinfo/America/New_York")
+					panicOn(err)
+					nyctzdata, err := ioutil.ReadAll(f)
+					panicOn(err)
+					nyc, err = time.LoadLocationFromTZData("America/New_York", nyctzdata)
+					panicOn(err)
+				})
 				//fmt.Printf("nyc is '%s'\n", nyc)
 			} else {
 				if !fi.IsDir() && fi.Size() > 0 && strings.HasSuffix(nm, ".lua") {
@@ -208,6 +203,23 @@ func NewLuaVmWithPrelude(cfg *GIConfig) (lvm *LuaVm, err error) {
 	luar.Register(vm, "", luar.Map{
 		"__lua2go": lua2GoProxy,
 	})
+
+	err = lvm.setupOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	err = lvm.setupTimers()
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg != nil && cfg.Sandbox != nil {
+		err = lvm.setupSandbox(cfg.Sandbox)
+		if err != nil {
+			return nil, err
+		}
+	}
 	//fmt.Printf("registered __lua2go with luar.\n")
 	// only now that __eval is available can we start heartbeat.
 
@@ -551,6 +563,13 @@ func LuaRunAndReport(lvm *LuaVm, s string) {
 // useEvalCoroutine may need to be false to bootstrap, but
 // should be typically true once the prelude / __gijitMainCoro is loaded.
 func LuaRun(lvm *LuaVm, s string, useEvalCoroutine bool) error {
+	if useEvalCoroutine && lvm.cfg.hasEvalLimits() {
+		return lvm.runLimited(s)
+	}
+	return runOnGoro(lvm, s, useEvalCoroutine)
+}
+
+func runOnGoro(lvm *LuaVm, s string, useEvalCoroutine bool) error {
 	tk := lvm.goro.newTicket(s, useEvalCoroutine)
 	return tk.Do()
 }
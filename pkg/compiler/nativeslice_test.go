@@ -0,0 +1,27 @@
+package compiler
+
+import (
+	"context"
+	"testing"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test1625NativeSliceElementsSetFromZero(t *testing.T) {
+
+	cv.Convey("interpreted code sets a native slice's elements at the same 0-based index it reads them from", t, func() {
+		in, err := NewInterpreter(nil)
+		panicOn(err)
+		defer in.Close()
+		ctx := context.Background()
+
+		host := []int{1, 2, 3}
+		panicOn(in.Register("host", host))
+		_, err = in.Eval(ctx, `host[0] = 10; host[2] = host[1] * 10`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(host, cv.ShouldResemble, []int{10, 2, 20})
+
+		_, err = in.Eval(ctx, `host[3] = 4`)
+		cv.So(err.Error(), cv.ShouldContainSubstring, "index out of range")
+	})
+}
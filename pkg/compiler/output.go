@@ -0,0 +1,149 @@
+package compiler
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	shadow_fmt "github.com/gijit/gi/pkg/compiler/shadow/fmt"
+	shadow_os "github.com/gijit/gi/pkg/compiler/shadow/os"
+)
+
+// outputLuaSetup sends Lua's print, and so the display
+// of an expression's value, to the interpreter's
+// Stdout, and gives the prelude __gijit_diag for the
+// REPL's own messages.
+const outputLuaSetup = `
+local function joined(...)
+   local n = select("#", ...)
+   local a = {...}
+   local s = {}
+   for i = 1, n do
+      s[i] = tostring(a[i])
+   end
+   return table.concat(s, "\t") .. "\n"
+end
+
+print = function(...)
+   __gijit_writeStdout(joined(...))
+end
+
+__gijit_diag = function(...)
+   __gijit_writeDiag(joined(...))
+end
+`
+
+func (c *GIConfig) stdout() io.Writer {
+	if c != nil && c.Stdout != nil {
+		return c.Stdout
+	}
+	return os.Stdout
+}
+
+func (c *GIConfig) stderr() io.Writer {
+	if c != nil && c.Stderr != nil {
+		return c.Stderr
+	}
+	return os.Stderr
+}
+
+func (c *GIConfig) diag() io.Writer {
+	if c != nil && c.Diag != nil {
+		return c.Diag
+	}
+	return os.Stdout
+}
+
+// printf writes REPL messages, as opposed to the
+// output of the code being run.
+func (r *Repl) printf(format string, a ...interface{}) {
+	fmt.Fprintf(r.cfg.diag(), format, a...)
+}
+
+func (lvm *LuaVm) setupOutput() error {
+	cfg := lvm.cfg
+	tk := lvm.goro.newTicket(outputLuaSetup, false)
+	tk.regmap["__gijit_writeStdout"] = func(s string) {
+		io.WriteString(cfg.stdout(), s)
+	}
+	tk.regmap["__gijit_writeDiag"] = func(s string) {
+		io.WriteString(cfg.diag(), s)
+	}
+	err := tk.Do()
+	if err != nil {
+		return fmt.Errorf("could not set up output: '%v'", err)
+	}
+	return nil
+}
+
+// shadowFmtFor is the shadow fmt package, with its
+// Print functions writing to cfg's Stdout.
+func shadowFmtFor(cfg *GIConfig) map[string]interface{} {
+	pkg := make(map[string]interface{})
+	for k, v := range shadow_fmt.Pkg {
+		pkg[k] = v
+	}
+	pkg["Print"] = func(a ...interface{}) (int, error) {
+		return fmt.Fprint(cfg.stdout(), a...)
+	}
+	pkg["Printf"] = func(format string, a ...interface{}) (int, error) {
+		return fmt.Fprintf(cfg.stdout(), format, a...)
+	}
+	pkg["Println"] = func(a ...interface{}) (int, error) {
+		return fmt.Fprintln(cfg.stdout(), a...)
+	}
+	return pkg
+}
+
+// shadowOsFor is the shadow os package, with its
+// Stdout and Stderr being cfg's, and its Args being
+// args, if not nil.
+func shadowOsFor(cfg *GIConfig, args []string) map[string]interface{} {
+	pkg := make(map[string]interface{})
+	for k, v := range shadow_os.Pkg {
+		pkg[k] = v
+	}
+	if cfg != nil && cfg.Stdout != nil {
+		pkg["Stdout"] = asFile("/dev/stdout", cfg.Stdout)
+	}
+	if cfg != nil && cfg.Stderr != nil {
+		pkg["Stderr"] = asFile("/dev/stderr", cfg.Stderr)
+	}
+	if args != nil {
+		pkg["Args"] = args
+	}
+	return pkg
+}
+
+// asFile gives w as itself if it is an *os.File, and
+// otherwise as a writerFile.
+func asFile(name string, w io.Writer) interface{} {
+	if f, ok := w.(*os.File); ok {
+		return f
+	}
+	return &writerFile{name: name, w: w}
+}
+
+// writerFile stands in for os.Stdout or os.Stderr
+// when they are an io.Writer that isn't a file. It has
+// the writing methods of an *os.File.
+type writerFile struct {
+	name string
+	w    io.Writer
+}
+
+func (f *writerFile) Write(p []byte) (int, error) {
+	return f.w.Write(p)
+}
+
+func (f *writerFile) WriteString(s string) (int, error) {
+	return io.WriteString(f.w, s)
+}
+
+func (f *writerFile) Name() string {
+	return f.name
+}
+
+func (f *writerFile) Sync() error {
+	return nil
+}
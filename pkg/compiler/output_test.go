@@ -0,0 +1,63 @@
+package compiler
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test1614OutputGoesToTheInterpretersWriters(t *testing.T) {
+
+	cv.Convey("program output goes to cfg.Stdout, and the REPL's messages to cfg.Diag", t, func() {
+		var out, diag bytes.Buffer
+		cfg := NewGIConfig()
+		cfg.Stdout = &out
+		cfg.Diag = &diag
+		vm, err := NewLuaVmWithPrelude(cfg)
+		panicOn(err)
+		defer vm.Close()
+		inc := NewIncrState(vm, cfg)
+		r := &Repl{cfg: cfg, lvm: vm, inc: inc}
+		r.reader = bufio.NewReader(strings.NewReader(""))
+
+		panicOn(r.Eval(`x := 21; println("hi", x*2)`))
+		cv.So(out.String(), cv.ShouldEqual, "hi\t42LL\n")
+		cv.So(diag.String(), cv.ShouldContainSubstring, "elapsed:")
+		cv.So(diag.String(), cv.ShouldNotContainSubstring, "hi")
+
+		out.Reset()
+		diag.Reset()
+		panicOn(r.Eval(`x * 3`))
+		cv.So(out.String(), cv.ShouldContainSubstring, "63")
+		cv.So(diag.String(), cv.ShouldNotContainSubstring, "63")
+
+		out.Reset()
+		diag.Reset()
+		panicOn(r.Eval(`panic("oh no")`))
+		cv.So(out.String(), cv.ShouldEqual, "")
+		cv.So(diag.String(), cv.ShouldContainSubstring, "oh no")
+	})
+
+	cv.Convey("the shadow fmt Print functions, and os.Stdout and os.Stderr, write to the interpreter's writers", t, func() {
+		var out, errOut bytes.Buffer
+		cfg := NewGIConfig()
+		cfg.Stdout = &out
+		cfg.Stderr = &errOut
+
+		pkg := shadowFmtFor(cfg)
+		pkg["Println"].(func(...interface{}) (int, error))("a", 1)
+		pkg["Printf"].(func(string, ...interface{}) (int, error))("%v-%v\n", "b", 2)
+		cv.So(out.String(), cv.ShouldEqual, "a 1\nb-2\n")
+
+		osPkg := shadowOsFor(cfg, nil)
+		fmt.Fprintf(osPkg["Stderr"].(*writerFile), "to stderr\n")
+		cv.So(errOut.String(), cv.ShouldEqual, "to stderr\n")
+
+		// with no writers set, os.Stdout is the real one.
+		cv.So(shadowOsFor(NewGIConfig(), nil)["Stdout"], cv.ShouldNotHaveSameTypeAs, &writerFile{})
+	})
+}
@@ -42,6 +42,9 @@ type pkgContext struct {
 	fileSet      *token.FileSet
 	files        []*ast.File
 	errList      ErrorList
+
+	// from ImportContext.BinaryPackages
+	binaryPackage map[string]bool
 }
 
 func (p *pkgContext) SelectionOf(e *ast.SelectorExpr) (selection, bool) {
@@ -113,6 +116,14 @@ type flowData struct {
 type ImportContext struct {
 	Packages map[string]*types.Package
 	Import   func(path, pkgDir string, depth int) (*Archive, error)
+
+	// BinaryPackages holds the import paths of the
+	// shadow (binary Go) packages imported so far.
+	BinaryPackages map[string]bool
+
+	// Sandbox, if set, leaves __lua and __zygo out of
+	// the main package's scope, unless allowed.
+	Sandbox *SandboxPolicy
 }
 
 // packageImporter implements go/types.Importer interface.
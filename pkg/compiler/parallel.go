@@ -0,0 +1,477 @@
+package compiler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/gijit/gi/pkg/ast"
+	"github.com/gijit/gi/pkg/types"
+	golua "github.com/glycerine/golua/lua"
+	"github.com/glycerine/luar"
+)
+
+// A parallelPool runs goroutines, started by gi.Go,
+// on worker LuaJIT states, each an Interpreter of
+// its own on a thread of its own; see
+// Interpreter.Parallel, and prelude/parallel.lua
+// for the Lua half. The workers know only the
+// translated Lua of the interpreter's declarations,
+// which each replays before its next job.
+type parallelPool struct {
+	workers []*Interpreter
+	jobs    chan *parallelJob
+
+	// done, when the pool is closed, interrupts the
+	// jobs still running.
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu sync.Mutex
+
+	// what the interpreter has declared so far, for
+	// the jobs to come, and its top-level funcs by
+	// name.
+	decls    [][]byte
+	hostPkgs map[string]*hostPackage
+	funcs    map[string]bool
+
+	// the first panic on a worker, not yet reported,
+	// and closed when there is one.
+	err    error
+	failed chan struct{}
+}
+
+// parallelJob is one gi.Go: the top-level func name,
+// run with args, on a worker that has replayed decls.
+type parallelJob struct {
+	name     string
+	args     []interface{}
+	decls    [][]byte
+	hostPkgs map[string]*hostPackage
+}
+
+// Parallel lets interpreted code run goroutines on n
+// worker LuaJIT states, each on a thread of its own,
+// so that they can use more than one core; n < 1
+// means one per CPU. It registers the package "gi",
+// whose Go(f, args...) is `go f(args...)`, but on a
+// worker:
+//
+//	import "gi"
+//	gi.Go(sum, lo, hi, results)
+//
+// f must be a top-level func. The workers have the
+// imports, types and funcs declared so far, but not
+// the package level variables, which stay with the
+// interpreter; a gi.Go of a func that uses one, itself
+// or through the funcs and methods it calls, is a
+// translation error. Calls through an interface or a
+// func value are not followed. Only numbers, strings, bools and
+// channels can be passed; a channel goes across as a
+// native one, which both sides' goroutines use as
+// their own. A worker finishes one call, and the
+// goroutines it started, before it takes the next.
+//
+// A panic on a worker ends the Eval that is running
+// then, or the next one, with the panic's message.
+func (in *Interpreter) Parallel(n int) error {
+	in.mut.Lock()
+	defer in.mut.Unlock()
+	if in.lvm == nil {
+		return fmt.Errorf("Parallel called on a closed Interpreter")
+	}
+	if in.pool != nil {
+		return fmt.Errorf("Parallel: the interpreter has its workers already")
+	}
+	if n < 1 {
+		n = runtime.NumCPU()
+	}
+	p, err := newParallelPool(in.cfg, n)
+	if err != nil {
+		return fmt.Errorf("Parallel: %v", err)
+	}
+	err = p.register(in)
+	if err == nil {
+		err = in.registerPackage("gi", map[string]interface{}{
+			"Go": func(f interface{}, args ...interface{}) {
+				panic("gi.Go: the interpreter has no workers")
+			},
+			"NumWorker": func() int { return n },
+		})
+	}
+	if err != nil {
+		p.close()
+		return fmt.Errorf("Parallel: %v", err)
+	}
+	in.pool = p
+	return nil
+}
+
+func newParallelPool(cfg *GIConfig, n int) (*parallelPool, error) {
+	p := &parallelPool{
+		jobs:   make(chan *parallelJob),
+		failed: make(chan struct{}),
+	}
+	p.ctx, p.cancel = context.WithCancel(context.Background())
+	for i := 0; i < n; i++ {
+		w, err := NewInterpreter(cfg)
+		if err == nil {
+			err = p.register(w)
+		}
+		if err != nil {
+			if w != nil {
+				w.Close()
+			}
+			for _, w := range p.workers {
+				w.Close()
+			}
+			return nil, err
+		}
+		p.workers = append(p.workers, w)
+	}
+	for _, w := range p.workers {
+		go p.work(w)
+	}
+	return p, nil
+}
+
+// register gives in's Lua the Go half of gi.Go, so
+// that a worker can start goroutines on the others
+// too.
+func (p *parallelPool) register(in *Interpreter) error {
+	t := in.lvm.goro.newTicket("", false)
+	t.regmap["__gijit_parallelGo"] = p.goFromLua
+	return t.Do()
+}
+
+// close stops the workers, interrupting the jobs
+// still running.
+func (p *parallelPool) close() {
+	p.cancel()
+}
+
+// learn takes what the interpreter ic has declared
+// so far, for the jobs started from now on.
+func (p *parallelPool) learn(ic *IncrState) {
+	if p == nil {
+		return
+	}
+	hostPkgs := make(map[string]*hostPackage, len(ic.hostPkgs))
+	for k, v := range ic.hostPkgs {
+		hostPkgs[k] = v
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.hostPkgs = hostPkgs
+	if len(ic.decls) == len(p.decls) || ic.CurPkg.Arch == nil {
+		return
+	}
+	p.decls = ic.decls
+	scope := ic.CurPkg.Arch.Pkg.Scope()
+	p.funcs = make(map[string]bool)
+	for _, name := range scope.Names() {
+		if _, ok := scope.Lookup(name).(*types.Func); ok {
+			p.funcs[name] = true
+		}
+	}
+}
+
+// failure is closed when a worker has panicked.
+func (p *parallelPool) failure() <-chan struct{} {
+	if p == nil {
+		return nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.failed
+}
+
+func (p *parallelPool) fail(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.err == nil {
+		p.err = err
+		close(p.failed)
+	}
+}
+
+// takeErr returns the worker's panic, if any, once.
+func (p *parallelPool) takeErr() error {
+	if p == nil {
+		return nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	err := p.err
+	if err != nil {
+		p.err = nil
+		p.failed = make(chan struct{})
+	}
+	return err
+}
+
+// goFromLua is __gijit_parallelGo(name, args...),
+// which parallel.lua's gi.Go calls. It queues the job
+// and returns at once: the caller's own goroutines
+// may be what the job waits on.
+func (p *parallelPool) goFromLua(L *golua.State) int {
+	j := &parallelJob{name: L.ToString(1)}
+	p.mu.Lock()
+	j.decls, j.hostPkgs = p.decls, p.hostPkgs
+	isFunc := p.funcs[j.name]
+	p.mu.Unlock()
+	if !isFunc {
+		// a func value held by a variable, say.
+		L.RaiseError(fmt.Sprintf("gi.Go: only a top-level func can run on another state, not %s", j.name))
+	}
+	for i := 2; i <= L.GetTop(); i++ {
+		v, ok := crossingValue(L, i)
+		if !ok {
+			L.RaiseError(fmt.Sprintf("gi.Go: argument %d of %s, a %s, cannot cross to another state; only numbers, strings, bools and channels can", i-1, j.name, L.Typename(int(L.Type(i)))))
+		}
+		j.args = append(j.args, v)
+	}
+	go func() {
+		select {
+		case p.jobs <- j:
+		case <-p.ctx.Done():
+		}
+	}()
+	return 0
+}
+
+// crossingValue converts the Lua value at idx to Go,
+// if it is one that can be copied to another state.
+func crossingValue(L *golua.State, idx int) (interface{}, bool) {
+	if L.IsNil(idx) {
+		return nil, true
+	}
+	if ch, ok := luaChan(L, idx); ok {
+		return ch.Interface(), true
+	}
+	var v interface{}
+	if _, err := luar.LuaToGo(L, idx, &v); err != nil || v == nil {
+		return nil, false
+	}
+	rt := reflect.TypeOf(v)
+	_, basic := basicKinds[rt.Kind()]
+	return v, basic && rt.PkgPath() == ""
+}
+
+// work runs jobs on the worker w until the pool is
+// closed.
+func (p *parallelPool) work(w *Interpreter) {
+	defer w.Close()
+	replayed := 0
+	for {
+		select {
+		case <-p.ctx.Done():
+			return
+		case j := <-p.jobs:
+			err := p.run(w, j, &replayed)
+			if err != nil && p.ctx.Err() == nil {
+				p.fail(fmt.Errorf("gi.Go(%s): %v", j.name, err))
+			}
+		}
+	}
+}
+
+// parallelCallLua calls the top-level func %[1]s with
+// the arguments %[2]s, then waits for the goroutines
+// it started.
+const parallelCallLua = `
+if type(_G[%[1]q]) ~= "function" then
+   panic("gi.Go: %[1]s is not a top-level func")
+end
+_G[%[1]q](%[2]s)
+__gijit_parallelDrain()
+`
+
+// run replays, on w, the declarations it has not
+// seen, then runs j.
+func (p *parallelPool) run(w *Interpreter, j *parallelJob, replayed *int) error {
+	if len(j.decls) > *replayed {
+		w.inc.hostPkgs = j.hostPkgs
+		var lua bytes.Buffer
+		for _, d := range j.decls[*replayed:] {
+			lua.Write(d)
+			lua.WriteString("\n")
+		}
+		*replayed = len(j.decls)
+		err := w.runUntilDone(p.ctx, lua.String())
+		if err != nil {
+			return err
+		}
+	}
+
+	t := w.lvm.goro.newTicket("", false)
+	args := make([]string, len(j.args))
+	for i, a := range j.args {
+		if a == nil {
+			args[i] = "nil"
+			continue
+		}
+		args[i] = fmt.Sprintf("__gijit_parallelArg%d", i+1)
+		t.regmap[args[i]] = a
+	}
+	if len(t.regmap) > 0 {
+		err := t.Do()
+		if err != nil {
+			return err
+		}
+	}
+	return w.runUntilDone(p.ctx, fmt.Sprintf(parallelCallLua, j.name, strings.Join(args, ", ")))
+}
+
+// funcRefs is what the body of a top-level func, or
+// of a method, refers to, as far as gi.Go cares: the
+// package level variables, which the workers lack, and
+// the funcs and methods it calls, by funcKey, so that
+// a callee declared again later is followed to its
+// new body. Calls through an interface or a func value
+// are not seen.
+type funcRefs struct {
+	vars  []*types.Var
+	funcs []string
+}
+
+// vetGiGo records what the funcs declared in file
+// refer to, then refuses any gi.Go in file of a func
+// that reaches a package level variable; on a worker,
+// it would quietly be another variable, or none.
+func (tr *IncrState) vetGiGo(file *ast.File) {
+	info := tr.CurPkg.Arch.TypesInfo
+	pkg := tr.CurPkg.Arch.Pkg
+	if tr.funcRefs == nil {
+		tr.funcRefs = make(map[string]*funcRefs)
+	}
+	for _, d := range file.Nodes {
+		fd, ok := d.(*ast.FuncDecl)
+		if !ok || fd.Body == nil {
+			continue
+		}
+		fn, ok := info.Defs[fd.Name].(*types.Func)
+		if !ok {
+			continue
+		}
+		refs := &funcRefs{}
+		ast.Inspect(fd.Body, func(n ast.Node) bool {
+			if id, ok := n.(*ast.Ident); ok {
+				switch obj := info.Uses[id].(type) {
+				case *types.Var:
+					if obj.Parent() == pkg.Scope() {
+						refs.vars = append(refs.vars, obj)
+					}
+				case *types.Func:
+					if key := funcKey(pkg, obj); key != "" {
+						refs.funcs = append(refs.funcs, key)
+					}
+				}
+			}
+			return true
+		})
+		tr.funcRefs[funcKey(pkg, fn)] = refs
+	}
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) == 0 || !isGiGo(call.Fun, info) {
+			return true
+		}
+		arg := call.Args[0]
+		for {
+			p, ok := arg.(*ast.ParenExpr)
+			if !ok {
+				break
+			}
+			arg = p.X
+		}
+		id, ok := arg.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		fn, ok := info.Uses[id].(*types.Func)
+		if !ok {
+			// not a top-level func; gi.Go says so
+			// when it runs.
+			return true
+		}
+		if v := tr.pkgVarUse(funcKey(pkg, fn), make(map[string]bool)); v != nil {
+			panic(&giGoError{fmt.Sprintf("gi.Go: %s uses the package level variable %s, which stays with the interpreter; pass it as an argument instead", fn.Name(), v.Name())})
+		}
+		return true
+	})
+}
+
+// funcKey names fn, a func or method declared in pkg,
+// as the session knows it now: f, or T.m for a method
+// of T. It is "" for one of another package.
+func funcKey(pkg *types.Package, fn *types.Func) string {
+	if fn.Pkg() != pkg {
+		return ""
+	}
+	sig, ok := fn.Type().(*types.Signature)
+	if !ok || sig.Recv() == nil {
+		return fn.Name()
+	}
+	recv := sig.Recv().Type()
+	if p, ok := recv.(*types.Pointer); ok {
+		recv = p.Elem()
+	}
+	named, ok := recv.(*types.Named)
+	if !ok {
+		return ""
+	}
+	return named.Obj().Name() + "." + fn.Name()
+}
+
+// giGoError is vetGiGo's refusal, which translating
+// again, without the ans prefix, would not change.
+type giGoError struct {
+	msg string
+}
+
+func (e *giGoError) Error() string { return e.msg }
+
+// isGiGo is true of fun, the func of a call, if it is
+// the Go of the "gi" package.
+func isGiGo(fun ast.Expr, info *types.Info) bool {
+	sel, ok := fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Go" {
+		return false
+	}
+	x, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	pn, ok := info.Uses[x].(*types.PkgName)
+	return ok && pn.Imported().Path() == "gi"
+}
+
+// pkgVarUse returns a package level variable that the
+// func named key uses, itself or through the funcs it
+// calls, if any.
+func (tr *IncrState) pkgVarUse(key string, seen map[string]bool) *types.Var {
+	if seen[key] {
+		return nil
+	}
+	seen[key] = true
+	refs := tr.funcRefs[key]
+	if refs == nil {
+		return nil
+	}
+	if len(refs.vars) > 0 {
+		return refs.vars[0]
+	}
+	for _, f := range refs.funcs {
+		if v := tr.pkgVarUse(f, seen); v != nil {
+			return v
+		}
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+package compiler
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"testing"
+	"time"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test1624GoroutinesRunInParallelOnWorkerStates(t *testing.T) {
+
+	cv.Convey("gi.Go runs a top-level func on a worker LuaJIT state, with channels between the states, and only copyable values crossing", t, func() {
+		in, err := NewInterpreter(nil)
+		panicOn(err)
+		defer in.Close()
+		panicOn(in.Parallel(4))
+
+		// a wait on the workers that never ends fails the
+		// test, rather than hanging it.
+		eval := func(src string) error {
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+			defer cancel()
+			_, err := in.Eval(ctx, src)
+			return err
+		}
+		get := func(expr string) interface{} {
+			res, err := in.Eval(context.Background(), expr)
+			panicOn(err)
+			return res[0]
+		}
+		panicOn(eval(`import "gi"`))
+		cv.So(get(`gi.NumWorker()`), cv.ShouldEqual, 4)
+
+		// the data-parallel loop: each worker sums its
+		// part, with types and funcs declared before.
+		err = eval(`
+type span struct {
+	lo, hi int
+}
+func (s span) sum() (tot int) {
+	for i := s.lo; i < s.hi; i++ {
+		tot += i
+	}
+	return
+}
+func sumPart(lo, hi int, out chan int) {
+	s := span{lo: lo, hi: hi}
+	out <- s.sum()
+}
+parts := make(chan int)
+for k := 0; k < 8; k++ {
+	gi.Go(sumPart, k*1000, (k+1)*1000, parts)
+}
+partsTotal := 0
+for k := 0; k < 8; k++ {
+	partsTotal += <-parts
+}`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(get(`partsTotal`), cv.ShouldEqual, 7999*8000/2)
+
+		// each waits until all four have started, which
+		// only four states, running at once, can do.
+		err = eval(`
+func meet(id int, started chan<- int, release <-chan bool, back chan string) {
+	started <- id
+	<-release
+	back <- "w"
+}
+started, release, back := make(chan int), make(chan bool), make(chan string, 4)
+for k := 0; k < 4; k++ {
+	gi.Go(meet, k, started, release, back)
+}
+seen := 0
+for k := 0; k < 4; k++ {
+	seen += <-started
+}
+close(release)
+met := ""
+for k := 0; k < 4; k++ {
+	met += <-back
+}`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(get(`seen`), cv.ShouldEqual, 6)
+		cv.So(get(`met`), cv.ShouldEqual, "wwww")
+
+		// a job's own goroutines, on its worker, run out
+		// too.
+		err = eval(`
+func fanOut(n int, out chan int) {
+	inner := make(chan int)
+	for i := 1; i <= n; i++ {
+		go func(i int) { inner <- i * i }(i)
+	}
+	go func() {
+		tot := 0
+		for i := 0; i < n; i++ {
+			tot += <-inner
+		}
+		out <- tot
+	}()
+}
+squares := make(chan int)
+gi.Go(fanOut, 10, squares)
+sq := <-squares`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(get(`sq`), cv.ShouldEqual, 385)
+
+		// what can't be copied stays home.
+		err = eval(`func takesSlice(a []int) {}; gi.Go(takesSlice, []int{1})`)
+		cv.So(err.Error(), cv.ShouldContainSubstring, "cannot cross to another state")
+		err = eval(`lit := func() {}; gi.Go(lit)`)
+		cv.So(err.Error(), cv.ShouldContainSubstring, "only a top-level func")
+
+		// nor do the package level variables, so a
+		// func that uses one, or calls one that does, is
+		// refused when translated.
+		err = eval(`
+hits := 0
+func hit(n int) { hits += n }
+func viaHit(n int) { hit(n) }`)
+		cv.So(err, cv.ShouldBeNil)
+		err = eval(`gi.Go(hit, 1)`)
+		cv.So(err.Error(), cv.ShouldContainSubstring, "gi.Go: hit uses the package level variable hits")
+		err = eval(`gi.Go(viaHit, 1)`)
+		cv.So(err.Error(), cv.ShouldContainSubstring, "gi.Go: viaHit uses the package level variable hits")
+		err = eval(`
+func (s span) hitAll() { hit(s.hi - s.lo) }
+func viaMethod() { span{}.hitAll() }
+gi.Go(viaMethod)`)
+		cv.So(err.Error(), cv.ShouldContainSubstring, "gi.Go: viaMethod uses the package level variable hits")
+
+		// a callee declared again is followed to its new
+		// body.
+		err = eval(`
+func helper() {}
+func caller() { helper() }`)
+		cv.So(err, cv.ShouldBeNil)
+		err = eval(`func helper() { hits++ }`)
+		cv.So(err, cv.ShouldBeNil)
+		err = eval(`gi.Go(helper)`)
+		cv.So(err.Error(), cv.ShouldContainSubstring, "gi.Go: helper uses the package level variable hits")
+		err = eval(`gi.Go(caller)`)
+		cv.So(err, cv.ShouldNotBeNil)
+		cv.So(err.Error(), cv.ShouldContainSubstring, "gi.Go: caller uses the package level variable hits")
+
+		// a panic on a worker ends the eval waiting on
+		// it, and the interpreter carries on.
+		err = eval(`
+func boom(never chan int) {
+	panic("kaboom")
+}
+never := make(chan int)
+gi.Go(boom, never)
+<-never`)
+		cv.So(err.Error(), cv.ShouldContainSubstring, "gi.Go(boom)")
+		cv.So(err.Error(), cv.ShouldContainSubstring, "kaboom")
+		cv.So(get(`partsTotal + 1`), cv.ShouldEqual, 7999*8000/2+1)
+	})
+}
+
+// BenchmarkParallelSum sums the same span in 8 parts,
+// on one worker and on one per CPU, or two, at least.
+func BenchmarkParallelSum(b *testing.B) {
+	cpus := runtime.NumCPU()
+	if cpus < 2 {
+		cpus = 2
+	}
+	for _, n := range []int{1, cpus} {
+		b.Run(fmt.Sprintf("workers=%d", n), func(b *testing.B) {
+			in, err := NewInterpreter(nil)
+			panicOn(err)
+			defer in.Close()
+			panicOn(in.Parallel(n))
+			ctx := context.Background()
+			_, err = in.Eval(ctx, `
+import "gi"
+func sumPart(lo, hi int, out chan int) {
+	tot := 0
+	for i := lo; i < hi; i++ {
+		tot += i % 7
+	}
+	out <- tot
+}
+parts := make(chan int)
+func sumAll() (tot int) {
+	for k := 0; k < 8; k++ {
+		gi.Go(sumPart, k*1000000, (k+1)*1000000, parts)
+	}
+	for k := 0; k < 8; k++ {
+		tot += <-parts
+	}
+	return
+}`)
+			panicOn(err)
+
+			// the workers replay the declarations on
+			// their first job; leave that out.
+			_, err = in.Eval(ctx, `sumAll()`)
+			panicOn(err)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_, err = in.Eval(ctx, `sumAll()`)
+				panicOn(err)
+			}
+		})
+	}
+}
@@ -92,3 +92,35 @@ else
    end
 
 end
+
+-- __sleep_ns blocks the Lua state's thread for about
+-- ns nanoseconds. Only the scheduler should call it,
+-- and only when it has nothing to run until a timer.
+
+if jit.os == "Windows" then
+   ffi.cdef[[
+   void Sleep(uint32_t dwMilliseconds);
+   ]]
+   __sleep_ns=function(ns)
+      ffi.C.Sleep(tonumber(ns / 1000000))
+   end
+else
+   if jit.os == "OSX" then
+      ffi.cdef[[
+      typedef long time_t;
+      struct timespec {
+         time_t   tv_sec;
+         long     tv_nsec;
+      };
+      ]]
+   end
+   ffi.cdef[[
+   int nanosleep(const struct timespec *req, struct timespec *rem);
+   ]]
+   local req = ffi.new("struct timespec")
+   __sleep_ns=function(ns)
+      req.tv_sec = ns / 1000000000
+      req.tv_nsec = ns % 1000000000
+      ffi.C.nanosleep(req, nil)
+   end
+end
@@ -82,6 +82,22 @@ local tasks_runnable = {}       -- list of coroutines ready to be resumed
 local tasks_to = {}             -- all the timeout tasks
 local altexec
 
+-- native is the bridge to Go's own channels, filled
+-- in by reflect_goro.lua.
+local native = {}
+
+-- blocked maps each coroutine parked in select to
+-- its alt_array, to say what it waits on and to
+-- take it back off the channels if abandoned.
+local blocked = setmetatable({}, {__mode = "k"})
+
+-- is_interrupt is true of the panic that Ctrl-C, a
+-- done Eval context, or a broken eval limit, raises in
+-- the running code.
+local function is_interrupt(err)
+   return type(err) == "table" and (err[1] == "interrupted" or err.__gijitLimit == true)
+end
+
 __all_coro = {} -- array
 
 __cleanupDeadCoro = function()
@@ -102,6 +118,11 @@ end
 
 -- value.__loc gives location in __all_coro array.
 -- value.__name readable name
+-- value.__id the goroutine id, in dumps: the eval
+--   is 1, and spawned goroutines count up from 2.
+-- value.__created where a spawned goroutine's go
+--   statement was, as debug.getinfo has it.
+-- value.__creator the id of the goroutine that ran it.
 __coro2notes = {} 
 
 -- return coroutine status as a string
@@ -165,10 +186,10 @@ local Set = {
    remove = function(self, v)
       local a, l = self.a, self.l
       local i = a[v]
-      if i > 0 then
+      if i ~= nil then
          local t = l[#l]
          a[t], l[i] = i, t
-         a[i], l[#l] = nil, nil
+         a[v], l[#l] = nil, nil
          return true
       end
    end,
@@ -217,11 +238,116 @@ local CircularBuffer = {
    end,
 }
 
+----------------------------------------------------------------------------
+-- Timers
+--
+-- A binary min-heap of pending timers, ordered by
+-- their when, in __abs_now() nanoseconds. A timer is
+-- {when=, period=, f=}; f runs on the scheduler and
+-- must not block. A timer with a period is put back
+-- after it fires, skipping any ticks already missed,
+-- as Go's tickers drop them.
+local timers = {}
+
+local function timer_swap(i, j)
+   timers[i], timers[j] = timers[j], timers[i]
+   timers[i].idx = i
+   timers[j].idx = j
+end
+
+local function timer_up(i)
+   while i > 1 do
+      local p = __builtin_math.floor(i / 2)
+      if timers[p].when <= timers[i].when then
+         break
+      end
+      timer_swap(i, p)
+      i = p
+   end
+end
+
+local function timer_down(i)
+   local n = #timers
+   while true do
+      local small = i
+      local l, r = 2*i, 2*i+1
+      if l <= n and timers[l].when < timers[small].when then
+         small = l
+      end
+      if r <= n and timers[r].when < timers[small].when then
+         small = r
+      end
+      if small == i then
+         return
+      end
+      timer_swap(i, small)
+      i = small
+   end
+end
+
+local function add_timer(t)
+   table.insert(timers, t)
+   t.idx = #timers
+   timer_up(t.idx)
+end
+
+-- del_timer reports whether t was still pending.
+local function del_timer(t)
+   local i = t.idx
+   if i == nil or timers[i] ~= t then
+      return false
+   end
+   local n = #timers
+   if i ~= n then
+      timer_swap(i, n)
+   end
+   table.remove(timers)
+   t.idx = nil
+   if i < n then
+      timer_down(i)
+      timer_up(i)
+   end
+   return true
+end
+
+-- run_timers fires every timer due by now.
+local function run_timers(now)
+   while #timers > 0 and timers[1].when <= now do
+      local t = timers[1]
+      del_timer(t)
+      if t.period ~= nil and t.period > 0 then
+         t.when = t.when + t.period
+         if t.when <= now then
+            t.when = now + t.period - (now - t.when) % t.period
+         end
+         add_timer(t)
+      end
+      t.f(now)
+   end
+end
+
 ----------------------------------------------------------------------------
 -- Scheduling
 --
 -- Tasks ready to be run are placed on a stack and it's possible to
 -- starve a coroutine.
+
+-- the longest the scheduler sleeps at once, in nanoseconds.
+local max_sleep_ns = 10000000LL
+
+-- how many resumes may go by before the scheduler
+-- looks at the native channels again, so that busy
+-- coroutines don't starve the ones parked on them.
+local native_poll_every = 64
+
+-- eval_waiting is true when the code being eval-ed
+-- at the prompt is blocked, so the scheduler has
+-- someone to wait for.
+local function eval_waiting()
+   local co = __gijitEvalCoro
+   return co ~= nil and coroutine.status(co) == "suspended"
+end
+
 local function scheduler()
    --print("top of scheduler")
    
@@ -250,10 +376,30 @@ local function scheduler()
    
    local i = 0
    while true do
+      if #timers > 0 then
+         run_timers(__abs_now())
+      end
+      if #tasks_runnable == 0 or i % native_poll_every == 0 then
+         native.poll()
+      end
       local nr = #tasks_runnable
       if nr == 0 then
-         --print("scheduler: no more runnable tasks")
-         break
+         -- while the eval is blocked, wait on the
+         -- next timer, or a native channel; in
+         -- slices, so an interrupt from the host is
+         -- still noticed.
+         if not eval_waiting() or (#timers == 0 and not native.parked()) then
+            --print("scheduler: no more runnable tasks")
+            break
+         end
+         local wait = max_sleep_ns
+         if #timers > 0 and timers[1].when - __abs_now() < wait then
+            wait = timers[1].when - __abs_now()
+         end
+         if wait > 0 and not native.wait(wait) then
+            __sleep_ns(wait)
+         end
+         goto continue
       end
       -- jea: pick one at random
       local k = __builtin_math.random(nr)
@@ -267,11 +413,14 @@ local function scheduler()
       
       local okay, emsg = unpack(back)
       if not okay then
-         print(debug.traceback(emsg))
+         if not is_interrupt(emsg) then
+            print(debug.traceback(emsg))
+         end
          error(emsg)
       end
       i = i + 1
-      --print("scheduler: resume was okay, i is now = ", i)      
+      --print("scheduler: resume was okay, i is now = ", i)
+      ::continue::
    end
 
    local now = __abs_now()
@@ -307,6 +456,8 @@ task_park = function(co)
    tasks_runnable = newrun
 end
 
+local next_goid = 2
+
 local function spawn(fun, args)
    --local args = {...}
 
@@ -320,7 +471,12 @@ local function spawn(fun, args)
    local co = coroutine.create(f)
    table.insert(__all_coro, co)
    local n=#__all_coro
-   __coro2notes[co]={__loc=n, __name="spawn #"..tostring(n)}
+   local parent = __coro2notes[coroutine.running()]
+   __coro2notes[co]={__loc=n, __name="spawn #"..tostring(n),
+                     __id=next_goid,
+                     __created=debug.getinfo(2, "Sln"),
+                     __creator=parent and parent.__id}
+   next_goid = next_goid + 1
    
    __task_ready(co)
    
@@ -397,9 +553,28 @@ local function altalldequeue(alt_array)
    end
 end
 
+-- resolve readies the task parked on a's select,
+-- with a as the case that went.
+local function resolve(a)
+   local alt_array = a.alt_array
+   altalldequeue(alt_array)
+   alt_array.resolved = a.alt_index
+   __task_ready(alt_array.task)
+end
+
 -- Can this Alt be execed without blocking?
 local function altcanexec(a)
    local c, op = a.c, a.op
+   if c.__native ~= nil then
+      -- the buffer, and closing, are on the native
+      -- side; here only the coroutines meet.
+      return op ~= NOP and c:_get_other_alts(op):len() > 0
+   end
+   if c._closed and op ~= NOP then
+      -- receives drain the buffer then get the
+      -- zero value; sends will panic.
+      return true
+   end
    if c._buf.size == 0 then
       if op ~= NOP then
          return c:_get_other_alts(op):len() > 0
@@ -417,6 +592,17 @@ end
 altexec = function (a)
    local c, op = a.c, a.op
 
+   if c._closed then
+      if op == SEND then
+         __throwRuntimeError("send on closed channel")
+      end
+      if op == RECV and c._buf:len() == 0 then
+         a.alt_array.value = c:_zero()
+         a.alt_array.closed = true
+         return
+      end
+   end
+
    --print("top of altexec, a=")
    --__st(a,"a")
    --print("top of altexec, op=")
@@ -500,11 +686,14 @@ select_inner = function(alt_array)
 
       local thisCo = coroutine.running()
       task_park(thisCo)
+      blocked[thisCo] = alt_array
       coroutine.yield() -- go back to scheduler
    end
 
    --print("select: loop through the alt_array...")   
-   local list_of_canexec_i = {}
+   -- the cases, in random order: the first that can
+   -- go is then a fair choice among those that can.
+   local order = {}
    for i = 1, #alt_array do
       --print("top of alt_array loop, i = ", i)
       local a = alt_array[i]
@@ -526,37 +715,43 @@ select_inner = function(alt_array)
       assert(type(a.op) == "string" and
                 (a.op == RECV or a.op == SEND or a.op == NOP),
              "op field must be RECV, SEND or NOP in alt")
+      if type(a.c) == "userdata" then
+         a.c = native.wrap(a.c) or a.c
+      end
       assert(type(a.c) == "table" and a.c.__index == __M.Channel,
              "pass valid channel to a c field of alt")
-      if altcanexec(a) == true then
-         table.insert(list_of_canexec_i, i)
-      elseif a.to then
+      table.insert(order, __builtin_math.random(#order + 1), i)
+      ::zcontinue::
+   end
+   --print("select: done with alt_array loop") 
 
+   -- a channel with a native side tries that first,
+   -- where its buffer is.
+   for _, i in ipairs(order) do
+      local a = alt_array[i]
+      local went = a.c.__native ~= nil and native.try(a)
+      if not went and altcanexec(a) == true then
+         altexec(a)
+         went = true
+      end
+      if went then
+         if alt_array.sendClosed then
+            __throwRuntimeError("send on closed channel")
+         end
+         local res = {int(i-1), {alt_array.value, alt_array.closed == nil}}
+         --print("select returning res[2] after choosing i=",i)
+         --__st(res[2], "res")
+         return res
+      end
+      if a.to then
          local sc = coroutine.running()
          if not tasks_to[sc] then
             --print("select is adding sc to the tasks_to table as key. value a")
             tasks_to[sc] = a
          end
       end
-      ::zcontinue::
-   end
-   --print("select: done with alt_array loop") 
-
-   if #list_of_canexec_i > 0 then
-      if #list_of_canexec_i > 1 then
-         --print("select: multiple choices from alt_array, can proceed... choosing one at random")
-      else
-         --print("select: one choice from alt_array can proceed.")
-      end
-      local i = random_choice(list_of_canexec_i)
-      altexec(alt_array[i])
-      local res = {int(i-1), {alt_array.value, alt_array.closed == nil}}
-      --print("select returning res[2] after choosing i=",i)
-      --__st(res[2], "res")
-      return res
-   else
-      --print("select: no cases to execute.")
    end
+   --print("select: no cases to execute.")
 
    --print("select: defaultPresent = ", defaultPresent)   
    if defaultPresent then
@@ -590,10 +785,15 @@ select_inner = function(alt_array)
    local current_co, is_main = coroutine.running()  
    --print("about to yield from (is_main? ",is_main," co=", current_co, " / ", __costring(current_co))
    
+   blocked[self_coro] = alt_array
    local who = coroutine.yield()
+   blocked[self_coro] = nil
    --print("select: resumed by who='"..who.."'")
    
    assert(alt_array.resolved > 0)
+   if alt_array.sendClosed then
+      __throwRuntimeError("send on closed channel")
+   end
 
    local r = alt_array.resolved
    local res = {int(r-1), {alt_array.value, alt_array.closed == nil}}
@@ -631,20 +831,67 @@ local Channel = {
       return unpack(r[2])
    end,
 
+   -- nbsend and nbrecv never block: the empty
+   -- alt is a default case.
+   -- nbsend reports whether msg went out; timers
+   -- use it, from the scheduler.
    nbsend = function(self, msg)
-      return select({{c = self, op = SEND, p = msg}}, false)
+      local r = select_inner({{c = self, op = SEND, p = msg}, {}})
+      return r[1] == 0
    end,
 
    nbrecv = function(self)
-      return select({{c = self, op = RECV}}, false)
+      local r = select_inner({{c = self, op = RECV}, {}})
+      if r[1] ~= 0 then
+         return false
+      end
+      return true, r[2][1]
    end,
 
+   -- close wakes every blocked receiver with the
+   -- zero value and ok == false, and makes blocked
+   -- senders panic, as Go does.
    close = function(self)
-      local alts = self:_get_alts(RECV)
-      for _, v in ipairs(alts.l) do
-         v.closed = true
-         altexec(v)
+      if self._closed then
+         __throwRuntimeError("close of closed channel")
+      end
+      if self.__native ~= nil then
+         if not __gijit_chanClose(self.__native) then
+            __throwRuntimeError("close of closed channel")
+         end
+         -- what is buffered is still to be had: the
+         -- scheduler's next look at the native side
+         -- wakes whoever is parked.
+         self._closed = true
+         return
+      end
+      self._closed = true
+      for _, op in ipairs({RECV, SEND}) do
+         local waiting = {}
+         for _, v in ipairs(self:_get_alts(op).l) do
+            table.insert(waiting, v)
+         end
+         for _, v in ipairs(waiting) do
+            local alt_array = v.alt_array
+            altalldequeue(alt_array)
+            if op == RECV then
+               alt_array.value = self:_zero()
+               alt_array.closed = true
+            else
+               alt_array.sendClosed = true
+            end
+            alt_array.resolved = v.alt_index
+            __task_ready(alt_array.task)
+         end
+      end
+   end,
+
+   _zero = function(self)
+      local typ = self.__elemTyp
+      if type(typ) == "table" and typ.zero ~= nil then
+         return typ.zero()
       end
+      return nil
    end,
 
    _get_alts = function(self, op)
@@ -676,6 +923,140 @@ local Channel = {
 }
 
 
+-- abandon takes co off every channel and timeout it
+-- waits on, and out of the run queue, so it never
+-- runs again.
+local function abandon(co)
+   local alt_array = blocked[co]
+   if alt_array ~= nil then
+      altalldequeue(alt_array)
+      blocked[co] = nil
+   end
+   tasks_to[co] = nil
+   task_park(co)
+end
+
+-- wait_reason is what Go's runtime would say the
+-- goroutine parked on alt_array waits for. Parking
+-- that is not a channel operation in Go, as in
+-- sync.lua, gives its own reason.
+local function wait_reason(alt_array)
+   if alt_array.reason ~= nil then
+      return alt_array.reason
+   end
+   if #alt_array == 0 then
+      return "select (no cases)"
+   end
+   if #alt_array > 1 then
+      return "select"
+   end
+   if alt_array[1].op == SEND then
+      return "chan send"
+   end
+   return "chan receive"
+end
+
+-- go_position is the Go file and line of a frame in
+-- code from the prompt, or nil for prelude code and
+-- anything else not translated here.
+local function go_position(info)
+   if __gijit_goLine == nil or info.source == nil or info.currentline == nil then
+      return nil
+   end
+   local label, line = __gijit_goLine(info.source, info.currentline)
+   line = tonumber(line)
+   if label == "" or line == 0 then
+      return nil
+   end
+   return label..":"..tostring(line)
+end
+
+-- go_func_name names a frame's function as Go would,
+-- as far as Lua knows it. Functions reached by pcall,
+-- as goroutine bodies are, have no name of their own;
+-- a global holding them will do.
+local function go_func_name(info)
+   if info.what == "main" then
+      return "main.main"
+   end
+   if info.name ~= nil then
+      return "main."..info.name
+   end
+   if info.func ~= nil then
+      for k, v in pairs(_G) do
+         if v == info.func and type(k) == "string" then
+            return "main."..k
+         end
+      end
+   end
+   return "main.func"
+end
+
+-- dump describes the parked goroutines the way Go
+-- does on deadlock: each one's id, what it waits
+-- for, and the Go functions and lines it is in.
+local function dump()
+   local cos = {}
+   for co in pairs(blocked) do
+      local notes = __coro2notes[co]
+      if notes ~= nil and notes.__id ~= nil then
+         table.insert(cos, co)
+      end
+   end
+   table.sort(cos, function(a, b)
+                 return __coro2notes[a].__id < __coro2notes[b].__id
+   end)
+
+   local out = {}
+   for _, co in ipairs(cos) do
+      local notes = __coro2notes[co]
+      local lines = {"goroutine "..tostring(notes.__id).." ["..wait_reason(blocked[co]).."]:"}
+      local level = 0
+      while true do
+         local info = debug.getinfo(co, level, "Slnf")
+         if info == nil then
+            break
+         end
+         local pos = go_position(info)
+         if pos ~= nil then
+            local name = go_func_name(info)
+            if name == "main.main" then
+               table.insert(lines, name.."()")
+            else
+               table.insert(lines, name.."(...)")
+            end
+            table.insert(lines, "\t"..pos)
+         end
+         level = level + 1
+      end
+      local created = notes.__created
+      if created ~= nil then
+         local pos = go_position(created)
+         if pos ~= nil then
+            local by = "created by "..go_func_name(created)
+            if notes.__creator ~= nil then
+               by = by.." in goroutine "..tostring(notes.__creator)
+            end
+            table.insert(lines, by)
+            table.insert(lines, "\t"..pos)
+         end
+      end
+      table.insert(out, table.concat(lines, "\n"))
+   end
+   return table.concat(out, "\n\n")
+end
+
+-- sleep parks the running goroutine for d
+-- nanoseconds, leaving the others to run.
+local function sleep(d)
+   if d <= 0 then
+      return
+   end
+   local c = Channel:new(1)
+   add_timer({when = __abs_now() + d, f = function() c:nbsend(true) end})
+   c:recv()
+end
+
 local background_scheduler = function()
    while true do
       scheduler()
@@ -710,6 +1091,9 @@ __resume_scheduler = function()
    
    local ok, err = coroutine.resume(scheduler_co, "resume_scheduler")
    --print("__task.resume_scheduler back from coroutine.resume(scheduler_co)")
+   if not ok and is_interrupt(err) then
+      error(err)
+   end
    if not ok then
       print("error detected in __task.resume_scheduler!")
       print(debug.traceback(err))
@@ -721,12 +1105,33 @@ __resume_scheduler = function()
 end
 
 
+-- __reset_scheduler abandons every task, after an
+-- interrupted eval, takes the eval off any channel
+-- it was blocked on, and replaces the scheduler
+-- coroutine if the interrupt killed it.
+local __reset_scheduler = function()
+   if __gijitEvalCoro ~= nil then
+      abandon(__gijitEvalCoro)
+   end
+   tasks_runnable = {}
+   tasks_to = {}
+   timers = {}
+   native.reset()
+   if coroutine.status(scheduler_co) == "dead" then
+      scheduler_co = coroutine.create(background_scheduler)
+      table.insert(__all_coro, scheduler_co)
+      __coro2notes[scheduler_co]={__loc=#__all_coro, __name="scheduler"}
+   end
+   __cleanupDeadCoro()
+end
+
 ----------------------------------------------------------------------------
 -- Public interface
 
 __task = __M
 
 __task.resume_scheduler = __resume_scheduler
+__task.reset_scheduler = __reset_scheduler
 
 __task.scheduler = scheduler
 __task.spawn     = spawn
@@ -736,19 +1141,45 @@ __task.RECV      = RECV
 __task.SEND      = SEND
 __task.NOP       = NOP
 __task.Error     = {TIMEOUT = TIMEOUT}
+__task.sleep     = sleep
+__task.addTimer  = add_timer
+__task.stopTimer = del_timer
+__task.abandon   = abandon
+__task.blocked   = function(co) return blocked[co] end
+__task.dump      = dump
+__task.native    = native
+__task.resolve   = resolve
+__task.newSet    = function() return Set:new() end
+
+-- busy reports whether any goroutine but the
+-- caller can still run: now, on a timer, or when a
+-- native channel it is parked on goes.
+__task.busy      = function()
+   return #tasks_runnable > 0 or #timers > 0 or native.parked()
+end
 ----------------------------------------------------------------------------
 ----------------------------------------------------------------------------
 
+-- a native channel, from Go, is used by way of the
+-- Channel that stands for it, so that it doesn't
+-- block the thread.
+local function chanOf(chan)
+   if type(chan) == "userdata" then
+      return native.wrap(chan) or chan
+   end
+   return chan
+end
+
 __send = function(chan, value)
-   return chan:send(value)
+   return chanOf(chan):send(value)
 end
 
 __recv = function(chan)
    -- no longer wrap in a tuple for now; that's what js did
    -- only because it lacks multiple assignment.
-   return chan:recv()
+   return chanOf(chan):recv()
 end
 
 __close = function(chan)
-   return chan:close()
+   return chanOf(chan):close()
 end
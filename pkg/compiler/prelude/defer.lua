@@ -63,6 +63,13 @@ panic = function(err)
    error(__recoverVal)
 end
 
+-- Ctrl-C during an eval sets a debug hook that calls
+-- __gijit_interrupt in whatever code is running, so
+-- a runaway loop panics, and its defers still run.
+__gijit_interrupt = function()
+   panic("interrupted")
+end
+
 
   -- begin boilerplate part 2:
   
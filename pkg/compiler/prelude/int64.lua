@@ -38,6 +38,36 @@ float32 = ffi.typeof("float")
 
 -- to display floats, use: tonumber() to convert to float64 that lua can print.
 
+-- The narrower integer types are held in int64 and
+-- uint64 cdata too. After any operation that can
+-- overflow, the result is cut back to its width:
+-- the signed types are sign-extended, the unsigned
+-- masked, as compiled Go would wrap them.
+
+function __truncInt8(x)
+   return __bit.arshift(__bit.lshift(int64(x), 56), 56)
+end
+
+function __truncInt16(x)
+   return __bit.arshift(__bit.lshift(int64(x), 48), 48)
+end
+
+function __truncInt32(x)
+   return __bit.arshift(__bit.lshift(int64(x), 32), 32)
+end
+
+function __truncUint8(x)
+   return __bit.band(uint64(x), 0xffULL)
+end
+
+function __truncUint16(x)
+   return __bit.band(uint64(x), 0xffffULL)
+end
+
+function __truncUint32(x)
+   return __bit.band(uint64(x), 0xffffffffULL)
+end
+
 --MinInt64: -9223372036854775808
 --MaxInt64: 9223372036854775807
 
@@ -87,6 +117,16 @@ function __newByteArray(vals)
    return res
 end
 
+-- __stringByte is s[i], which in Go is the i-th
+-- byte, not the i-th character.
+__stringByte = function(s, i)
+   i = tonumber(i)
+   if i < 0 or i >= #s then
+      error("index out of range: i="..tostring(i).." vs #s is "..tostring(#s))
+   end
+   return uint64(string.byte(s, i+1))
+end
+
 __stringToBytes = function(str)
    return __newByteArray(str)
 end;
@@ -37,6 +37,30 @@ __integerByZeroCheck = function(x)
    return x + (-x % 1)
 end
 
+-- __integerQuo and __integerRem divide as Go does,
+-- truncating toward zero. The int64 and uint64 cdata
+-- already do so, like C, but never fail; so check
+-- the divisor first.
+__integerQuo = function(x, y)
+   if y == 0 then
+      error("integer divide by zero")
+   end
+   if type(x) == "cdata" or type(y) == "cdata" then
+      return x / y
+   end
+   return __truncateToInt(x / y)
+end
+
+__integerRem = function(x, y)
+   if y == 0 then
+      error("integer divide by zero")
+   end
+   if type(x) == "cdata" or type(y) == "cdata" then
+      return x % y
+   end
+   return __builtin_math.fmod(x, y)
+end
+
 function __max(a,b)
    if a > b then
       return a
@@ -0,0 +1,76 @@
+-- parallel.lua: gi.Go, which runs a top-level func
+-- as a goroutine on one of the worker states of an
+-- Interpreter's Parallel pool, each a LuaJIT state
+-- of its own on a thread of its own; see
+-- parallel.go. Only what can be copied crosses:
+-- numbers, strings and bools, and channels, which
+-- go across as the native channel behind them.
+
+-- the global name of each top-level func met, the
+-- name being all a worker can find it by.
+local funcNames = setmetatable({}, {__mode = "k"})
+
+local function funcName(f)
+   local name = funcNames[f]
+   if name == nil then
+      for k, v in pairs(_G) do
+         if rawequal(v, f) and type(k) == "string" then
+            name = k
+            break
+         end
+      end
+      if name == nil then
+         panic("gi.Go: only a top-level func can run on another state")
+      end
+      funcNames[f] = name
+   end
+   return name
+end
+
+-- crossing gives what goes across for v: for an
+-- interpreted channel, its native side, made now if
+-- need be, of the Go type of the channel.
+local function crossing(v)
+   if type(v) ~= "table" or v.__name ~= "__valChannel" then
+      return v
+   end
+   local ok, t = pcall(__gijitTypeToGoType, v.__elemTyp)
+   if not ok then
+      panic("gi.Go: a chan of "..tostring(v.__elemTyp.__str).." cannot cross to another state")
+   end
+   return v:__toNative(reflect.ChanOf(3, t))
+end
+
+-- __gijit_schedGi replaces, in the "gi" package
+-- imported as the global name, the stub of Go, when
+-- the Interpreter has a Parallel pool.
+function __gijit_schedGi(name)
+   if __gijit_parallelGo == nil then
+      return
+   end
+   local host = _G[name]
+   local pkg = setmetatable({}, {__index = host})
+
+   pkg.Go = function(f, ...)
+      if type(f) ~= "function" then
+         panic("gi.Go: not a func: "..tostring(f))
+      end
+      local n = select("#", ...)
+      local args = {...}
+      for i = 1, n do
+         args[i] = crossing(args[i])
+      end
+      __gijit_parallelGo(funcName(f), unpack(args, 1, n))
+   end
+
+   _G[name] = pkg
+end
+
+-- __gijit_parallelDrain waits, on a worker, for the
+-- goroutines a job started to finish, or to park for
+-- good, before the worker takes another job.
+function __gijit_parallelDrain()
+   while __task.busy() do
+      __task.sleep(1000000LL)
+   end
+end
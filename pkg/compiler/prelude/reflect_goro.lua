@@ -1,134 +1,175 @@
--- reflect_goro.lua:
+-- reflect_goro.lua: the bridge between the
+-- coroutine channels of chan.lua and native Go
+-- channels, so that interpreted goroutines and Go's
+-- own can send to, receive from, and select on the
+-- same channel, made on either side.
 --
--- refactor from goroutines.lua into
--- their own file: implementation of
--- send/receive/select that interact
--- with native Go channels using reflect.
+-- A native channel that interpreted code meets, as
+-- a Go function's result say, gets a Channel that
+-- stands for it, with the native one in __native.
+-- An interpreted Channel passed to Go gets a native
+-- one made for it, in the same place, by luar
+-- calling its __toNative.
+--
+-- Either way the buffer then lives in the native
+-- channel, and each side meets its own kind there:
+-- coroutines on the Channel's queues, as before,
+-- and Go's goroutines in the native channel. The
+-- two sides meet through the native channel's
+-- non-blocking operations: a select tries them
+-- before it parks, and the scheduler tries them
+-- again for the coroutines parked, now and then and
+-- whenever it has nothing else to run. Only when
+-- the eval itself waits, and nothing can run, does
+-- the scheduler wait on the native channels
+-- themselves, as it waits for a timer.
 
+local native = __task.native
+local Channel = __task.Channel
+local RECV, SEND = __task.RECV, __task.SEND
 
-__recv_via_reflect = function(wchan)
-   --print("__recv_via_reflect called! wchan=")
-   --__st(wchan, "wchan")
-   if wchan == nil then
-      error("cannot read from nil channel")
-   end
+-- the Channel for a native channel, by the
+-- channel's address: however many proxies of it
+-- come through luar, the coroutines parked on each
+-- have to meet.
+local wrappers = setmetatable({}, {__mode = "v"})
 
-   -- unwrap
-   if wchan.__native == nil then
-      -- may not be wrapped; if native Go channel supplied
-      chan = wchan
-   else
-      chan = wchan.__native.Interface()
-   end
+-- every Channel with a native side.
+local bridged = setmetatable({}, {__mode = "k"})
 
-   local ch = reflect.ValueOf(chan)
-   local rv, ok = ch.Recv();
-   -- rv is userdata, a reflect.Value. Convert to
-   -- interface{} for Luar, using Interface(), so
-   -- luar can translate that to Lua for us.
-   local v = rv.Interface();
-   return {v, ok}
+local function adopt(c, ch)
+   c.__native = ch
+   wrappers[__gijit_chanKey(ch)] = c
+   bridged[c] = true
 end
 
-__send_via_reflect = function(wchan, value)
-   --print("__send_via_reflect called! value=", value)
-   --__st(value, "value")
-   --__st(wchan, "wchan")
-   if wchan == nil then
-      error("cannot send on nil channel")
+-- wrap gives the Channel that stands for v, or nil
+-- if v is not a native channel.
+native.wrap = function(v)
+   local key = __gijit_chanKey(v)
+   if key == nil then
+      return nil
    end
-
-   -- unwrap
-   if wchan.__native == nil then
-      -- may not be wrapped; if native Go channel supplied
-      chan = wchan
-   else
-      chan = wchan.__native.Interface()
+   local c = wrappers[key]
+   if c == nil then
+      c = Channel:new(0)
+      adopt(c, v)
    end
+   return c
+end
 
-   local ch = reflect.ValueOf(chan)
-   local v = reflect.ValueOf(value)
-   local cv = v.Convert(reflect.TypeOf(chan).Elem())
-   ch.Send(cv);
+-- __toNative gives luar the native channel, of the
+-- channel type t, for c: made the first time, with
+-- c's capacity and what c has buffered.
+Channel.__toNative = function(self, t)
+   if self.__native == nil then
+      local ch = __gijit_chanMake(t, tonumber(self._buf.size))
+      while self._buf:len() > 0 do
+         __gijit_chanTrySend(ch, self._buf:pop())
+      end
+      if self._closed then
+         __gijit_chanClose(ch)
+      end
+      adopt(self, ch)
+   end
+   return self.__native
 end
 
-__select_via_reflect = function(comms)
-   --print("__select_via_reflect called!")
-   --__st(comms, "comms")
-   
-   --__st(comms[1], "comms[1]")
-   --__st(comms[2], "comms[2]")
-   --__st(comms[3], "comms[3]")
-   
-   --__st(comms[1][1], "comms[1][1]")
-   --__st(comms[2][1], "comms[2][1]")
-   --__st(comms[3][1], "comms[3][1]")
+-- got records, on a's select, that a went: with the
+-- value and ok for a receive; closed for a send on
+-- a closed channel, which panics in the sender.
+local function got(a, v, ok, closed)
+   local alt_array = a.alt_array
+   if a.op == RECV then
+      alt_array.value = v
+      if not ok then
+         alt_array.closed = true
+      end
+   elseif closed then
+      alt_array.sendClosed = true
+   end
+end
 
-   local c1 = reflect.ValueOf(comms[1][1])
-   local c2 = reflect.ValueOf(comms[2][1])
+-- try does a on the native side of its channel, if
+-- it can go without blocking, and reports whether
+-- it did.
+native.try = function(a)
+   local ch = a.c.__native
+   if a.op == RECV then
+      local ready, v, ok = __gijit_chanTryRecv(ch)
+      if not ready then
+         return false
+      end
+      got(a, v, ok)
+      return true
+   end
+   local sent, closed = __gijit_chanTrySend(ch, a.p)
+   if not sent and not closed then
+      return false
+   end
+   got(a, nil, nil, closed)
+   return true
+end
 
-   --print("c1 is "..type(c1))
-   --print("c2 is "..type(c2))
-      
-   --print("c1 = "..tostring(c1))
-   --print("c2 = "..tostring(c2))
+-- parked lists the cases parked on native sides.
+local function parked()
+   local alts = {}
+   for c in pairs(bridged) do
+      for _, op in ipairs({RECV, SEND}) do
+         for _, a in ipairs(c:_get_alts(op).l) do
+            table.insert(alts, a)
+         end
+      end
+   end
+   return alts
+end
 
-   local cases = {}
-   local casesType = {}
-   local rty = reflect.TypeOf(__refSelCaseVal).Elem()
-   
-    for i, comm in ipairs(comms) do
-      local chan = comm[1];
-      --switch (comm.length)
-      local comm_len = #comm
+native.parked = function()
+   for c in pairs(bridged) do
+      if c._recv_alts:len() > 0 or c._send_alts:len() > 0 then
+         return true
+      end
+   end
+   return false
+end
 
-      local newCase = reflect.New(rty).Interface()
-      
-      if comm_len == 0 then
-         -- default --
-         --print("comm_len is 0/default at i =", i)
-         newCase.Dir  = 3
-         table.insert(cases, newCase)
-         casesType[i-1]="d"
-                      
-      elseif comm_len == 1 then
-         -- recv --
-         --print("comm_len is 1/recv at i =", i)
-         newCase.Chan = reflect.ValueOf(comm[1])
-         newCase.Dir  = 2
-         --print("newCase is ", newCase)
-         --fmt.Printf("newCase is %#v\n", newCase)
-         --fmt.Printf("newCase.dir is %#v\n", newCase.Dir)
-         table.insert(cases, newCase)
-         casesType[i-1]="r"
-         
-      elseif comm_len == 2 then
-         -- send --
-         --print("comm_len is 2/send at i =", i)
+-- poll tries the cases parked on native sides,
+-- readying the coroutines whose case went.
+native.poll = function()
+   for _, a in ipairs(parked()) do
+      -- another case of the same select went
+      -- already.
+      if a.alt_array.resolved == nil and native.try(a) then
+         __task.resolve(a)
+      end
+   end
+end
 
-         newCase.Chan = reflect.ValueOf(comm[1])
-         newCase.Dir  = 1
-         newCase.Send = reflect.ValueOf(comm[2]) -- maybe comm[2]? or?
-         --__st(comm, "comm in send case")
-         --fmt.Printf("in send, newCase.Send is %#v\n", newCase.Send)
-         
-         table.insert(cases, newCase)
-         casesType[i-1]="s"
-         
-      end -- end switch
+-- wait blocks the thread, for at most ns
+-- nanoseconds, until a case parked on a native side
+-- can go, and does it. It reports false, at once,
+-- if none is parked.
+native.wait = function(ns)
+   local alts = parked()
+   if #alts == 0 then
+      return false
+   end
+   local cases = {}
+   for i, a in ipairs(alts) do
+      cases[i] = {a.c.__native, a.op == SEND, a.p}
    end
-   
-   local chosen, recv, recvOk = reflect.Select(cases)
-   --print("back from reflect.Select, we got: chosen=", chosen)
-   --print("back from reflect.Select, we got:   recv=", recv)
-   --print("back from reflect.Select, we got: recvOk=", recvOk)
+   local i, v, ok = __gijit_chanWait(cases, tonumber(ns))
+   if i > 0 then
+      got(alts[i], v, ok)
+      __task.resolve(alts[i])
+   end
+   return true
+end
 
-   local recvVal = nil
-   --__st(casesType, "casesType")
-   --print("chosen is ", chosen, " and casesType[chosen]= ", casesType[tonumber(chosen)])
-   if casesType[tonumber(chosen)]=="r" then
-      recvVal = recv.Interface()
-      --print("recvVal in receive case is ", recvVal)
+-- reset forgets the coroutines parked on native
+-- sides, when the scheduler abandons every task.
+native.reset = function()
+   for c in pairs(bridged) do
+      c._recv_alts, c._send_alts = __task.newSet(), __task.newSet()
    end
-   return {chosen, {recvVal, recvOk}};
 end
@@ -0,0 +1,413 @@
+-- sync.lua: Mutex, RWMutex, WaitGroup, Once and
+-- Cond, and sync/atomic, for interpreted code.
+-- Goroutines are coroutines on one thread, so the
+-- native Wait, or a contended Lock, would block the
+-- whole Lua state; these park only the goroutine
+-- that waits, and the scheduler in chan.lua runs
+-- the rest until it is woken. Values from native
+-- code, such as a field of a native struct, stay
+-- native.
+
+----------------------------------------------------------------------------
+-- waiter queues
+
+local queueMeta = {}
+
+local function newQueue()
+   return setmetatable({first = 1, last = 0}, queueMeta)
+end
+
+local function queueLen(q)
+   return q.last - q.first + 1
+end
+
+-- park waits, on a channel of its own at the back of
+-- q, until wake gets to it. reason is what the
+-- goroutine dump says it waits for.
+local function park(q, reason)
+   local c = __task.Channel:new(1)
+   q.last = q.last + 1
+   q[q.last] = c
+   __task.select({{c = c, op = __task.RECV}, reason = reason})
+end
+
+-- wake readies the goroutine parked longest on q,
+-- and reports whether there was one.
+local function wake(q)
+   if q.first > q.last then
+      return false
+   end
+   local c = q[q.first]
+   q[q.first] = nil
+   q.first = q.first + 1
+   c:nbsend(true)
+   return true
+end
+
+local function wakeAll(q)
+   while wake(q) do
+   end
+end
+
+-- copyOf copies a sync value's state, as Go's
+-- assignment would, but not its waiters.
+local function copyOf(src)
+   local o = setmetatable({}, getmetatable(src))
+   for k, v in pairs(src) do
+      if getmetatable(v) == queueMeta then
+         o[k] = newQueue()
+      else
+         o[k] = v
+      end
+   end
+   return o
+end
+
+----------------------------------------------------------------------------
+-- Mutex
+
+local mutexMethods = {}
+local mutexMeta = {__index = mutexMethods}
+
+local function newMutex()
+   return setmetatable({__locked = false, __waiters = newQueue()}, mutexMeta)
+end
+
+-- a woken waiter is handed the lock by Unlock, so a
+-- stream of new Lock calls can't starve it.
+mutexMethods.Lock = function(self)
+   if not self.__locked then
+      self.__locked = true
+      return
+   end
+   park(self.__waiters, "sync.Mutex.Lock")
+end
+
+mutexMethods.TryLock = function(self)
+   if self.__locked then
+      return false
+   end
+   self.__locked = true
+   return true
+end
+
+mutexMethods.Unlock = function(self)
+   if not self.__locked then
+      panic("sync: unlock of unlocked mutex")
+   end
+   if not wake(self.__waiters) then
+      self.__locked = false
+   end
+end
+
+----------------------------------------------------------------------------
+-- RWMutex
+
+local rwMethods = {}
+local rwMeta = {__index = rwMethods}
+
+local function newRWMutex()
+   return setmetatable({
+         __readers = 0,
+         __writer = false,
+         __readWaiters = newQueue(),
+         __writeWaiters = newQueue(),
+   }, rwMeta)
+end
+
+-- a waiting writer holds off new readers, as in Go.
+rwMethods.RLock = function(self)
+   if self.__writer or queueLen(self.__writeWaiters) > 0 then
+      park(self.__readWaiters, "sync.RWMutex.RLock")
+      return
+   end
+   self.__readers = self.__readers + 1
+end
+
+rwMethods.TryRLock = function(self)
+   if self.__writer or queueLen(self.__writeWaiters) > 0 then
+      return false
+   end
+   self.__readers = self.__readers + 1
+   return true
+end
+
+rwMethods.RUnlock = function(self)
+   if self.__readers <= 0 then
+      panic("sync: RUnlock of unlocked RWMutex")
+   end
+   self.__readers = self.__readers - 1
+   if self.__readers == 0 and wake(self.__writeWaiters) then
+      self.__writer = true
+   end
+end
+
+rwMethods.Lock = function(self)
+   if self.__writer or self.__readers > 0 then
+      park(self.__writeWaiters, "sync.RWMutex.Lock")
+      return
+   end
+   self.__writer = true
+end
+
+rwMethods.TryLock = function(self)
+   if self.__writer or self.__readers > 0 then
+      return false
+   end
+   self.__writer = true
+   return true
+end
+
+-- Unlock lets in the readers that waited on this
+-- writer before the next writer.
+rwMethods.Unlock = function(self)
+   if not self.__writer then
+      panic("sync: Unlock of unlocked RWMutex")
+   end
+   self.__writer = false
+   local n = queueLen(self.__readWaiters)
+   if n > 0 then
+      self.__readers = self.__readers + n
+      wakeAll(self.__readWaiters)
+   elseif wake(self.__writeWaiters) then
+      self.__writer = true
+   end
+end
+
+rwMethods.RLocker = function(self)
+   return {
+      Lock = function() self:RLock() end,
+      Unlock = function() self:RUnlock() end,
+   }
+end
+
+----------------------------------------------------------------------------
+-- WaitGroup
+
+local wgMethods = {}
+local wgMeta = {__index = wgMethods}
+
+local function newWaitGroup()
+   return setmetatable({__n = 0, __waiters = newQueue()}, wgMeta)
+end
+
+wgMethods.Add = function(self, delta)
+   self.__n = self.__n + delta
+   if self.__n < 0 then
+      panic("sync: negative WaitGroup counter")
+   end
+   if self.__n == 0 then
+      wakeAll(self.__waiters)
+   end
+end
+
+wgMethods.Done = function(self)
+   self:Add(-1)
+end
+
+wgMethods.Wait = function(self)
+   if self.__n == 0 then
+      return
+   end
+   park(self.__waiters, "sync.WaitGroup.Wait")
+end
+
+----------------------------------------------------------------------------
+-- Once
+
+local onceMethods = {}
+local onceMeta = {__index = onceMethods}
+
+local function newOnce()
+   return setmetatable({__done = false, __running = false, __waiters = newQueue()}, onceMeta)
+end
+
+-- Do's f counts as done even if it panics; callers
+-- that come while it runs wait for it to return.
+onceMethods.Do = function(self, f)
+   if self.__done then
+      return
+   end
+   if self.__running then
+      park(self.__waiters, "sync.Once.Do")
+      return
+   end
+   self.__running = true
+   local ok, err = pcall(f)
+   self.__done = true
+   self.__running = false
+   wakeAll(self.__waiters)
+   if not ok then
+      error(err, 0)
+   end
+end
+
+----------------------------------------------------------------------------
+-- Cond
+
+local condMethods = {}
+local condMeta = {__index = condMethods}
+
+local function newCond(l)
+   return setmetatable({L = l, __waiters = newQueue()}, condMeta)
+end
+
+condMethods.Wait = function(self)
+   self.L:Unlock()
+   park(self.__waiters, "sync.Cond.Wait")
+   self.L:Lock()
+end
+
+condMethods.Signal = function(self)
+   wake(self.__waiters)
+end
+
+condMethods.Broadcast = function(self)
+   wakeAll(self.__waiters)
+end
+
+----------------------------------------------------------------------------
+
+-- schedType makes the type wrapper typ, from a
+-- shadow or host package, give meta's values: a new
+-- one for the zero value, and copies of them. Other
+-- sources are native, and stay so.
+local function schedType(typ, meta, new)
+   if typ == nil then
+      return
+   end
+   local native = typ.__call
+   typ.__call = function(t, src)
+      if src == nil then
+         return new()
+      end
+      if getmetatable(src) == meta then
+         return copyOf(src)
+      end
+      return native(t, src)
+   end
+end
+
+-- __gijit_schedSync replaces, in the "sync" package
+-- imported as the global name, the types that block
+-- and NewCond. Map and Pool are still the host's.
+function __gijit_schedSync(name)
+   local host = _G[name]
+   local pkg = setmetatable({}, {__index = host})
+   local types = __type__[name] or {}
+
+   schedType(types.Mutex, mutexMeta, newMutex)
+   schedType(types.RWMutex, rwMeta, newRWMutex)
+   schedType(types.WaitGroup, wgMeta, newWaitGroup)
+   schedType(types.Once, onceMeta, newOnce)
+   schedType(types.Cond, condMeta, function() return newCond(nil) end)
+
+   pkg.NewCond = newCond
+
+   _G[name] = pkg
+end
+
+----------------------------------------------------------------------------
+-- sync/atomic
+
+-- the native functions take Go pointers; interpreted
+-- pointers have __get and __set instead. Goroutines
+-- only switch at a channel operation, so each of
+-- these is atomic as it stands.
+
+local function identity(x)
+   return x
+end
+
+-- wrapping, for the widths Add can overflow.
+local atomicWidths = {
+   Int32 = __truncInt32,
+   Int64 = identity,
+   Uint32 = __truncUint32,
+   Uint64 = identity,
+   Uintptr = identity,
+}
+
+local function atomicFuncs(pkg, kind, wrap)
+   if wrap ~= nil then
+      pkg["Add"..kind] = function(p, delta)
+         local v = wrap(p.__get() + delta)
+         p.__set(v)
+         return v
+      end
+   end
+   pkg["Load"..kind] = function(p)
+      return p.__get()
+   end
+   pkg["Store"..kind] = function(p, v)
+      p.__set(v)
+   end
+   pkg["Swap"..kind] = function(p, new)
+      local old = p.__get()
+      p.__set(new)
+      return old
+   end
+   pkg["CompareAndSwap"..kind] = function(p, old, new)
+      if p.__get() ~= old then
+         return false
+      end
+      p.__set(new)
+      return true
+   end
+end
+
+local valueMethods = {}
+local valueMeta = {__index = valueMethods}
+
+local function newValue()
+   return setmetatable({}, valueMeta)
+end
+
+valueMethods.Load = function(self)
+   return self.__v
+end
+
+valueMethods.Store = function(self, v)
+   if v == nil then
+      panic("sync/atomic: store of nil value into Value")
+   end
+   self.__v = v
+end
+
+valueMethods.Swap = function(self, new)
+   if new == nil then
+      panic("sync/atomic: swap of nil value into Value")
+   end
+   local old = self.__v
+   self.__v = new
+   return old
+end
+
+valueMethods.CompareAndSwap = function(self, old, new)
+   if new == nil then
+      panic("sync/atomic: compare and swap of nil value into Value")
+   end
+   if self.__v ~= old then
+      return false
+   end
+   self.__v = new
+   return true
+end
+
+-- __gijit_schedAtomic replaces, in the "sync/atomic"
+-- package imported as the global name, the functions
+-- and Value, with ones that work on interpreted
+-- pointers and values.
+function __gijit_schedAtomic(name)
+   local host = _G[name]
+   local pkg = setmetatable({}, {__index = host})
+   local types = __type__[name] or {}
+
+   for kind, wrap in pairs(atomicWidths) do
+      atomicFuncs(pkg, kind, wrap)
+   end
+   atomicFuncs(pkg, "Pointer", nil)
+   schedType(types.Value, valueMeta, newValue)
+
+   _G[name] = pkg
+end
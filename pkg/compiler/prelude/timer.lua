@@ -0,0 +1,247 @@
+-- timer.lua: time.Sleep, timers, tickers and
+-- context deadlines for interpreted code. The
+-- native versions would block the whole Lua state;
+-- these are fired by the scheduler in chan.lua
+-- instead, so only the goroutine waiting on one
+-- is parked.
+
+local timerMethods = {
+   Stop = function(self)
+      return __task.stopTimer(self.__t)
+   end,
+
+   Reset = function(self, d)
+      local active = __task.stopTimer(self.__t)
+      if self.__t.period ~= nil then
+         self.__t.period = d
+      end
+      self.__t.when = __abs_now() + d
+      __task.addTimer(self.__t)
+      return active
+   end,
+}
+local timerMeta = {__index = timerMethods}
+
+local function newTimer(d, f, period)
+   local tm = setmetatable({}, timerMeta)
+   tm.__t = {when = __abs_now() + d, period = period, f = f}
+   __task.addTimer(tm.__t)
+   return tm
+end
+
+-- sendTime makes a timer's f. The channel has room
+-- for one value, as in Go: a reader that falls
+-- behind misses ticks rather than blocking the
+-- scheduler.
+local function sendTime(c)
+   return function()
+      c:nbsend(__gijit_timeNow())
+   end
+end
+
+-- __gijit_schedTime replaces, in the "time" package
+-- imported as the global name, the members that
+-- block or fire later. The rest are still the
+-- host's.
+function __gijit_schedTime(name)
+   local host = _G[name]
+   local pkg = setmetatable({}, {__index = host})
+
+   pkg.Sleep = function(d)
+      __task.sleep(d)
+   end
+
+   pkg.NewTimer = function(d)
+      local c = __task.Channel:new(1)
+      local tm = newTimer(d, sendTime(c))
+      tm.C = c
+      return tm
+   end
+
+   pkg.After = function(d)
+      return pkg.NewTimer(d).C
+   end
+
+   pkg.AfterFunc = function(d, f)
+      return newTimer(d, function()
+                         __task.spawn(f, {})
+      end)
+   end
+
+   pkg.NewTicker = function(d)
+      if d <= 0 then
+         panic("non-positive interval for NewTicker")
+      end
+      local c = __task.Channel:new(1)
+      local tk = newTimer(d, sendTime(c), d)
+      tk.C = c
+      return tk
+   end
+
+   pkg.Tick = function(d)
+      if d <= 0 then
+         return nil
+      end
+      return pkg.NewTicker(d).C
+   end
+
+   _G[name] = pkg
+end
+
+----------------------------------------------------------------------------
+-- context
+
+local ctxMethods = {}
+local ctxMeta = {__index = ctxMethods}
+
+-- the errors, as the host's "context" package
+-- has them, once it is imported.
+local ctxCanceled = "context canceled"
+local ctxDeadlineExceeded = "context deadline exceeded"
+
+ctxMethods.Done = function(self)
+   return self.__done
+end
+
+-- only cancelable contexts, those with children,
+-- hold their own error.
+ctxMethods.Err = function(self)
+   if self.__children == nil and self.__parent ~= nil then
+      return self.__parent:Err()
+   end
+   return self.__err
+end
+
+ctxMethods.Deadline = function(self)
+   if self.__deadline ~= nil then
+      return self.__deadline, true
+   end
+   return __gijit_timeZero(), false
+end
+
+ctxMethods.Value = function(self, key)
+   local c = self
+   while c ~= nil do
+      if c.__hasKey and __interfaceIsEqual(c.__key, key) then
+         return c.__val
+      end
+      c = c.__parent
+   end
+   return nil
+end
+
+local function cancelCtx(c, err)
+   if c.__err ~= nil then
+      return
+   end
+   c.__err = err
+   if c.__timer ~= nil then
+      __task.stopTimer(c.__timer)
+   end
+   c.__done:close()
+   for child in pairs(c.__children) do
+      cancelCtx(child, err)
+   end
+   c.__children = {}
+end
+
+-- newCtx derives a context from parent, sharing
+-- its done channel unless cancelable is set.
+local function newCtx(parent, cancelable)
+   if getmetatable(parent) ~= ctxMeta then
+      panic("cannot create context from a context not made by the interpreted context package")
+   end
+   local c = setmetatable({
+         __parent = parent,
+         __done = parent.__done,
+         __deadline = parent.__deadline,
+         __deadlineAbs = parent.__deadlineAbs,
+   }, ctxMeta)
+   if not cancelable then
+      return c
+   end
+   c.__done = __task.Channel:new(0)
+   c.__children = {}
+   -- hook up to the nearest cancelable ancestor.
+   local p = parent
+   while p ~= nil and p.__children == nil do
+      p = p.__parent
+   end
+   if p ~= nil then
+      if p.__err ~= nil then
+         cancelCtx(c, p.__err)
+      else
+         p.__children[c] = true
+      end
+   end
+   return c
+end
+
+-- __gijit_schedContext replaces, in the "context"
+-- package imported as the global name, the members
+-- that make contexts, with ones whose Done channels
+-- the scheduler closes.
+function __gijit_schedContext(name)
+   local host = _G[name]
+   local pkg = setmetatable({}, {__index = host})
+
+   ctxCanceled = host.Canceled or ctxCanceled
+   ctxDeadlineExceeded = host.DeadlineExceeded or ctxDeadlineExceeded
+
+   local background = setmetatable({__done = __task.Channel:new(0)}, ctxMeta)
+   local todo = setmetatable({__done = __task.Channel:new(0)}, ctxMeta)
+
+   pkg.Background = function()
+      return background
+   end
+
+   pkg.TODO = function()
+      return todo
+   end
+
+   pkg.WithCancel = function(parent)
+      local c = newCtx(parent, true)
+      return c, function()
+         cancelCtx(c, ctxCanceled)
+      end
+   end
+
+   -- withDeadlineAbs is WithDeadline, given the
+   -- deadline both as a time.Time and as __abs_now()
+   -- nanoseconds.
+   local function withDeadlineAbs(parent, deadline, abs)
+      if parent.__deadlineAbs ~= nil and parent.__deadlineAbs <= abs then
+         return pkg.WithCancel(parent)
+      end
+      local c = newCtx(parent, true)
+      c.__deadline = deadline
+      c.__deadlineAbs = abs
+      if c.__err == nil then
+         c.__timer = {when = abs, f = function()
+                         cancelCtx(c, ctxDeadlineExceeded)
+         end}
+         __task.addTimer(c.__timer)
+      end
+      return c, function()
+         cancelCtx(c, ctxCanceled)
+      end
+   end
+
+   pkg.WithDeadline = function(parent, d)
+      return withDeadlineAbs(parent, d, __abs_now() + __gijit_timeUntil(d))
+   end
+
+   pkg.WithTimeout = function(parent, timeout)
+      return withDeadlineAbs(parent, __gijit_timeAfterNow(timeout), __abs_now() + timeout)
+   end
+
+   pkg.WithValue = function(parent, key, val)
+      local c = newCtx(parent, false)
+      c.__hasKey = true
+      c.__key = key
+      c.__val = val
+      return c
+   end
+
+   _G[name] = pkg
+end
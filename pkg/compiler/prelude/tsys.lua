@@ -2237,39 +2237,16 @@ end
 
 __theNilChan={__name="__theNilChan"}
 
-function __Chan(elem, capacity, elemReflectType)
+-- __Chan makes a channel for coroutines. One that
+-- goes to Go gets a native side then, of the type Go
+-- wants; see reflect_goro.lua.
+function __Chan(elem, capacity)
    --print("__Chan called")
 
    if elem == nil then
       return __theNilChan
    end
-
-   -- short cut for a moment, just the raw __taskChan
    return __task.Channel:new(capacity, elem)
-      
-   --[[
-   --print(debug.traceback())
-   local dir = 3 -- direction: 1=recv, 2=send, 3=both.
-   local elemty = __gijitTypeToGoType(elem)
-   local chtype = reflect.ChanOf(dir, elemty)
-
-   local this = {}   
-   this.__taskChan = __task.Channel:new(capacity)
-   --this.__native = reflect.MakeChan(chtype, capacity)
-
-   -- gopherJS stuff below
-   if capacity < 0  or  capacity > 2147483647 then
-      __throwRuntimeError("makechan: size out of range");
-   end
-   this.elem = elem;
-   this.__capacity = capacity;
-   this.__buffer = {};
-   this.__sendQueue = {};
-   this.__recvQueue = {};
-   this.__closed = false;
-   this.__val = this
-   return this
-   --]]
 end;
 
 
@@ -3256,13 +3233,38 @@ __throw = function(err)  error(err); end;
 
 __lastEvalErr = ""
 
+-- for the REPL's own messages; the Go side sets
+-- where they go.
+__gijit_diag = __gijit_diag or print
+
 __errHandlerForEval = function(err)
    __lastEvalErr = err
-   print("error! __errHandlerForEval sees err =", err)
-   print(debug.traceback(coroutine.running(), err))
+   __gijit_diag("error! __errHandlerForEval sees err =", err)
+   __gijit_diag(debug.traceback(coroutine.running(), err))
    return err
 end
 
+__deadlockMsg = "fatal error: all goroutines are asleep - deadlock!"
+
+-- __deadlockReport is Go's deadlock message, with
+-- the dump of the goroutines parked at the time.
+__deadlockReport = function()
+   local dump = __task.dump()
+   if dump == "" then
+      return __deadlockMsg
+   end
+   return __deadlockMsg.."\n\n"..dump
+end
+
+-- __evalDeadlocked reports code at the prompt that is
+-- blocked, with nothing left that could wake it. It
+-- runs before the eval is abandoned, so the dump still
+-- shows where the eval waits; the REPL goes on.
+__evalDeadlocked = function(co)
+   __lastEvalErr = __deadlockReport()
+   __gijit_diag(__lastEvalErr)
+end
+
 -- The main eval procedure for the gijit REPL
 -- It only compiles and runs 'code', then exits.
 --
@@ -3274,6 +3276,9 @@ __gijitMainEval = function(code)
    --print("top of main loop: while true...")
    -- compile chunk to bytecode
    chunk, err = loadstring(code);
+   if chunk ~= nil and __gijit_sandboxEnv ~= nil then
+      setfenv(chunk, __gijit_sandboxEnv)
+   end
    --print("back from loadstring of code '"..code.."'  we have err=",err," and chunk=", chunk)
    if err ~= nil then
       
@@ -3321,7 +3326,7 @@ __eval = function(code)
 
    __gijitEvalCoro = coroutine.create(function() __gijitMainEval(code) end)
    table.insert(__all_coro, __gijitEvalCoro)
-   __coro2notes[__gijitEvalCoro]={__loc=#__all_coro, __name="co-eval-"..tostring(__eval_next_count)}
+   __coro2notes[__gijitEvalCoro]={__loc=#__all_coro, __name="co-eval-"..tostring(__eval_next_count), __id=1}
    __eval_next_count = __eval_next_count+1
 
    -- we need the scheduler to resume this goroutine,
@@ -3332,6 +3337,14 @@ __eval = function(code)
    __task_ready(__gijitEvalCoro)
    __task.resume_scheduler()
 
+   -- still parked on a channel, once the scheduler has
+   -- nothing to run and no timer to wait for: nobody
+   -- can ever wake it.
+   if __task.blocked(__gijitEvalCoro) ~= nil then
+      __evalDeadlocked(__gijitEvalCoro)
+      __task.abandon(__gijitEvalCoro)
+   end
+
    __cleanupDeadCoro()   
    --print("end of __eval, returning")
    end)}
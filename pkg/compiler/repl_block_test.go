@@ -0,0 +1,127 @@
+package compiler
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test1620BlockingReceiveAndSelectAtThePrompt(t *testing.T) {
+
+	vm, err := NewLuaVmWithPrelude(nil)
+	panicOn(err)
+	defer vm.Close()
+	inc := NewIncrState(vm, nil)
+
+	run := func(src string, sigs chan os.Signal) (interrupted bool, lastErr string) {
+		translation, err := TranslateAndCatchPanic(inc, []byte(src))
+		panicOn(err)
+		interrupted, err = runInterruptible(vm, translation, true, sigs)
+		panicOn(err)
+		lastErr, err = lastEvalErr(vm)
+		panicOn(err)
+		return
+	}
+
+	cv.Convey("a receive or select at the prompt waits while the background goroutines run, until it can complete", t, func() {
+		sigs := make(chan os.Signal, 1)
+		run(`a := make(chan int); b := make(chan int); c := make(chan string)`, sigs)
+		run(`go func() { x := <-a; b <- x * 2 }()`, sigs)
+		run(`go func() { a <- 21 }()`, sigs)
+		_, lastErr := run(`v := <-b`, sigs)
+		cv.So(lastErr, cv.ShouldEqual, "")
+		LuaMustInt64(vm, "v", 42)
+
+		run(`go func() { for i := 0; i < 3; i++ { a <- i }; c <- "done" }()`, sigs)
+		_, lastErr = run(`
+sum := 0
+got := ""
+for got == "" {
+	select {
+	case i := <-a:
+		sum += i
+	case s := <-c:
+		got = s
+	}
+}`, sigs)
+		cv.So(lastErr, cv.ShouldEqual, "")
+		LuaMustInt64(vm, "sum", 3)
+		LuaMustString(vm, "got", "done")
+	})
+
+	cv.Convey("a wait that nothing can finish is reported as a deadlock, and later sends are not stolen by it", t, func() {
+		sigs := make(chan os.Signal, 1)
+		run(`never := make(chan int); w := 0`, sigs)
+		interrupted, lastErr := run(`w = <-never`, sigs)
+		cv.So(interrupted, cv.ShouldBeFalse)
+		cv.So(lastErr, cv.ShouldStartWith, "fatal error: all goroutines are asleep - deadlock!")
+
+		_, lastErr = run(`select {}`, sigs)
+		cv.So(lastErr, cv.ShouldStartWith, "fatal error: all goroutines are asleep - deadlock!")
+
+		_, lastErr = run(`go func() { never <- 9 }(); w = <-never`, sigs)
+		cv.So(lastErr, cv.ShouldEqual, "")
+		LuaMustInt64(vm, "w", 9)
+	})
+
+	cv.Convey("an interrupt cancels a wait at the prompt, and the abandoned receive takes nothing later", t, func() {
+		sigs := make(chan os.Signal, 1)
+		run(`stuck := make(chan int); k := 0; spins := 0`, sigs)
+		go func() {
+			time.Sleep(200 * time.Millisecond)
+			sigs <- os.Interrupt
+		}()
+		interrupted, _ := run(`go func() { for { spins++ } }(); k = <-stuck`, sigs)
+		cv.So(interrupted, cv.ShouldBeTrue)
+
+		_, lastErr := run(`go func() { stuck <- 5 }(); k = <-stuck`, sigs)
+		cv.So(lastErr, cv.ShouldEqual, "")
+		LuaMustInt64(vm, "k", 5)
+	})
+}
+
+func Test1621DeadlockDumpsTheParkedGoroutines(t *testing.T) {
+
+	cv.Convey("on deadlock, each parked goroutine is listed with its id, what it waits on, and its Go functions and lines", t, func() {
+		vm, err := NewLuaVmWithPrelude(nil)
+		panicOn(err)
+		defer vm.Close()
+		inc := NewIncrState(vm, nil)
+
+		run := func(src string) (lastErr string) {
+			translation, err := TranslateAndCatchPanic(inc, []byte(src))
+			panicOn(err)
+			_, err = runInterruptible(vm, translation, true, make(chan os.Signal, 1))
+			panicOn(err)
+			lastErr, err = lastEvalErr(vm)
+			panicOn(err)
+			return
+		}
+
+		run(`in := make(chan int); out := make(chan string)`)
+		run(`func worker(c chan int) {
+	v := <-c
+	_ = v
+}`)
+		lastErr := run(`go worker(in)
+go func() {
+	out <- "hi"
+}()
+got := <-in`)
+
+		dump := strings.TrimPrefix(lastErr, "fatal error: all goroutines are asleep - deadlock!\n\n")
+		cv.So(dump, cv.ShouldNotEqual, lastErr)
+		cv.So(strings.Split(dump, "\n\n"), cv.ShouldResemble, []string{
+			"goroutine 1 [chan receive]:\nmain.main()\n\tinput 3:5",
+			"goroutine 2 [chan receive]:\nmain.worker(...)\n\tinput 2:2\ncreated by main.main in goroutine 1\n\tinput 3:1",
+			"goroutine 3 [chan send]:\nmain.func(...)\n\tinput 3:3\ncreated by main.main in goroutine 1\n\tinput 3:2",
+		})
+
+		// an empty select has no case to wait on.
+		lastErr = run(`select {}`)
+		cv.So(lastErr, cv.ShouldContainSubstring, "goroutine 1 [select (no cases)]:\nmain.main()\n\tinput 4:1")
+	})
+}
@@ -2,12 +2,21 @@ package compiler
 
 import (
 	"flag"
+	"fmt"
+	"io"
+	"time"
 
 	"github.com/gijit/gi/pkg/verb"
 )
 
 type GIConfig struct {
-	Quiet          bool
+	Quiet bool
+
+	// Verbose and VerboseVerbose show, for this
+	// interpreter, each translation, and the Lua stacks
+	// around each eval; :v, :vv and :q set them. As
+	// flags, ValidateConfig also turns on the debug
+	// traces of package verb, which are process wide.
 	Verbose        bool
 	VerboseVerbose bool
 	RawLua         bool
@@ -18,6 +27,49 @@ type GIConfig struct {
 	NoPrelude      bool
 	NoLuar         bool
 
+	// KernelConnectionFile, if set, makes gi serve as a
+	// Jupyter kernel using the ports and key in this file.
+	KernelConnectionFile string
+
+	// ListenAddr, if set, makes gi serve the network
+	// REPL at unix:/path or tcp:host:port.
+	ListenAddr string
+
+	// RestoreFile, if set, is a snapshot (from :snapshot)
+	// to restore before the first prompt.
+	RestoreFile string
+
+	// ScriptPath, if set, is a Go program to run in place
+	// of the REPL, with ScriptArgs as its arguments.
+	ScriptPath string
+	ScriptArgs []string
+
+	// Limits on each eval, for running untrusted
+	// snippets; zero means no limit. Breaking one is a
+	// runtime error in the eval, which names the limit.
+	// The heap limit is a soft cap, checked every
+	// limitCheckEvery instructions.
+	EvalTimeout         time.Duration
+	EvalMaxInstructions int64
+	EvalMaxHeapBytes    int64
+
+	// Stdout and Stderr get the output of the code being
+	// run: Lua's print and println, the value of an
+	// expression at the prompt, fmt.Print*, and writes
+	// to os.Stdout and os.Stderr. Diag gets the REPL's
+	// own messages, such as "elapsed" and errors. nil
+	// means the process's os.Stdout, os.Stderr, and
+	// os.Stdout, respectively.
+	Stdout io.Writer
+	Stderr io.Writer
+	Diag   io.Writer
+
+	// Sandbox, if set, limits interpreted code to the
+	// imports and Lua runtime that the policy allows.
+	// The -sandbox flag uses NewSandboxPolicy().
+	Sandbox *SandboxPolicy
+	sandbox bool
+
 	Dev bool // dev mode, don't use statically cached prelude
 }
 
@@ -39,9 +91,24 @@ func (c *GIConfig) DefineFlags(fs *flag.FlagSet) {
 	fs.BoolVar(&c.IsTestMode, "t", false, "load test mode functions and types")
 	fs.BoolVar(&c.NoLiner, "no-liner", false, "turn off liner, e.g. under emacs")
 	fs.BoolVar(&c.NoPrelude, "np", false, "no prelude; skip loading the prelude .lua files and Luar. implies -r raw mode too.")
+	fs.StringVar(&c.KernelConnectionFile, "kernel", "", "run as a Jupyter kernel, reading ports and signing key from this connection file. Implies -q and -no-liner.")
+	fs.StringVar(&c.ListenAddr, "listen", "", "serve a network REPL at unix:/path or tcp:host:port, so editors and scripts can share this interpreter. Implies -q and -no-liner.")
+	fs.StringVar(&c.RestoreFile, "restore", "", "restore the types, funcs, and variables saved in this :snapshot file before starting.")
+	fs.DurationVar(&c.EvalTimeout, "eval-timeout", 0, "limit the wall-clock time of each eval, e.g. 2s. 0 means no limit.")
+	fs.Int64Var(&c.EvalMaxInstructions, "eval-max-instr", 0, "limit the LuaJIT instructions run by each eval. 0 means no limit.")
+	fs.Int64Var(&c.EvalMaxHeapBytes, "eval-max-heap", 0, "limit the Lua heap, in bytes, during each eval; a soft cap, checked every 10000 instructions. 0 means no limit.")
+	fs.BoolVar(&c.sandbox, "sandbox", false, "sandbox untrusted code: refuse imports like os and io/ioutil, hide Lua's io, os, ffi, debug, and load functions, and disable __lua and __zygo.")
 	fs.BoolVar(&c.Dev, "d", false, "dev mode uses the pkg/compiler/prelude/*.lua files, skipping the statically cached pkg/compiler/prelude_static.go version.")
 }
 
+func (c *GIConfig) verbose() bool {
+	return c != nil && (c.Verbose || c.VerboseVerbose)
+}
+
+func (c *GIConfig) veryVerbose() bool {
+	return c != nil && c.VerboseVerbose
+}
+
 // call c.ValidateConfig() after myflags.Parse()
 func (c *GIConfig) ValidateConfig() error {
 
@@ -50,6 +117,20 @@ func (c *GIConfig) ValidateConfig() error {
 		c.RawLua = true
 	}
 
+	if c.KernelConnectionFile != "" || c.ListenAddr != "" || c.ScriptPath != "" {
+		// stdin and stdout belong to the notebook server,
+		// the network clients, or the script.
+		c.Quiet = true
+		c.NoLiner = true
+	}
+
+	if c.sandbox && c.Sandbox == nil {
+		c.Sandbox = NewSandboxPolicy()
+	}
+	if c.Sandbox != nil && c.RawLua {
+		return fmt.Errorf("gi: -sandbox cannot be used with raw Lua mode (-r or -np)")
+	}
+
 	if c.PreludePath == "" {
 		// just use the statically embedded prelude from build time.
 	}
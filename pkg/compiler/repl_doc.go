@@ -0,0 +1,291 @@
+package compiler
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/gijit/gi/pkg/ast"
+	"github.com/gijit/gi/pkg/doc"
+	"github.com/gijit/gi/pkg/gostd/build"
+	"github.com/gijit/gi/pkg/parser"
+	"github.com/gijit/gi/pkg/printer"
+	"github.com/gijit/gi/pkg/token"
+	"github.com/gijit/gi/pkg/types"
+)
+
+// TypeOf type-checks expr against the current package,
+// without running it, and describes its type and method
+// set. It backs the :type command.
+func (ic *IncrState) TypeOf(expr string) (string, error) {
+	pkg := ic.curTypesPkg()
+	if pkg == nil {
+		return "", fmt.Errorf("no current package")
+	}
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return "", fmt.Errorf("usage: :type <expression>")
+	}
+	tv, err := types.Eval(ic.CurPkg.fileSet, pkg, token.NoPos, expr)
+	if err != nil {
+		return "", err
+	}
+	if tv.Type == nil || tv.Type == types.Typ[types.Invalid] {
+		return "", fmt.Errorf("could not type check '%s'", expr)
+	}
+	qf := types.RelativeTo(pkg)
+
+	var buf bytes.Buffer
+	switch {
+	case tv.IsType():
+		fmt.Fprintf(&buf, "type %s", types.TypeString(tv.Type, qf))
+		if u := tv.Type.Underlying(); u != tv.Type {
+			fmt.Fprintf(&buf, " %s", types.TypeString(u, qf))
+		}
+	case tv.Value != nil:
+		fmt.Fprintf(&buf, "%s = %s", types.TypeString(tv.Type, qf), tv.Value)
+	default:
+		buf.WriteString(types.TypeString(tv.Type, qf))
+	}
+	buf.WriteString("\n")
+
+	writeMethodSet(&buf, tv.Type, qf)
+	if _, isPtr := tv.Type.Underlying().(*types.Pointer); !isPtr && !types.IsInterface(tv.Type) {
+		// the pointer methods a variable could also call.
+		writeMethodSet(&buf, types.NewPointer(tv.Type), qf)
+	}
+	return buf.String(), nil
+}
+
+func writeMethodSet(buf *bytes.Buffer, t types.Type, qf types.Qualifier) {
+	mset := types.NewMethodSet(t)
+	if mset.Len() == 0 {
+		return
+	}
+	fmt.Fprintf(buf, "method set of %s:\n", types.TypeString(t, qf))
+	for i := 0; i < mset.Len(); i++ {
+		fmt.Fprintf(buf, "    %s\n", types.ObjectString(mset.At(i).Obj(), qf))
+	}
+}
+
+// Doc renders the documentation for name, which may be a
+// package ("fmt"), a member of a package ("fmt.Sprintf",
+// "strings.Builder.WriteString"), or something declared
+// in this session. Packages may be binary (shadowed)
+// or Go source. It backs the :doc command.
+func (ic *IncrState) Doc(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", fmt.Errorf("usage: :doc <package>, <package>.<name>, or <name>")
+	}
+
+	// split off the package, which may be an import path.
+	slash := strings.LastIndex(name, "/")
+	parts := strings.Split(name[slash+1:], ".")
+	first := name[:slash+1] + parts[0]
+	rest := parts[1:]
+
+	path := first
+	if imp := ic.importedPackage(first); imp != nil {
+		path = imp.Path()
+	} else if pkg := ic.curTypesPkg(); pkg != nil && !strings.Contains(first, "/") {
+		if obj := pkg.Scope().Lookup(first); obj != nil {
+			return ic.sessionDoc(obj, rest)
+		}
+	}
+
+	dpkg, fset, err := loadPackageDoc(path)
+	if err != nil {
+		return "", fmt.Errorf("no documentation for '%s': %v", name, err)
+	}
+	if len(rest) == 0 {
+		return packageDocText(dpkg, fset), nil
+	}
+	if text, ok := memberDocText(dpkg, fset, rest); ok {
+		return text, nil
+	}
+	return "", fmt.Errorf("no documentation for '%s' in package %s", strings.Join(rest, "."), path)
+}
+
+// sessionDoc describes something declared at the prompt.
+func (ic *IncrState) sessionDoc(obj types.Object, rest []string) (string, error) {
+	pkg := ic.curTypesPkg()
+	qf := types.RelativeTo(pkg)
+	if len(rest) > 0 {
+		m, _, _ := types.LookupFieldOrMethod(obj.Type(), true, pkg, rest[0])
+		if m == nil {
+			return "", fmt.Errorf("%s has no field or method %s", obj.Name(), rest[0])
+		}
+		return types.ObjectString(m, qf) + "\n", nil
+	}
+	text := types.ObjectString(obj, qf) + "\n"
+	switch obj.(type) {
+	case *types.Func:
+		if src, ok := ic.CurPkg.Arch.FuncSrcCache[obj.Name()]; ok {
+			text += "\n" + strings.TrimSpace(src) + "\n"
+		}
+	case *types.TypeName:
+		var buf bytes.Buffer
+		writeMethodSet(&buf, types.NewPointer(obj.Type()), qf)
+		text += buf.String()
+	}
+	return text, nil
+}
+
+// loadPackageDoc parses the source of the package at
+// import path for its documentation.
+func loadPackageDoc(path string) (*doc.Package, *token.FileSet, error) {
+	bp, err := build.Default.Import(path, currentDirectory, build.FindOnly)
+	if err != nil {
+		return nil, nil, err
+	}
+	fset := token.NewFileSet()
+	notTest := func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}
+	// files that fail to parse are skipped, so one odd
+	// file does not cost us the whole package.
+	pkgs, firstErr := parser.ParseDir(fset, bp.Dir, notTest, parser.ParseComments)
+	var apkg *ast.Package
+	for nm, p := range pkgs {
+		if nm != "main" && nm != "documentation" {
+			apkg = p
+			break
+		}
+	}
+	if apkg == nil {
+		if firstErr == nil {
+			firstErr = fmt.Errorf("no Go package found in %s", bp.Dir)
+		}
+		return nil, nil, firstErr
+	}
+	return doc.New(apkg, path, 0), fset, nil
+}
+
+func packageDocText(p *doc.Package, fset *token.FileSet) string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "package %s // import \"%s\"\n\n", p.Name, p.ImportPath)
+	doc.ToText(&buf, p.Doc, "", "    ", 80)
+	buf.WriteString("\n")
+	var names []string
+	for _, v := range p.Consts {
+		names = append(names, "const "+strings.Join(v.Names, ", "))
+	}
+	for _, v := range p.Vars {
+		names = append(names, "var "+strings.Join(v.Names, ", "))
+	}
+	for _, f := range p.Funcs {
+		names = append(names, declString(fset, f.Decl))
+	}
+	for _, t := range p.Types {
+		names = append(names, "type "+t.Name)
+		for _, f := range t.Funcs {
+			names = append(names, "    "+declString(fset, f.Decl))
+		}
+	}
+	buf.WriteString(strings.Join(names, "\n"))
+	buf.WriteString("\n")
+	return buf.String()
+}
+
+// memberDocText finds path[0] in the package, and
+// path[1], if given, among the methods of type path[0].
+func memberDocText(p *doc.Package, fset *token.FileSet, path []string) (string, bool) {
+	name := path[0]
+	for _, t := range p.Types {
+		if len(path) > 1 {
+			if t.Name != name {
+				continue
+			}
+			for _, m := range t.Methods {
+				if m.Name == path[1] {
+					return entryText(fset, m.Decl, m.Doc), true
+				}
+			}
+			return "", false
+		}
+		if t.Name == name {
+			text := entryText(fset, t.Decl, t.Doc)
+			var more []string
+			for _, f := range t.Funcs {
+				more = append(more, declString(fset, f.Decl))
+			}
+			for _, m := range t.Methods {
+				more = append(more, declString(fset, m.Decl))
+			}
+			sort.Strings(more)
+			if len(more) > 0 {
+				text += "\n" + strings.Join(more, "\n") + "\n"
+			}
+			return text, true
+		}
+		for _, f := range t.Funcs {
+			if f.Name == name {
+				return entryText(fset, f.Decl, f.Doc), true
+			}
+		}
+		for _, v := range append(t.Consts, t.Vars...) {
+			if hasName(v.Names, name) {
+				return entryText(fset, v.Decl, v.Doc), true
+			}
+		}
+	}
+	if len(path) > 1 {
+		return "", false
+	}
+	for _, f := range p.Funcs {
+		if f.Name == name {
+			return entryText(fset, f.Decl, f.Doc), true
+		}
+	}
+	for _, v := range append(p.Consts, p.Vars...) {
+		if hasName(v.Names, name) {
+			return entryText(fset, v.Decl, v.Doc), true
+		}
+	}
+	return "", false
+}
+
+func hasName(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// entryText renders a declaration and its doc comment
+// in the manner of `go doc`.
+func entryText(fset *token.FileSet, decl ast.Decl, comment string) string {
+	var buf bytes.Buffer
+	buf.WriteString(declString(fset, decl))
+	buf.WriteString("\n")
+	if comment != "" {
+		doc.ToText(&buf, comment, "    ", "\t", 76)
+	}
+	return buf.String()
+}
+
+// declString prints decl without any function body.
+func declString(fset *token.FileSet, decl ast.Decl) string {
+	if fd, ok := decl.(*ast.FuncDecl); ok {
+		cp := *fd
+		cp.Body = nil
+		cp.Doc = nil
+		decl = &cp
+	}
+	if gd, ok := decl.(*ast.GenDecl); ok {
+		cp := *gd
+		cp.Doc = nil
+		decl = &cp
+	}
+	var buf bytes.Buffer
+	err := printer.Fprint(&buf, fset, decl)
+	if err != nil {
+		return fmt.Sprintf("<%v>", err)
+	}
+	return buf.String()
+}
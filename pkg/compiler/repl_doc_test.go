@@ -0,0 +1,74 @@
+package compiler
+
+import (
+	"testing"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test1602TypeAndDocCommands(t *testing.T) {
+
+	vm, err := NewLuaVmWithPrelude(nil)
+	panicOn(err)
+	defer vm.Close()
+	inc := NewIncrState(vm, nil)
+
+	cv.Convey(":type reports the type and method set of an expression without running it, and :doc finds documentation for imported packages and session declarations", t, func() {
+		code := `
+import "github.com/gijit/gi/pkg/compiler/spkg_tst"
+type boat struct { Name string }
+func (b *boat) Sail() int { return 1 }
+func (b boat) Sink() int { return 2 }
+myBoat := boat{Name: "minnow"}
+func launch(n int) *boat { return &boat{} }
+`
+		translation, err := inc.Tr([]byte(code))
+		panicOn(err)
+		LuaRunAndReport(vm, string(translation))
+
+		out, err := inc.TypeOf("myBoat")
+		panicOn(err)
+		cv.So(out, cv.ShouldEqual, `boat
+method set of boat:
+    func (boat).Sink() int
+method set of *boat:
+    func (*boat).Sail() int
+    func (boat).Sink() int
+`)
+
+		// launch is not called: only type checked.
+		out, err = inc.TypeOf("launch(3).Name")
+		panicOn(err)
+		cv.So(out, cv.ShouldEqual, "string\n")
+
+		out, err = inc.TypeOf("1 << 3")
+		panicOn(err)
+		cv.So(out, cv.ShouldEqual, "untyped int = 8\n")
+
+		_, err = inc.TypeOf("nosuchThing")
+		cv.So(err, cv.ShouldNotBeNil)
+
+		out, err = inc.Doc("spkg_tst.Fish")
+		panicOn(err)
+		cv.So(out, cv.ShouldEqual, `func Fish(numPole int) (fishCaught int)
+    Fish reports how many fish are caught with numPole poles in the water.
+`)
+
+		out, err = inc.Doc("spkg_tst")
+		panicOn(err)
+		cv.So(out, cv.ShouldContainSubstring, `package spkg_tst // import "github.com/gijit/gi/pkg/compiler/spkg_tst"`)
+		cv.So(out, cv.ShouldContainSubstring, "type GONZAGA")
+
+		out, err = inc.Doc("launch")
+		panicOn(err)
+		cv.So(out, cv.ShouldStartWith, "func launch(n int) *boat\n")
+		cv.So(out, cv.ShouldContainSubstring, "return &boat{}")
+
+		out, err = inc.Doc("boat.Sail")
+		panicOn(err)
+		cv.So(out, cv.ShouldEqual, "func (*boat).Sail() int\n")
+
+		_, err = inc.Doc("spkg_tst.NoSuch")
+		cv.So(err, cv.ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,338 @@
+package compiler
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/gijit/gi/pkg/ast"
+	"github.com/gijit/gi/pkg/format"
+	"github.com/gijit/gi/pkg/front"
+	"github.com/gijit/gi/pkg/parser"
+	"github.com/gijit/gi/pkg/printer"
+	"github.com/gijit/gi/pkg/token"
+	"github.com/gijit/gi/pkg/types"
+)
+
+// exportSession writes this session's history to path
+// as a Go program. It backs the :export command.
+func (r *Repl) exportSession(path string) error {
+	src, err := r.inc.ExportSession(r.sessionSources())
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(expandHome(path), src, 0644)
+}
+
+// ExportSession rewrites the Go entered at the prompt as
+// a package main program. Top level funcs, types, vars
+// and consts become file level declarations, keeping only
+// the last definition of each name. Variables made with :=,
+// or with var and an initializer, become package vars, so
+// the funcs can still see them, and are assigned in main()
+// where they were defined.
+// Declarations keep their session order, so the program
+// can also be replayed at the prompt.
+// Other statements, and expressions (which the prompt
+// would have printed), go into main() in order.
+//
+// history holds the session's entries, or its lines;
+// lines are regrouped into the entries they make up.
+func (ic *IncrState) ExportSession(history []string) ([]byte, error) {
+	pkg := ic.curTypesPkg()
+	if pkg == nil {
+		return nil, fmt.Errorf("no current package")
+	}
+	ex := &exporter{
+		ic:      ic,
+		pkg:     pkg,
+		fset:    token.NewFileSet(),
+		defined: make(map[string]bool),
+	}
+	for _, entry := range splitSessionEntries(history) {
+		err := ex.addEntry(entry)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ex.program()
+}
+
+// splitSessionEntries regroups history lines into the
+// complete entries that the prompt evaluated.
+func splitSessionEntries(history []string) (entries []string) {
+	var cur string
+	for _, line := range history {
+		if cur == "" {
+			cur = line
+		} else {
+			cur += "\n" + line
+		}
+		eof, syntaxErr, empty, _ := front.TopLevelParseGoSource([]byte(cur))
+		switch {
+		case empty:
+			cur = ""
+		case eof && !syntaxErr:
+			// keep reading lines
+		default:
+			entries = append(entries, cur)
+			cur = ""
+		}
+	}
+	if strings.TrimSpace(cur) != "" {
+		entries = append(entries, cur)
+	}
+	return
+}
+
+type exporter struct {
+	ic   *IncrState
+	pkg  *types.Package
+	fset *token.FileSet
+
+	imports []*ast.ImportSpec
+	decls   []exportDecl
+
+	// names given package vars in place of top level :=
+	defined map[string]bool
+
+	mainBody []ast.Stmt
+	needFmt  bool
+}
+
+// exportDecl is a file level declaration and the
+// names it defines. Methods are named Recv.Method.
+type exportDecl struct {
+	names []string
+	decl  ast.Decl
+}
+
+func (ex *exporter) addEntry(entry string) error {
+	trimmed := strings.TrimSpace(entry)
+	if len(trimmed) > 1 && trimmed[0] == '=' && trimmed[1] != '=' {
+		// calculator line: = expr
+		x, err := parser.ParseExprFrom(ex.fset, "", trimmed[1:], 0)
+		if err != nil {
+			return fmt.Errorf("could not export '%s': %v", trimmed, err)
+		}
+		ex.addExpr(x)
+		return nil
+	}
+	file, err := parser.ParseFile(ex.fset, "", entry, 0)
+	if err != nil {
+		return fmt.Errorf("could not export '%s' (raw Lua cannot be exported): %v", trimmed, err)
+	}
+	for _, node := range file.Nodes {
+		switch n := node.(type) {
+		case *ast.GenDecl:
+			ex.addGenDecl(n)
+		case *ast.FuncDecl:
+			name := n.Name.Name
+			if n.Recv != nil && len(n.Recv.List) == 1 {
+				name = recvTypeName(n.Recv.List[0].Type) + "." + name
+			}
+			if name == "main" {
+				return fmt.Errorf("cannot export a session that defines its own func main")
+			}
+			ex.addDecl([]string{name}, n)
+		case *ast.AssignStmt:
+			if n.Tok == token.DEFINE {
+				for _, lhs := range n.Lhs {
+					if id, ok := lhs.(*ast.Ident); ok && id.Name != "_" {
+						ex.addVar(id.Name)
+					}
+				}
+				n.Tok = token.ASSIGN
+			}
+			ex.mainBody = append(ex.mainBody, n)
+		case *ast.ExprStmt:
+			ex.addExpr(n.X)
+		case ast.Expr:
+			ex.addExpr(n)
+		case ast.Stmt:
+			ex.mainBody = append(ex.mainBody, n)
+		}
+	}
+	return nil
+}
+
+func (ex *exporter) addGenDecl(d *ast.GenDecl) {
+	for _, spec := range d.Specs {
+		var names []string
+		switch s := spec.(type) {
+		case *ast.ImportSpec:
+			ex.addImport(s)
+			continue
+		case *ast.TypeSpec:
+			names = []string{s.Name.Name}
+		case *ast.ValueSpec:
+			if d.Tok == token.VAR && len(s.Values) > 0 {
+				// the initializer may read a := var,
+				// which main assigns; so it is
+				// assigned in main too, in turn.
+				lhs := make([]ast.Expr, len(s.Names))
+				for i, id := range s.Names {
+					if id.Name != "_" {
+						ex.addVar(id.Name)
+					}
+					lhs[i] = id
+				}
+				ex.mainBody = append(ex.mainBody, &ast.AssignStmt{
+					Lhs: lhs, Tok: token.ASSIGN, Rhs: s.Values,
+				})
+				continue
+			}
+			for _, id := range s.Names {
+				names = append(names, id.Name)
+			}
+		}
+		ex.addDecl(names, &ast.GenDecl{Tok: d.Tok, Specs: []ast.Spec{spec}})
+	}
+}
+
+func (ex *exporter) addImport(s *ast.ImportSpec) {
+	for _, have := range ex.imports {
+		if have.Path.Value == s.Path.Value && identName(have.Name) == identName(s.Name) {
+			return
+		}
+	}
+	ex.imports = append(ex.imports, s)
+}
+
+// addDecl appends decl, dropping any earlier
+// definition of the same names.
+func (ex *exporter) addDecl(names []string, decl ast.Decl) {
+	keep := ex.decls[:0]
+	for _, d := range ex.decls {
+		if !sharesName(d.names, names) {
+			keep = append(keep, d)
+		}
+	}
+	ex.decls = append(keep, exportDecl{names: names, decl: decl})
+}
+
+// addVar declares a package var for a top level := or
+// initialized var, typed as the session last had it.
+func (ex *exporter) addVar(name string) {
+	if ex.defined[name] {
+		return
+	}
+	ex.defined[name] = true
+	typ := "interface{}"
+	if v, ok := ex.pkg.Scope().Lookup(name).(*types.Var); ok {
+		typ = types.TypeString(v.Type(), ex.qualifier)
+	}
+	tx, err := parser.ParseExprFrom(ex.fset, "", typ, 0)
+	if err != nil {
+		tx = ast.NewIdent(typ)
+	}
+	ex.addDecl([]string{name}, &ast.GenDecl{Tok: token.VAR, Specs: []ast.Spec{
+		&ast.ValueSpec{Names: []*ast.Ident{ast.NewIdent(name)}, Type: tx},
+	}})
+}
+
+// addExpr handles an expression the prompt would have
+// printed the value of. We print it too, unless it has
+// no value.
+func (ex *exporter) addExpr(x ast.Expr) {
+	var src bytes.Buffer
+	printer.Fprint(&src, ex.fset, x)
+	tv, err := types.Eval(ex.ic.CurPkg.fileSet, ex.pkg, token.NoPos, src.String())
+	if err == nil && tv.IsVoid() {
+		ex.mainBody = append(ex.mainBody, &ast.ExprStmt{X: x})
+		return
+	}
+	ex.needFmt = true
+	ex.mainBody = append(ex.mainBody, &ast.ExprStmt{X: &ast.CallExpr{
+		Fun:  &ast.SelectorExpr{X: ast.NewIdent("fmt"), Sel: ast.NewIdent("Println")},
+		Args: []ast.Expr{x},
+	}})
+}
+
+func (ex *exporter) qualifier(p *types.Package) string {
+	if p == ex.pkg {
+		return ""
+	}
+	return p.Name()
+}
+
+func (ex *exporter) program() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("package main\n\n")
+
+	haveFmt := false
+	for _, s := range ex.imports {
+		if s.Name == nil && s.Path.Value == `"fmt"` {
+			haveFmt = true
+		}
+	}
+	if len(ex.imports) > 0 || (ex.needFmt && !haveFmt) {
+		buf.WriteString("import (\n")
+		if ex.needFmt && !haveFmt {
+			buf.WriteString("\t\"fmt\"\n")
+		}
+		for _, s := range ex.imports {
+			if s.Name != nil {
+				fmt.Fprintf(&buf, "\t%s %s\n", s.Name.Name, s.Path.Value)
+			} else {
+				fmt.Fprintf(&buf, "\t%s\n", s.Path.Value)
+			}
+		}
+		buf.WriteString(")\n\n")
+	}
+
+	for _, d := range ex.decls {
+		err := printer.Fprint(&buf, ex.fset, d.decl)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString("\n\n")
+	}
+
+	buf.WriteString("func main() {\n")
+	for _, s := range ex.mainBody {
+		err := printer.Fprint(&buf, ex.fset, s)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString("\n")
+	}
+	buf.WriteString("}\n")
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("exported program does not format: %v\n%s", err, buf.String())
+	}
+	return formatted, nil
+}
+
+func recvTypeName(x ast.Expr) string {
+	switch t := x.(type) {
+	case *ast.StarExpr:
+		return recvTypeName(t.X)
+	case *ast.Ident:
+		return t.Name
+	case *ast.ParenExpr:
+		return recvTypeName(t.X)
+	}
+	return "?"
+}
+
+func identName(id *ast.Ident) string {
+	if id == nil {
+		return ""
+	}
+	return id.Name
+}
+
+func sharesName(a, b []string) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,139 @@
+package compiler
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test1603ExportedSessionRunsTheSame(t *testing.T) {
+
+	session := []string{
+		`type counter struct { n int }`,
+		`func (c *counter) inc() { c.n++ }`,
+		`func scale(a int) int { return a * 2 }`,
+		`func scale(a int) int {`,
+		`	return a * 3`,
+		`}`,
+		`c := &counter{}`,
+		`c.inc()`,
+		`c.inc()`,
+		`x := scale(c.n)`,
+		`println("x is", x)`,
+		`for i := 0; i < 2; i++ {`,
+		`	println("i is", i)`,
+		`}`,
+		`x := 10`,
+		`println(x + scale(1))`,
+	}
+
+	// runLines plays lines through a fresh interpreter,
+	// returning what it printed.
+	runLines := func(lines []string) (string, *Repl) {
+		vm, err := NewLuaVmWithPrelude(nil)
+		panicOn(err)
+		inc := NewIncrState(vm, nil)
+		r := &Repl{cfg: NewGIConfig(), lvm: vm, inc: inc}
+		h, err := newKernelHandler(r)
+		panicOn(err)
+		var stdout, stderr bytes.Buffer
+		for _, entry := range splitSessionEntries(lines) {
+			_, err := h.Execute(entry, &stdout, &stderr)
+			panicOn(err)
+		}
+		return stdout.String(), r
+	}
+
+	cv.Convey("`:export out.go` writes the session as a package main program that, translated and run, prints what the session did", t, func() {
+		want, r := runLines(session)
+		defer r.lvm.Close()
+		cv.So(want, cv.ShouldEqual, "x is\t6LL\ni is\t0LL\ni is\t1LL\n13LL\n")
+
+		for _, entry := range splitSessionEntries(session) {
+			r.addHistory(entry)
+		}
+		dir, err := ioutil.TempDir("", "gi-export")
+		panicOn(err)
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "out.go")
+		panicOn(r.exportSession(path))
+		prog, err := ioutil.ReadFile(path)
+		panicOn(err)
+		src := string(prog)
+
+		// only the last scale survives; := became package vars.
+		cv.So(strings.Count(src, "func scale"), cv.ShouldEqual, 1)
+		cv.So(src, cv.ShouldContainSubstring, "return a * 3")
+		cv.So(src, cv.ShouldContainSubstring, "c *counter")
+		cv.So(src, cv.ShouldContainSubstring, "x = 10")
+
+		// run the exported file, less its package clause, then its main.
+		body := strings.TrimPrefix(src, "package main\n")
+		got, r2 := runLines(append(strings.Split(body, "\n"), "main()"))
+		defer r2.lvm.Close()
+		cv.So(got, cv.ShouldEqual, want)
+
+		if goBin, err := exec.LookPath("go"); err == nil {
+			cmd := exec.Command(goBin, "build", "-o", filepath.Join(dir, "out"), path)
+			cmd.Dir = dir
+			out, err := cmd.CombinedOutput()
+			cv.So(string(out), cv.ShouldEqual, "")
+			cv.So(err, cv.ShouldBeNil)
+		}
+	})
+
+	cv.Convey("`:export` runs a var's initializer in main, in session order, so it sees the := vars before it", t, func() {
+		lines := []string{
+			`x := 5`,
+			`var y = x + 1`,
+			`var a, b = y, x`,
+			`println(y, a, b)`,
+		}
+		want, r := runLines(lines)
+		defer r.lvm.Close()
+		cv.So(want, cv.ShouldEqual, "6LL\t6LL\t5LL\n")
+
+		src, err := r.inc.ExportSession(lines)
+		panicOn(err)
+		cv.So(string(src), cv.ShouldContainSubstring, "var y int\n")
+		cv.So(string(src), cv.ShouldContainSubstring, "\ty = x + 1\n")
+
+		body := strings.TrimPrefix(string(src), "package main\n")
+		got, r2 := runLines(append(strings.Split(body, "\n"), "main()"))
+		defer r2.lvm.Close()
+		cv.So(got, cv.ShouldEqual, want)
+	})
+
+	cv.Convey("`:export` prints calculator lines and bare expressions, and collects imports", t, func() {
+		vm, err := NewLuaVmWithPrelude(nil)
+		panicOn(err)
+		defer vm.Close()
+		inc := NewIncrState(vm, nil)
+		translation, err := inc.Tr([]byte("y := 3"))
+		panicOn(err)
+		LuaRunAndReport(vm, string(translation))
+
+		src, err := inc.ExportSession([]string{`y := 3`, `= y * 2`, `y`})
+		panicOn(err)
+		cv.So(string(src), cv.ShouldEqual, `package main
+
+import (
+	"fmt"
+)
+
+var y int
+
+func main() {
+	y = 3
+	fmt.Println(y * 2)
+	fmt.Println(y)
+}
+`)
+	})
+}
@@ -0,0 +1,210 @@
+package compiler
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// histEntry is one thing evaluated at the prompt:
+// a whole statement or declaration, however many
+// lines it took to type.
+type histEntry struct {
+	Src     string    `json:"src"`
+	When    time.Time `json:"when"`
+	Session string    `json:"session,omitempty"`
+}
+
+// historyHeader starts a ~/.gijit.hist file in the
+// current format: after it, one JSON histEntry per
+// line. A file without it is from before entries,
+// holding one line of source per line.
+const historyHeader = "# gijit history v2"
+
+// histNewline stands in for the newlines of a
+// multi-line entry in the line editor, which edits
+// one line at a time. Up-arrow brings back the whole
+// entry; Read turns them back into newlines.
+const histNewline = "␤"
+
+// newSessionId names this run of gi in the entries it adds.
+func newSessionId() string {
+	return fmt.Sprintf("%s-%d", time.Now().Format("20060102T150405"), os.Getpid())
+}
+
+// sessionSources returns the source of each
+// entry in the current session.
+func (r *Repl) sessionSources() (srcs []string) {
+	if r.sessionStartAfter < len(r.history) {
+		for _, e := range r.history[r.sessionStartAfter:] {
+			srcs = append(srcs, e.Src)
+		}
+	}
+	return
+}
+
+// addHistory records src, a complete entry, and
+// saves it to the history file.
+func (r *Repl) addHistory(src string) {
+	src = strings.TrimRight(src, "\n")
+	if strings.TrimSpace(src) == "" {
+		return
+	}
+	e := &histEntry{Src: src, When: time.Now(), Session: r.sessionId}
+	r.history = append(r.history, e)
+	if r.histFile != nil {
+		err := writeHistEntry(r.histFile, e)
+		if err != nil {
+			r.printf("could not save history: '%v'\n", err)
+		}
+		r.histFile.Sync()
+	}
+	if r.prompter != nil {
+		r.prompter.prompter.AppendHistory(toHistLine(src))
+	}
+}
+
+// toHistLine and fromHistLine convert an entry to and
+// from its one line form in the line editor.
+func toHistLine(src string) string {
+	return strings.Replace(src, "\n", histNewline, -1)
+}
+
+func fromHistLine(line string) string {
+	return strings.Replace(line, histNewline, "\n", -1)
+}
+
+func writeHistEntry(w io.Writer, e *histEntry) error {
+	by, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", by)
+	return err
+}
+
+// openHistory reads the history in histFn, and opens it
+// for appending. A history file in the old one-line-per
+// -line format is regrouped into entries and rewritten,
+// with the original kept beside it as histFn+".v1".
+func openHistory(histFn string) (history []*histEntry, f *os.File, err error) {
+	if FileExists(histFn) {
+		var old bool
+		history, old, err = readHistory(histFn)
+		if err != nil {
+			return nil, nil, err
+		}
+		if old {
+			err = os.Rename(histFn, histFn+".v1")
+			if err != nil {
+				return nil, nil, err
+			}
+			f, err = rewriteHistory(histFn, history)
+			return history, f, err
+		}
+	}
+	f, err = os.OpenFile(histFn,
+		os.O_WRONLY|os.O_CREATE|os.O_APPEND|os.O_SYNC,
+		0600)
+	if err != nil {
+		return nil, nil, err
+	}
+	fi, err := f.Stat()
+	if err == nil && fi.Size() == 0 {
+		_, err = fmt.Fprintf(f, "%s\n", historyHeader)
+	}
+	return history, f, err
+}
+
+// rewriteHistory replaces histFn with history, returning
+// the file open for appending.
+func rewriteHistory(histFn string, history []*histEntry) (*os.File, error) {
+	f, err := os.OpenFile(histFn,
+		os.O_WRONLY|os.O_CREATE|os.O_TRUNC|os.O_SYNC,
+		0600)
+	if err != nil {
+		return nil, err
+	}
+	w := bufio.NewWriter(f)
+	fmt.Fprintf(w, "%s\n", historyHeader)
+	for _, e := range history {
+		writeHistEntry(w, e)
+	}
+	err = w.Flush()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return f, nil
+}
+
+// parseHistory reads either format of history file.
+// old reports the one-line-per-line format, whose
+// lines are regrouped into complete entries.
+func parseHistory(by []byte) (history []*histEntry, old bool, err error) {
+	if !bytes.HasPrefix(by, []byte(historyHeader+"\n")) {
+		lines := strings.Split(string(by), "\n")
+		n := len(lines)
+		if n > 0 && strings.TrimSpace(lines[n-1]) == "" {
+			lines = lines[:n-1]
+		}
+		for _, src := range splitSessionEntries(lines) {
+			history = append(history, &histEntry{Src: src})
+		}
+		return history, true, nil
+	}
+	lines := bytes.Split(by[len(historyHeader)+1:], []byte("\n"))
+	for i, line := range lines {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		e := &histEntry{}
+		err = json.Unmarshal(line, e)
+		if err != nil {
+			return nil, false, fmt.Errorf("history line %v: %v", i+2, err)
+		}
+		history = append(history, e)
+	}
+	return history, false, nil
+}
+
+// showHistory prints the history, numbered; continuation
+// lines of an entry are indented under its first. A
+// non-empty pattern, as in `:h /regex`, shows only the
+// entries it matches.
+func (r *Repl) showHistory(w io.Writer, pattern string) error {
+	var re *regexp.Regexp
+	if pattern != "" {
+		var err error
+		re, err = regexp.Compile(pattern)
+		if err != nil {
+			return fmt.Errorf("bad history search: %v", err)
+		}
+	}
+	if len(r.history) == 0 {
+		fmt.Fprintf(w, "history: empty\n")
+		fmt.Fprintf(w, "----- current session: -----\n")
+		return nil
+	}
+	fmt.Fprintf(w, "history:\n")
+	if r.sessionStartAfter == 0 && re == nil {
+		fmt.Fprintf(w, "----- current session: -----\n")
+	}
+	for i, e := range r.history {
+		if re == nil || re.MatchString(e.Src) {
+			src := strings.Replace(e.Src, "\n", "\n     ", -1)
+			fmt.Fprintf(w, "%03d: %s\n", i+1, src)
+		}
+		if i+1 == r.sessionStartAfter && re == nil {
+			fmt.Fprintf(w, "----- current session: -----\n")
+		}
+	}
+	fmt.Fprintf(w, "\n")
+	return nil
+}
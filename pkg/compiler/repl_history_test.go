@@ -0,0 +1,75 @@
+package compiler
+
+import (
+	"bufio"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test1608HistoryKeepsMultiLineEntriesWhole(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "gi-hist")
+	panicOn(err)
+	defer os.RemoveAll(dir)
+	histFn := filepath.Join(dir, ".gijit.hist")
+
+	cv.Convey("an old line-per-line history is migrated to entries; a multi-line func is one entry to show, search, replay, and remove", t, func() {
+		old := "x := 1\nfunc f() int {\n\treturn x\n}\nf()\n"
+		panicOn(ioutil.WriteFile(histFn, []byte(old), 0600))
+
+		r := &Repl{cfg: NewGIConfig(), histFn: histFn, sessionId: "s2"}
+		r.cfg.NoLiner = true
+		r.history, r.histFile, err = openHistory(histFn)
+		panicOn(err)
+		defer func() { r.histFile.Close() }()
+		r.sessionStartAfter = len(r.history)
+
+		cv.So(len(r.history), cv.ShouldEqual, 3)
+		cv.So(r.history[1].Src, cv.ShouldEqual, "func f() int {\n\treturn x\n}")
+		backup, err := ioutil.ReadFile(histFn + ".v1")
+		panicOn(err)
+		cv.So(string(backup), cv.ShouldEqual, old)
+
+		r.addHistory("type pt struct {\n\ta int\n}\n")
+		again, old2, err := readHistory(histFn)
+		panicOn(err)
+		cv.So(old2, cv.ShouldBeFalse)
+		cv.So(len(again), cv.ShouldEqual, 4)
+		cv.So(again[3].Src, cv.ShouldEqual, "type pt struct {\n\ta int\n}")
+		cv.So(again[3].Session, cv.ShouldEqual, "s2")
+		cv.So(again[3].When.IsZero(), cv.ShouldBeFalse)
+		cv.So(r.sessionSources(), cv.ShouldResemble, []string{"type pt struct {\n\ta int\n}"})
+
+		var buf bytes.Buffer
+		panicOn(r.showHistory(&buf, "ret.rn"))
+		cv.So(buf.String(), cv.ShouldEqual, "history:\n002: func f() int {\n     \treturn x\n     }\n\n")
+
+		read := func(line string) string {
+			r.reader = bufio.NewReader(strings.NewReader(line + "\n"))
+			src, err := r.Read()
+			panicOn(err)
+			return src
+		}
+		cv.So(read(":2"), cv.ShouldEqual, "func f() int {\n\treturn x\n}")
+		cv.So(read(":1-2"), cv.ShouldEqual, "x := 1\nfunc f() int {\n\treturn x\n}\n")
+
+		read(":rm 2")
+		cv.So(len(r.history), cv.ShouldEqual, 3)
+		cv.So(r.sessionStartAfter, cv.ShouldEqual, 2)
+		again, _, err = readHistory(histFn)
+		panicOn(err)
+		cv.So(len(again), cv.ShouldEqual, 3)
+		cv.So(again[1].Src, cv.ShouldEqual, "f()")
+
+		// the line editor holds an entry on one line.
+		line := toHistLine(r.history[2].Src)
+		cv.So(strings.Contains(line, "\n"), cv.ShouldBeFalse)
+		cv.So(fromHistLine(line), cv.ShouldEqual, r.history[2].Src)
+	})
+}
@@ -0,0 +1,66 @@
+package compiler
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+)
+
+// runInterruptible is LuaRun, where an os.Interrupt
+// arriving on sigs while code runs makes the running
+// code panic("interrupted"): its defers run, and control
+// comes back here with the vm's globals intact. The
+// goroutines that were runnable are dropped. A second
+// interrupt means the first did not take (LuaJIT's
+// compiled loops don't check for it), so gi exits.
+func runInterruptible(lvm *LuaVm, code string, useEval bool, sigs <-chan os.Signal) (interrupted bool, err error) {
+	var mut sync.Mutex
+	running := true
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-sigs:
+				mut.Lock()
+				if running {
+					if interrupted {
+						fmt.Fprintf(os.Stderr, "\ngi: interrupted twice; exiting.\n")
+						os.Exit(130)
+					}
+					lvm.vm.Interrupt()
+					interrupted = true
+				}
+				mut.Unlock()
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	err = LuaRun(lvm, code, useEval)
+
+	mut.Lock()
+	running = false
+	lvm.vm.ClearHook()
+	mut.Unlock()
+	close(done)
+
+	if interrupted {
+		rerr := LuaRun(lvm, "__task.reset_scheduler()", false)
+		if err == nil {
+			err = rerr
+		}
+	}
+	return
+}
+
+// runCatchingCtrlC is runInterruptible for the terminal:
+// Ctrl-C is caught only while code runs, so at an idle
+// prompt it does what it always has.
+func runCatchingCtrlC(lvm *LuaVm, code string, useEval bool) (interrupted bool, err error) {
+	sigs := make(chan os.Signal, 2)
+	signal.Notify(sigs, os.Interrupt)
+	defer signal.Stop(sigs)
+	return runInterruptible(lvm, code, useEval, sigs)
+}
@@ -0,0 +1,56 @@
+package compiler
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test1609InterruptRunawayEvalKeepsGlobals(t *testing.T) {
+
+	vm, err := NewLuaVmWithPrelude(nil)
+	panicOn(err)
+	defer vm.Close()
+	inc := NewIncrState(vm, nil)
+
+	run := func(src string, sigs chan os.Signal) bool {
+		translation, err := TranslateAndCatchPanic(inc, []byte(src))
+		panicOn(err)
+		interrupted, err := runInterruptible(vm, translation, true, sigs)
+		panicOn(err)
+		return interrupted
+	}
+
+	cv.Convey("an interrupt during a runaway loop panics in the loop, runs its defers, and returns to the prompt with globals intact", t, func() {
+		sigs := make(chan os.Signal, 1)
+		run(`x := 7; ran := false; n := 0`, sigs)
+		run(`func spin() { defer func() { ran = true }(); for { n++ } }`, sigs)
+		go func() {
+			time.Sleep(200 * time.Millisecond)
+			sigs <- os.Interrupt
+		}()
+		cv.So(run(`spin()`, sigs), cv.ShouldBeTrue)
+		LuaMustBool(vm, "ran", true)
+
+		// the globals survived, and the next eval runs normally.
+		cv.So(run(`x++`, sigs), cv.ShouldBeFalse)
+		LuaMustInt64(vm, "x", 8)
+		cv.So(run(`y := x * 2`, sigs), cv.ShouldBeFalse)
+		LuaMustInt64(vm, "y", 16)
+	})
+
+	cv.Convey("an interrupt of a spinning goroutine leaves the scheduler ready for the next eval", t, func() {
+		sigs := make(chan os.Signal, 1)
+		go func() {
+			time.Sleep(200 * time.Millisecond)
+			sigs <- os.Interrupt
+		}()
+		cv.So(run(`go func() { for { n++ } }()`, sigs), cv.ShouldBeTrue)
+
+		// spawned goroutines run again.
+		run(`n = 0; go func() { n = 100 }()`, sigs)
+		LuaMustInt64(vm, "n", 100)
+	})
+}
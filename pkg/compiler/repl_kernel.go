@@ -0,0 +1,231 @@
+package compiler
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/gijit/gi/pkg/front"
+	"github.com/gijit/gi/pkg/jupyter"
+	"github.com/gijit/gi/pkg/types"
+)
+
+// kernelLuaSetup reroutes output while running as a
+// Jupyter kernel. Lua's print normally goes straight to
+// the C stdout; here it comes back to Go so each cell's
+// output can be streamed to the notebook. The printing of
+// a cell's final expression is marked so it becomes the
+// execute_result instead of stream output.
+const kernelLuaSetup = `
+__gijit_kernelPrintQuoted = __gijit_printQuoted
+__gijit_printQuoted = function(...)
+   __gijit_kernel_toResult(true)
+   local ok, err = pcall(__gijit_kernelPrintQuoted, ...)
+   __gijit_kernel_toResult(false)
+   if not ok then error(err) end
+end
+
+print = function(...)
+   local n = select("#", ...)
+   local a = {...}
+   local s = {}
+   for i = 1, n do
+      s[i] = tostring(a[i])
+   end
+   __gijit_kernel_write(table.concat(s, "\t") .. "\n")
+end
+
+__errHandlerForEval = function(err)
+   __lastEvalErr = tostring(err)
+   return err
+end
+
+__evalDeadlocked = function(co)
+   __lastEvalErr = __deadlockReport()
+end
+`
+
+// kernelHandler lets a Repl serve as the language
+// behind a jupyter.Kernel.
+type kernelHandler struct {
+	r *Repl
+
+	// where output goes during Execute.
+	mut      sync.Mutex
+	out      io.Writer
+	errOut   io.Writer
+	result   *bytes.Buffer
+	toResult bool
+}
+
+// kernelStream is the interpreter's Stdout, or its
+// Stderr, under a kernel: it writes to the stream of
+// the cell running. The shadow os package holds on to
+// it from import on, so it stays the same from cell
+// to cell.
+type kernelStream struct {
+	h      *kernelHandler
+	stderr bool
+}
+
+func (s kernelStream) Write(p []byte) (int, error) {
+	if s.stderr {
+		s.h.writeErr(string(p))
+	} else {
+		s.h.write(string(p))
+	}
+	return len(p), nil
+}
+
+func newKernelHandler(r *Repl) (*kernelHandler, error) {
+	h := &kernelHandler{r: r}
+	r.cfg.Stdout = kernelStream{h: h}
+	r.cfg.Stderr = kernelStream{h: h, stderr: true}
+	tk := r.lvm.goro.newTicket(kernelLuaSetup, false)
+	tk.regmap["__gijit_kernel_write"] = h.write
+	tk.regmap["__gijit_kernel_toResult"] = h.setToResult
+	err := tk.Do()
+	if err != nil {
+		return nil, fmt.Errorf("could not set up kernel output capture: '%v'", err)
+	}
+	return h, nil
+}
+
+// serveKernel runs r as a Jupyter kernel until the
+// front end shuts it down.
+func (r *Repl) serveKernel() error {
+	h, err := newKernelHandler(r)
+	if err != nil {
+		return err
+	}
+	return jupyter.Serve(r.cfg.KernelConnectionFile, h)
+}
+
+func (h *kernelHandler) write(s string) {
+	h.mut.Lock()
+	defer h.mut.Unlock()
+	switch {
+	case h.toResult && h.result != nil:
+		h.result.WriteString(s)
+	case h.out != nil:
+		io.WriteString(h.out, s)
+	default:
+		io.WriteString(os.Stdout, s)
+	}
+}
+
+func (h *kernelHandler) writeErr(s string) {
+	h.mut.Lock()
+	defer h.mut.Unlock()
+	if h.errOut != nil {
+		io.WriteString(h.errOut, s)
+	} else {
+		io.WriteString(os.Stderr, s)
+	}
+}
+
+func (h *kernelHandler) setToResult(on bool) {
+	h.mut.Lock()
+	h.toResult = on
+	h.mut.Unlock()
+}
+
+func (h *kernelHandler) Info() jupyter.KernelInfo {
+	return jupyter.KernelInfo{
+		Implementation:        "gijit",
+		ImplementationVersion: NearestGitTag,
+		Banner:                "gijit: a go interpreter, just-in-time.",
+		LanguageInfo: jupyter.LanguageInfo{
+			Name:           "go",
+			Version:        runtime.Version(),
+			Mimetype:       "text/x-go",
+			FileExtension:  ".go",
+			PygmentsLexer:  "go",
+			CodemirrorMode: "go",
+		},
+	}
+}
+
+func (h *kernelHandler) Execute(code string, stdout, stderr io.Writer) (string, error) {
+	eof, syntaxErr, empty, _ := front.TopLevelParseGoSource([]byte(code))
+	if empty {
+		return "", nil
+	}
+	if eof && !syntaxErr {
+		return "", fmt.Errorf("incomplete Go source: the cell ended before the code did")
+	}
+	translation, err := TranslateAndCatchPanic(h.r.inc, []byte(code))
+	if err != nil {
+		return "", err
+	}
+
+	var result bytes.Buffer
+	h.mut.Lock()
+	h.out = stdout
+	h.errOut = stderr
+	h.result = &result
+	h.mut.Unlock()
+
+	err = LuaRun(h.r.lvm, translation, true)
+
+	h.mut.Lock()
+	h.out = nil
+	h.errOut = nil
+	h.result = nil
+	h.toResult = false
+	h.mut.Unlock()
+
+	if err != nil {
+		return "", err
+	}
+	lastErr, err := lastEvalErr(h.r.lvm)
+	if err != nil {
+		return "", err
+	}
+	if lastErr != "" {
+		return "", fmt.Errorf("%s", lastErr)
+	}
+	return strings.TrimRight(result.String(), "\n"), nil
+}
+
+func (h *kernelHandler) Complete(code string, cursor int) ([]string, int, int) {
+	return h.r.inc.CompleteGo(code, cursor)
+}
+
+func (h *kernelHandler) Inspect(code string, cursor, detailLevel int) (string, bool) {
+	obj := h.r.inc.LookupGo(code, cursor)
+	if obj == nil {
+		return "", false
+	}
+	cur := h.r.inc.CurPkg.Arch.Pkg
+	if pkg := obj.Pkg(); pkg != nil && pkg != cur && obj.Parent() == pkg.Scope() {
+		// a package level name from an import: show its documentation.
+		if text, err := h.r.inc.Doc(pkg.Path() + "." + obj.Name()); err == nil {
+			return text, true
+		}
+	}
+	text := types.ObjectString(obj, types.RelativeTo(cur))
+	if detailLevel > 0 {
+		if src, ok := h.r.inc.CurPkg.Arch.FuncSrcCache[obj.Name()]; ok && obj.Pkg() == cur {
+			text += "\n\n" + src
+		}
+	}
+	return text, true
+}
+
+func (h *kernelHandler) IsComplete(code string) (string, string) {
+	eof, syntaxErr, empty, _ := front.TopLevelParseGoSource([]byte(code))
+	switch {
+	case empty:
+		return "complete", ""
+	case eof && !syntaxErr:
+		return "incomplete", "\t"
+	case syntaxErr:
+		return "invalid", ""
+	}
+	return "complete", ""
+}
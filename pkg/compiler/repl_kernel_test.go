@@ -0,0 +1,95 @@
+package compiler
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"testing"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test1600KernelHandlerCapturesOutputAndResults(t *testing.T) {
+
+	vm, err := NewLuaVmWithPrelude(nil)
+	panicOn(err)
+	defer vm.Close()
+	inc := NewIncrState(vm, nil)
+	r := &Repl{cfg: NewGIConfig(), lvm: vm, inc: inc}
+
+	cv.Convey("running as a Jupyter kernel, a cell's printed output is streamed, its final expression becomes the result, and runtime errors are reported", t, func() {
+		h, err := newKernelHandler(r)
+		panicOn(err)
+
+		var stdout, stderr bytes.Buffer
+		res, err := h.Execute("x := 21", &stdout, &stderr)
+		panicOn(err)
+		cv.So(res, cv.ShouldEqual, "")
+
+		res, err = h.Execute(`println("hello")`, &stdout, &stderr)
+		panicOn(err)
+		cv.So(res, cv.ShouldEqual, "")
+		cv.So(stdout.String(), cv.ShouldEqual, "hello\n")
+
+		res, err = h.Execute("x*2", &stdout, &stderr)
+		panicOn(err)
+		cv.So(res, cv.ShouldEqual, "42LL")
+
+		stdout.Reset()
+		_, err = h.Execute(`var m map[string]int; m["a"] = 1`, &stdout, &stderr)
+		cv.So(err, cv.ShouldNotBeNil)
+
+		// the next cell starts clean.
+		res, err = h.Execute("x+1", &stdout, &stderr)
+		panicOn(err)
+		cv.So(res, cv.ShouldEqual, "22LL")
+
+		status, _ := h.IsComplete("func f() {")
+		cv.So(status, cv.ShouldEqual, "incomplete")
+		status, _ = h.IsComplete("x := )")
+		cv.So(status, cv.ShouldEqual, "invalid")
+		status, _ = h.IsComplete("x")
+		cv.So(status, cv.ShouldEqual, "complete")
+
+		_, err = h.Execute("func addOne(a int) int { return a + 1 }", &stdout, &stderr)
+		panicOn(err)
+		matches, start, end := h.Complete("y := add", 8)
+		cv.So(matches, cv.ShouldResemble, []string{"addOne"})
+		cv.So(start, cv.ShouldEqual, 5)
+		cv.So(end, cv.ShouldEqual, 8)
+
+		text, found := h.Inspect("addOne(3)", 2, 0)
+		cv.So(found, cv.ShouldBeTrue)
+		cv.So(text, cv.ShouldEqual, "func addOne(a int) int")
+	})
+	cv.Convey("a cell's Go output reaches that cell's streams by way of the interpreter's writers, leaving the process's alone", t, func() {
+		cfg := NewGIConfig()
+		vm, err := NewLuaVmWithPrelude(cfg)
+		panicOn(err)
+		defer vm.Close()
+		inc := NewIncrState(vm, cfg)
+		r := &Repl{cfg: cfg, lvm: vm, inc: inc}
+		h, err := newKernelHandler(r)
+		panicOn(err)
+
+		// a host package writing as the shadow fmt does.
+		in := &Interpreter{cfg: cfg, lvm: vm, inc: inc}
+		panicOn(in.RegisterPackage("say", map[string]interface{}{
+			"Out": func(s string) { io.WriteString(cfg.Stdout, s) },
+			"Err": func(s string) { io.WriteString(cfg.Stderr, s) },
+		}))
+		origOut, origErr := os.Stdout, os.Stderr
+
+		var stdout1, stderr1, stdout2, stderr2 bytes.Buffer
+		_, err = h.Execute(`import "say"; say.Out("one\n"); say.Err("oops\n")`, &stdout1, &stderr1)
+		panicOn(err)
+		_, err = h.Execute(`say.Out("two\n")`, &stdout2, &stderr2)
+		panicOn(err)
+		cv.So(stdout1.String(), cv.ShouldEqual, "one\n")
+		cv.So(stderr1.String(), cv.ShouldEqual, "oops\n")
+		cv.So(stdout2.String(), cv.ShouldEqual, "two\n")
+		cv.So(stderr2.String(), cv.ShouldEqual, "")
+		cv.So(os.Stdout, cv.ShouldEqual, origOut)
+		cv.So(os.Stderr, cv.ShouldEqual, origErr)
+	})
+}
@@ -34,6 +34,26 @@ func NewPrompter(prompt string) *Prompter {
 	return p
 }
 
+// SetCompleter installs tab completion. complete is given
+// the line and the cursor as a byte offset, and returns
+// the candidates to replace line[start:end] with.
+func (p *Prompter) SetCompleter(complete func(line string, pos int) (matches []string, start, end int)) {
+	p.prompter.SetTabCompletionStyle(liner.TabPrints)
+	p.prompter.SetWordCompleter(func(line string, pos int) (head string, completions []string, tail string) {
+		// liner counts pos in runes.
+		rs := []rune(line)
+		if pos > len(rs) {
+			pos = len(rs)
+		}
+		b := len(string(rs[:pos]))
+		matches, start, end := complete(line, b)
+		if len(matches) == 0 {
+			return line[:b], nil, line[b:]
+		}
+		return line[:start], matches, line[end:]
+	})
+}
+
 func (p *Prompter) Close() {
 	defer p.prompter.Close()
 }
@@ -56,7 +76,7 @@ func (p *Prompter) Getline(prompt *string) (line string, err error) {
 		line, err = p.prompter.Prompt(*prompt)
 	}
 	if err == nil {
-		p.prompter.AppendHistory(line)
+		// the Repl keeps the editor's history, by entry.
 		return line, nil
 	}
 	return "", err
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"io"
 	"os"
-	"runtime"
 	"strconv"
 	"strings"
 	"time"
@@ -18,78 +17,43 @@ import (
 
 var p = verb.P
 
-// Arrange that main.main runs on main thread.
-// We want LuaJIT to always be on the one
-// main C thread.
-func init() {
-	runtime.LockOSThread()
-}
-
-// the single queue of work to run in main thread.
-var mainQ = make(chan func())
-var mainShutdown = make(chan bool)
-
-// doMain runs f on the main thread.
-func doMainWait(f func()) {
-	done := make(chan bool, 1)
-	mainQ <- func() {
-		f()
-		done <- true
-	}
-	<-done
-}
-
-func doMainAsync(f func()) {
-	mainQ <- func() {
-		f()
+// LuajitMain runs gi as cfg asks: a script, a
+// Jupyter kernel, a network REPL, or the terminal REPL.
+// The LuaJIT state runs on an OS thread of its own;
+// see Goro.Start.
+func (cfg *GIConfig) LuajitMain() {
+	if cfg.ScriptPath != "" {
+		// no Repl: its history and its reader of
+		// stdin are not for scripts.
+		os.Exit(RunScript(cfg))
 	}
+	r := NewRepl(cfg)
+	defer r.lvm.Close()
+	r.run()
 }
 
-// Main runs the main LuaJIT service loop.
-// The binary's main.main must call LuajitMain() to run this loop.
-// Main does not return. If the binary needs to do other work, it
-// must do it in separate goroutines.
-func MainCThread() {
-	for {
-		select {
-		case <-mainShutdown:
-			return
-		case f := <-mainQ:
-			f()
+// run serves the Jupyter protocol if we were asked
+// to be a kernel, the network REPL if we were asked
+// to listen, and otherwise the terminal.
+func (r *Repl) run() {
+	if r.cfg.RestoreFile != "" {
+		err := r.restore(r.cfg.RestoreFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "gi: %v\n", err)
 		}
 	}
-}
-
-func (cfg *GIConfig) LuajitMain() {
-	if reserveMainThread {
-		done := make(chan bool)
-		var r *Repl
-		go func() {
-			r = NewRepl(cfg)
-
-			// in place of defer to cleanup:
-			go func() {
-				<-done
-				r.lvm.Close()
-				close(mainShutdown)
-			}()
-			r.Loop()
-			done <- true
-		}()
-
-		// this is the C main thread, for LuaJIT
-		// to always and only be on... it never
-		// returns. Use doMain() to communicate
-		// with it via mainQ.
-		MainCThread()
-
-	} else {
-
-		r := NewRepl(cfg)
-		defer func() {
-			r.lvm.Close()
-			close(mainShutdown)
-		}()
+	switch {
+	case r.cfg.KernelConnectionFile != "":
+		err := r.serveKernel()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "gi kernel error: '%v'\n", err)
+		}
+	case r.cfg.ListenAddr != "":
+		err := r.serveNet()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "gi listen error: '%v'\n", err)
+		}
+	default:
 		r.Loop()
 	}
 }
@@ -102,10 +66,11 @@ type Repl struct {
 	t0 time.Time
 	t1 time.Time
 
-	history  []string
-	home     string
-	histFn   string
-	histFile *os.File
+	history   []*histEntry
+	sessionId string
+	home      string
+	histFn    string
+	histFile  *os.File
 
 	sessionStartAfter int
 
@@ -137,24 +102,15 @@ func NewRepl(cfg *GIConfig) *Repl {
 	panicOn(err)
 	inc := NewIncrState(lvm, cfg)
 
-	r := &Repl{cfg: cfg, lvm: lvm, inc: inc}
+	r := &Repl{cfg: cfg, lvm: lvm, inc: inc, sessionId: newSessionId()}
 	r.home = os.Getenv("HOME")
 	if r.home != "" {
 		r.histFn = r.home + string(os.PathSeparator) + ".gijit.hist"
 
-		// open and close once to read back history
-		r.history, err = readHistory(r.histFn)
-		lh := len(r.history)
-		if lh > 0 {
-			r.sessionStartAfter = lh
-		}
-		panicOn(err)
-
-		// re-open for append new history
-		r.histFile, err = os.OpenFile(r.histFn,
-			os.O_WRONLY|os.O_CREATE|os.O_APPEND|os.O_SYNC,
-			0600)
+		// read back history, and keep the file open to append to.
+		r.history, r.histFile, err = openHistory(r.histFn)
 		panicOn(err)
+		r.sessionStartAfter = len(r.history)
 	}
 
 	r.reader = bufio.NewReader(os.Stdin)
@@ -167,8 +123,9 @@ func NewRepl(cfg *GIConfig) *Repl {
 
 	if !r.cfg.NoLiner {
 		r.prompter = NewPrompter(r.goPrompt)
-		for i := range r.history {
-			r.prompter.prompter.AppendHistory(r.history[i])
+		r.prompter.SetCompleter(r.complete)
+		for _, e := range r.history {
+			r.prompter.prompter.AppendHistory(toHistLine(e.Src))
 		}
 	}
 	r.setPrompt()
@@ -198,21 +155,21 @@ func (r *Repl) Read() (src string, err error) {
 readtop:
 	if r.cfg.NoLiner {
 		if r.prompt != "" {
-			fmt.Printf(r.prompt)
+			r.printf("%s", r.prompt)
 		}
 		by, err = r.reader.ReadBytes('\n')
 	} else {
 		r.prompterLine, err = r.prompter.Getline(&(r.prompt))
-		by = []byte(r.prompterLine)
+		by = []byte(fromHistLine(r.prompterLine))
 	}
 	if err == io.EOF {
 		if len(by) > 0 {
-			fmt.Printf("\n on EOF, but len(by) = %v, by='%s'", len(by), string(by))
+			r.printf("\n on EOF, but len(by) = %v, by='%s'", len(by), string(by))
 			// process bytes first,
 			// return next time.
 			return
 		} else {
-			fmt.Printf("[EOF]\n")
+			r.printf("[EOF]\n")
 			return "", err
 		}
 	}
@@ -221,6 +178,11 @@ readtop:
 	src = use
 	cmd := bytes.TrimSpace(by)
 	low := string(bytes.ToLower(cmd))
+	if r.prompter != nil && (low == "==" || (len(low) > 0 && low[0] == ':' && !strings.HasPrefix(low, "::"))) {
+		// source goes to the line editor's history as
+		// whole entries, from Eval; commands go here.
+		r.prompter.prompter.AppendHistory(string(cmd))
+	}
 	if len(low) > 1 && low[0] == ':' {
 		if low[:2] == "::" {
 			// likely the start of a lua label for a goto, not a special : command.
@@ -232,32 +194,36 @@ readtop:
 			// check for range
 			num, err := getHistoryRange(low[1:], r.history)
 			if err != nil {
-				fmt.Printf("%s\n", err.Error())
+				r.printf("%s\n", err.Error())
 				return "", err
 			}
 
 			switch len(num) {
 			case 1:
-				fmt.Printf("replay history %03d:\n", num[0])
-				src = r.history[num[0]-1]
-				fmt.Printf("%s\n", src)
+				r.printf("replay history %03d:\n", num[0])
+				src = r.history[num[0]-1].Src
+				r.printf("%s\n", src)
 			case 2:
 				if num[1] < num[0] {
-					fmt.Printf("bad history request, end before beginning.\n")
+					r.printf("bad history request, end before beginning.\n")
 					return "", nil
 				}
-				fmt.Printf("replay history %03d - %03d:\n", num[0], num[1])
-				src = strings.Join(r.history[num[0]-1:num[1]], "\n") + "\n"
-				fmt.Printf("%s\n", src)
+				r.printf("replay history %03d - %03d:\n", num[0], num[1])
+				var srcs []string
+				for _, e := range r.history[num[0]-1 : num[1]] {
+					srcs = append(srcs, e.Src)
+				}
+				src = strings.Join(srcs, "\n") + "\n"
+				r.printf("%s\n", src)
 			}
 		}
 	}
 	if len(low) > 3 && low[:3] == ":rm" {
 		// remove some commands from history
 		var beg, end int
-		r.history, r.histFile, beg, end, err = removeCommands(r.history, r.histFn, r.histFile, low[3:])
+		r.history, r.histFile, beg, end, err = removeCommands(r.history, r.histFn, r.histFile, low[3:], r.cfg.diag())
 		if err != nil {
-			fmt.Printf("%s\n", err.Error())
+			r.printf("%s\n", err.Error())
 		}
 		if end >= 0 {
 			delcount := (end - beg + 1)
@@ -272,6 +238,57 @@ readtop:
 		}
 		return "", nil
 	}
+	if strings.HasPrefix(low, ":h /") {
+		// search history; the pattern keeps its case.
+		err = r.showHistory(os.Stdout, strings.TrimSpace(string(cmd))[len(":h /"):])
+		if err != nil {
+			r.printf("%v\n", err)
+		}
+		return "", nil
+	}
+	if low == ":type" || low == ":doc" || strings.HasPrefix(low, ":type ") || strings.HasPrefix(low, ":doc ") {
+		// the argument is Go, so keep its case.
+		var arg string
+		if sp := strings.Index(low, " "); sp > 0 {
+			arg = string(cmd[sp:])
+		}
+		var out string
+		if low[1] == 't' {
+			out, err = r.inc.TypeOf(arg)
+		} else {
+			out, err = r.inc.Doc(arg)
+		}
+		if err != nil {
+			r.printf("%s\n", err.Error())
+		} else {
+			r.printf("%s", out)
+		}
+		return "", nil
+	}
+	if fileCmd := strings.Fields(low); len(fileCmd) > 0 &&
+		(fileCmd[0] == ":export" || fileCmd[0] == ":snapshot" || fileCmd[0] == ":restore") {
+		// the path is the rest of the line, in its original case.
+		path := strings.TrimSpace(string(cmd[len(fileCmd[0]):]))
+		if path == "" {
+			r.printf("usage: %s <file>\n", fileCmd[0])
+			return "", nil
+		}
+		var done string
+		switch fileCmd[0] {
+		case ":export":
+			err, done = r.exportSession(path), "wrote session to"
+		case ":snapshot":
+			err, done = r.snapshot(path), "saved snapshot to"
+		case ":restore":
+			err, done = r.restore(path), "restored from"
+		}
+		if err != nil {
+			r.printf("%s\n", err.Error())
+		} else {
+			r.printf("%s '%s'.\n", done, path)
+		}
+		return "", nil
+	}
 	switch low {
 	case ":ast":
 		r.inc.PrintAST = true
@@ -280,58 +297,40 @@ readtop:
 		r.inc.PrintAST = false
 		return "", nil
 	case ":q":
-		fmt.Printf("quiet mode\n")
-		verb.Verbose = false
-		verb.VerboseVerbose = false
+		r.printf("quiet mode\n")
+		r.cfg.Verbose = false
+		r.cfg.VerboseVerbose = false
 		return "", nil
 	case ":v":
-		fmt.Printf("verbose mode.\n")
-		verb.Verbose = true
-		verb.VerboseVerbose = false
+		r.printf("verbose mode.\n")
+		r.cfg.Verbose = true
+		r.cfg.VerboseVerbose = false
 		return "", nil
 	case ":vv":
-		fmt.Printf("very verbose mode.\n")
-		verb.Verbose = true
-		verb.VerboseVerbose = true
+		r.printf("very verbose mode.\n")
+		r.cfg.Verbose = true
+		r.cfg.VerboseVerbose = true
+		return "", nil
+	case ":reset":
+		err = r.reset()
+		if err != nil {
+			r.printf("reset failed: %v\n", err)
+		} else {
+			r.printf("reset to a fresh interpreter; history kept.\n")
+		}
 		return "", nil
-	case ":clear", ":reset":
+	case ":clear":
 		r.history = r.history[:0]
-		if r.histFn != "" {
-			r.histFile, err = os.OpenFile(r.histFn,
-				os.O_WRONLY|os.O_CREATE|os.O_TRUNC|os.O_SYNC,
-				0600)
+		if r.histFile != nil {
+			r.histFile.Close()
+			r.histFile, err = rewriteHistory(r.histFn, nil)
 			panicOn(err)
 		}
 		r.sessionStartAfter = 0
-		fmt.Printf("history cleared.\n")
+		r.printf("history cleared.\n")
 		return "", nil
 	case ":h":
-		if len(r.history) == 0 {
-			fmt.Printf("history: empty\n")
-			fmt.Printf("----- current session: -----\n")
-			return "", nil
-		}
-		fmt.Printf("history:\n")
-		newline := "\n"
-		if r.sessionStartAfter == 0 {
-			fmt.Printf("----- current session: -----\n")
-		}
-		for i, h := range r.history {
-			lenh := len(h)
-			switch {
-			case lenh == 0:
-				newline = "\n"
-			case h[lenh-1] == '\n':
-				newline = ""
-			default:
-				newline = "\n"
-			}
-			fmt.Printf("%03d: %s%s", i+1, h, newline)
-			if i+1 == r.sessionStartAfter {
-				fmt.Printf("----- current session: -----\n")
-			}
-		}
-		fmt.Printf("\n")
+		r.showHistory(os.Stdout, "")
 		return "", nil
 	case ":ls":
 		r.displayCmd(`ls`)
@@ -349,41 +348,45 @@ readtop:
 		showLuaStacks(r.lvm.vm)
 		goto readtop
 	case ":r":
+		if r.cfg.Sandbox != nil {
+			r.printf("Raw LuaJIT mode is not available in the sandbox.\n")
+			goto readtop
+		}
 		r.cfg.RawLua = true
 		r.cfg.CalculatorMode = false
 		r.prompt = r.luaPrompt
-		fmt.Printf("Raw LuaJIT language mode.\n")
+		r.printf("Raw LuaJIT language mode.\n")
 		goto readtop
 
 	case ":go", ":g", ":":
 		r.cfg.RawLua = false
 		r.cfg.CalculatorMode = false
 		r.prompt = r.goPrompt
-		fmt.Printf("Go language mode.\n")
+		r.printf("Go language mode.\n")
 		return "", nil
 
 	case "==":
 		r.cfg.RawLua = false
 		r.cfg.CalculatorMode = true
-		fmt.Printf("Calculator mode.\n")
+		r.printf("Calculator mode.\n")
 		r.prompt = r.calcPrompt
 		return "", nil
 
 	case ":prelude", ":reload":
-		fmt.Printf("Reloading prelude...\n")
+		r.printf("Reloading prelude...\n")
 
 		files, err := FetchPreludeFilenames(r.cfg.PreludePath, r.cfg.Quiet)
 		if err != nil {
-			fmt.Printf("error during prelude reload: '%v'", err)
+			r.printf("error during prelude reload: '%v'", err)
 			return "", err
 		}
 		err = LuaDoPreludeFiles(r.lvm, files)
 		if err != nil {
-			fmt.Printf("error during prelude reload: '%v'", err)
+			r.printf("error during prelude reload: '%v'", err)
 		}
 		return "", nil
 	case ":help", ":?":
-		fmt.Printf(`
+		r.printf(`
 ======================
 gijit: a go interpreter, just-in-time
 https://github.com/gijit/gi
@@ -400,15 +403,23 @@ these special commands.
  :noast          Stop printing the Go AST.
  :?              Show this help (:help does the same).
  :h              Show command line history.
- :30             Replay command number 30 from history.
- :1-10           Replay commands 1 - 10 inclusive.
- :reset          Reset and clear history (also :clear).
- :rm 3-4         Remove commands 3-4 from history.
+ :h /regex       Show the history entries that match regex.
+ :30             Replay entry number 30 from history.
+ :1-10           Replay entries 1 - 10 inclusive.
+ :reset          Start over with a fresh interpreter, keeping history.
+ :clear          Clear history.
+ :rm 3-4         Remove entries 3-4 from history.
+ :type <expr>    Show the type and method set of expr, without running it.
+ :doc <name>     Show documentation, e.g. :doc fmt.Sprintf
+ :export <file>  Write this session's Go as a package main program.
+ :snapshot <file> Save types, funcs, and global variables to file.
+ :restore <file> Start over from a snapshot (also gi -restore <file>).
  :do <path>      Run dofile(path) on a .lua file.
  :source <path>  Re-play Go code from a file.
  :ls             List all global user variables.
  :gls            List all global variables (include __ prefixed).
  :stacks         Show lua stacks for each coroutine.
+ <tab>           Complete names, fields, methods, : commands, and paths.
  = 3 + 4         Calculate the expression after the '=' (one line).
  ==              Multiple entry calculator mode. ':' to exit.
  import "fmt"    Import the binary, pre-compiled package.
@@ -445,23 +456,23 @@ these special commands.
 		}
 		var err error
 		if len(final) > 0 {
-			fmt.Printf("%s (%s)\n", nm, strings.Join(show, ","))
+			r.printf("%s (%s)\n", nm, strings.Join(show, ","))
 			if r.isDo {
 				err = LuaDoUserFiles(r.lvm, final)
 			} else {
 				by, err = sourceGoFiles(final)
 				if err != nil {
-					fmt.Printf("error during %s: '%v'\n", action, err)
+					r.printf("error during %s: '%v'\n", action, err)
 				} else {
 					src = string(by)
 					return src, nil
 				}
 			}
 			if err != nil {
-				fmt.Printf("error during %s: '%v'\n", action, err)
+				r.printf("error during %s: '%v'\n", action, err)
 			}
 		} else {
-			fmt.Printf("nothing to do.\n")
+			r.printf("nothing to do.\n")
 		}
 		return "", nil
 	}
@@ -508,14 +519,12 @@ func (r *Repl) Eval(src string) error {
 		r.setPrompt()
 		translation, err := TranslateAndCatchPanic(r.inc, []byte(src))
 		if err != nil {
-			fmt.Printf("oops: '%v' on input '%s'\n", err, strings.TrimSpace(src))
+			r.printf("oops: '%v' on input '%s'\n", err, strings.TrimSpace(src))
 			translation = "\n"
 			// still write, so we get another prompt
 
 			// hmm, or maybe not
 			return err
-		} else {
-			p("got translation of line from Go into lua: '%s'\n", strings.TrimSpace(string(translation)))
 		}
 		use = translation
 
@@ -524,37 +533,33 @@ func (r *Repl) Eval(src string) error {
 		use = src
 	}
 
-	p("sending use='%v'\n", use)
+	if r.cfg.verbose() {
+		r.printf("sending use='%v'\n", use)
+	}
 
-	// add to history as separate lines
-	srcLines := strings.Split(src, "\n")
-	//fmt.Printf("appending to history: src='%#v', srcLines='%#v'\n", src, srcLines)
-	lensrc := len(srcLines)
-	histBeg := len(r.history)
-	if lensrc > 1 && strings.TrimSpace(srcLines[lensrc-1]) == "" {
-		r.history = append(r.history, srcLines[:lensrc-1]...)
+	if r.cfg.RawLua {
+		r.addHistory(src)
 	} else {
-		r.history = append(r.history, srcLines[:len(srcLines)]...)
-	}
-	histEnd := len(r.history)
-	if r.histFile != nil {
-		for i := histBeg; i < histEnd; i++ {
-			fmt.Fprintf(r.histFile, "%s\n", r.history[i])
+		// a replayed range adds back each of its entries.
+		for _, entry := range splitSessionEntries(strings.Split(src, "\n")) {
+			r.addHistory(entry)
 		}
-		r.histFile.Sync()
 	}
 	r.t0 = time.Now()
 
 	useEval := !r.cfg.RawLua
-	err := LuaRun(r.lvm, use, useEval)
+	interrupted, err := runCatchingCtrlC(r.lvm, use, useEval)
+	if interrupted {
+		r.printf("interrupted; back to the prompt, with globals intact.\n")
+	}
 	if err != nil {
-		fmt.Printf("error from LuaRun: supplied lua with: '%s'\nlua stack:\n%v\n", use[:len(use)-1], err)
+		r.printf("error from LuaRun: supplied lua with: '%s'\nlua stack:\n%v\n", use[:len(use)-1], err)
 		return nil
 	}
 	r.t1 = time.Now()
-	fmt.Printf("\n")
+	r.printf("\n")
 	r.reader.Reset(os.Stdin)
-	fmt.Printf("elapsed: '%v'\n", r.t1.Sub(r.t0))
+	r.printf("elapsed: '%v'\n", r.t1.Sub(r.t0))
 
 	return nil
 }
@@ -0,0 +1,40 @@
+package compiler
+
+// newInterpreter builds a fresh LuaVm, with the prelude
+// loaded and the binary packages registered, and an
+// IncrState to go with it.
+func (r *Repl) newInterpreter() (*LuaVm, *IncrState, error) {
+	lvm, err := NewLuaVmWithPrelude(r.cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	inc := NewIncrState(lvm, r.cfg)
+	if r.inc != nil {
+		inc.PrintAST = r.inc.PrintAST
+	}
+	return lvm, inc, nil
+}
+
+// useInterpreter replaces r's LuaVm and IncrState,
+// closing the old vm.
+func (r *Repl) useInterpreter(lvm *LuaVm, inc *IncrState) {
+	old := r.lvm
+	r.lvm, r.inc = lvm, inc
+	if old != nil {
+		old.Close()
+	}
+}
+
+// reset throws away every declaration, variable, and
+// import, as if gi had just started, but keeps the
+// history. What follows is a new session, as far as
+// :h and :export are concerned. It backs :reset.
+func (r *Repl) reset() error {
+	lvm, inc, err := r.newInterpreter()
+	if err != nil {
+		return err
+	}
+	r.useInterpreter(lvm, inc)
+	r.sessionStartAfter = len(r.history)
+	return nil
+}
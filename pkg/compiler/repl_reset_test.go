@@ -0,0 +1,48 @@
+package compiler
+
+import (
+	"testing"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test1605ResetStartsAFreshInterpreter(t *testing.T) {
+
+	vm, err := NewLuaVmWithPrelude(nil)
+	panicOn(err)
+	inc := NewIncrState(vm, nil)
+	r := &Repl{cfg: NewGIConfig(), lvm: vm, inc: inc}
+	defer func() { r.lvm.Close() }()
+
+	cv.Convey(":reset replaces the LuaVm and IncrState, forgetting declarations, keeping history and the binary package registrations", t, func() {
+		translation, err := r.inc.Tr([]byte("type dropped struct{}; x := 42"))
+		panicOn(err)
+		LuaRunAndReport(r.lvm, string(translation))
+		r.addHistory("type dropped struct{}; x := 42")
+
+		panicOn(r.reset())
+		cv.So(r.lvm, cv.ShouldNotEqual, vm)
+		cv.So(r.inc, cv.ShouldNotEqual, inc)
+		cv.So(r.sessionSources(), cv.ShouldBeEmpty)
+		cv.So(len(r.history), cv.ShouldEqual, 1)
+		cv.So(r.history[0].Src, cv.ShouldEqual, "type dropped struct{}; x := 42")
+		cv.So(r.sessionStartAfter, cv.ShouldEqual, 1)
+
+		// the type checker has forgotten x, and dropped.
+		_, err = TranslateAndCatchPanic(r.inc, []byte("y := x"))
+		cv.So(err, cv.ShouldNotBeNil)
+		_, err = r.inc.TypeOf("dropped{}")
+		cv.So(err, cv.ShouldNotBeNil)
+
+		// so has Lua, while the prelude and luar registrations are back.
+		err = LuaRun(r.lvm, `assert(x == nil and __type__.dropped == nil); assert(fmt.Sprintf ~= nil and __refSelCaseVal ~= nil)`, false)
+		cv.So(err, cv.ShouldBeNil)
+
+		// and we can carry on.
+		translation, err = r.inc.Tr([]byte("x := 7; z := x * 6"))
+		panicOn(err)
+		LuaRunAndReport(r.lvm, string(translation))
+		err = LuaRun(r.lvm, `assert(z == 42LL)`, false)
+		cv.So(err, cv.ShouldBeNil)
+	})
+}
@@ -0,0 +1,292 @@
+package compiler
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The network REPL, for `gi -listen unix:/tmp/gi.sock`
+// or `gi -listen tcp:127.0.0.1:7777`, lets editors,
+// scripts, and test harnesses share one warm session.
+//
+// Each frame on the wire is a 4 byte big-endian length
+// followed by that many bytes of JSON. A client sends an
+// EvalRequest frame and reads back one EvalReply frame.
+// Any number of clients may attach at once; their evals
+// are run one at a time, under netServer.evalMut.
+
+// maxFrame bounds a frame, so a confused peer
+// can't make us allocate without limit.
+const maxFrame = 64 << 20
+
+// EvalRequest asks the server to run Src, which
+// must be complete Go source.
+type EvalRequest struct {
+	Id  int64  `json:"id"`
+	Src string `json:"src"`
+}
+
+// EvalReply is the outcome of an EvalRequest. Stdout and
+// Stderr hold what was printed while Src ran, Result the
+// printed value of a trailing expression, and Error any
+// compile or run time error. Elapsed is in nanoseconds.
+type EvalReply struct {
+	Id      int64         `json:"id"`
+	Stdout  string        `json:"stdout"`
+	Stderr  string        `json:"stderr"`
+	Result  string        `json:"result"`
+	Error   string        `json:"error,omitempty"`
+	Elapsed time.Duration `json:"elapsed"`
+}
+
+func writeFrame(w io.Writer, v interface{}) error {
+	by, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	var hdr [4]byte
+	binary.BigEndian.PutUint32(hdr[:], uint32(len(by)))
+	_, err = w.Write(append(hdr[:], by...))
+	return err
+}
+
+func readFrame(r io.Reader, v interface{}) error {
+	var hdr [4]byte
+	_, err := io.ReadFull(r, hdr[:])
+	if err != nil {
+		return err
+	}
+	n := binary.BigEndian.Uint32(hdr[:])
+	if n > maxFrame {
+		return fmt.Errorf("frame of %v bytes is too big", n)
+	}
+	by := make([]byte, n)
+	_, err = io.ReadFull(r, by)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(by, v)
+}
+
+// parseListenAddr splits "unix:/path" or "tcp:host:port"
+// into the network and address that net.Listen wants.
+func parseListenAddr(s string) (network, addr string, err error) {
+	i := strings.Index(s, ":")
+	if i < 0 {
+		return "", "", fmt.Errorf("listen address '%s' should start with unix: or tcp:", s)
+	}
+	network, addr = s[:i], s[i+1:]
+	switch network {
+	case "unix", "tcp", "tcp4", "tcp6":
+	default:
+		return "", "", fmt.Errorf("listen address '%s' has unknown network '%s'; use unix: or tcp:", s, network)
+	}
+	if addr == "" {
+		return "", "", fmt.Errorf("listen address '%s' is missing its path or host:port", s)
+	}
+	return network, addr, nil
+}
+
+// netServer is the network REPL over one Repl.
+type netServer struct {
+	r  *Repl
+	h  *kernelHandler
+	ln net.Listener
+
+	mut   sync.Mutex
+	conns map[net.Conn]bool
+	done  bool
+
+	// one eval at a time, from all clients.
+	evalMut sync.Mutex
+}
+
+// listen opens addr for the network REPL; call
+// serve to start taking clients.
+func (r *Repl) listen(addr string) (*netServer, error) {
+	network, where, err := parseListenAddr(addr)
+	if err != nil {
+		return nil, err
+	}
+	h, err := newKernelHandler(r)
+	if err != nil {
+		return nil, err
+	}
+	ln, err := net.Listen(network, where)
+	if err != nil && network == "unix" && staleSocket(where) {
+		os.Remove(where)
+		ln, err = net.Listen(network, where)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &netServer{r: r, h: h, ln: ln, conns: make(map[net.Conn]bool)}, nil
+}
+
+// staleSocket is true when path is a unix socket
+// that nobody is listening on any more, as a gi
+// that was killed leaves behind.
+func staleSocket(path string) bool {
+	fi, err := os.Stat(path)
+	if err != nil || fi.Mode()&os.ModeSocket == 0 {
+		return false
+	}
+	c, err := net.Dial("unix", path)
+	if err != nil {
+		return true
+	}
+	c.Close()
+	return false
+}
+
+// serveNet runs r as a network REPL until the
+// process is stopped.
+func (r *Repl) serveNet() error {
+	s, err := r.listen(r.cfg.ListenAddr)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "gi: listening on %s\n", r.cfg.ListenAddr)
+	return s.serve()
+}
+
+func (s *netServer) Addr() net.Addr {
+	return s.ln.Addr()
+}
+
+// Close stops accepting, and hangs up on every client.
+func (s *netServer) Close() error {
+	s.mut.Lock()
+	s.done = true
+	for c := range s.conns {
+		c.Close()
+	}
+	s.mut.Unlock()
+	return s.ln.Close()
+}
+
+// serve accepts clients until Close.
+func (s *netServer) serve() error {
+	for {
+		c, err := s.ln.Accept()
+		s.mut.Lock()
+		done := s.done
+		s.mut.Unlock()
+		if done {
+			if c != nil {
+				c.Close()
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		s.mut.Lock()
+		s.conns[c] = true
+		s.mut.Unlock()
+		go s.serveConn(c)
+	}
+}
+
+func (s *netServer) serveConn(c net.Conn) {
+	defer func() {
+		s.mut.Lock()
+		delete(s.conns, c)
+		s.mut.Unlock()
+		c.Close()
+	}()
+	br := bufio.NewReader(c)
+	for {
+		var req EvalRequest
+		err := readFrame(br, &req)
+		if err != nil {
+			if err != io.EOF {
+				p("network REPL client %v: %v", c.RemoteAddr(), err)
+			}
+			return
+		}
+		s.evalMut.Lock()
+		reply := s.eval(&req)
+		s.evalMut.Unlock()
+		err = writeFrame(c, reply)
+		if err != nil {
+			return
+		}
+	}
+}
+
+// eval runs one request; it must be called
+// holding s.evalMut.
+func (s *netServer) eval(req *EvalRequest) *EvalReply {
+	var stdout, stderr bytes.Buffer
+	t0 := time.Now()
+	result, err := s.h.Execute(req.Src, &stdout, &stderr)
+	reply := &EvalReply{
+		Id:      req.Id,
+		Stdout:  stdout.String(),
+		Stderr:  stderr.String(),
+		Result:  result,
+		Elapsed: time.Since(t0),
+	}
+	if err != nil {
+		reply.Error = err.Error()
+	}
+	return reply
+}
+
+// ReplClient talks to a `gi -listen` server.
+type ReplClient struct {
+	c      net.Conn
+	br     *bufio.Reader
+	nextId int64
+}
+
+// DialRepl connects to a network REPL at addr,
+// given as for -listen: unix:/path or tcp:host:port.
+func DialRepl(addr string) (*ReplClient, error) {
+	network, where, err := parseListenAddr(addr)
+	if err != nil {
+		return nil, err
+	}
+	c, err := net.Dial(network, where)
+	if err != nil {
+		return nil, err
+	}
+	return &ReplClient{c: c, br: bufio.NewReader(c)}, nil
+}
+
+// Eval runs src in the server's interpreter. A failure
+// of src itself is in the reply's Error; the error
+// returned here is for trouble with the connection.
+func (rc *ReplClient) Eval(src string) (*EvalReply, error) {
+	rc.nextId++
+	err := writeFrame(rc.c, &EvalRequest{Id: rc.nextId, Src: src})
+	if err != nil {
+		return nil, err
+	}
+	var reply EvalReply
+	err = readFrame(rc.br, &reply)
+	if err != nil {
+		return nil, err
+	}
+	if reply.Id != rc.nextId {
+		return nil, fmt.Errorf("reply %v does not answer request %v", reply.Id, rc.nextId)
+	}
+	return &reply, nil
+}
+
+func (rc *ReplClient) Close() error {
+	return rc.c.Close()
+}
@@ -0,0 +1,95 @@
+package compiler
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test1607NetworkReplSharesOneSession(t *testing.T) {
+
+	vm, err := NewLuaVmWithPrelude(nil)
+	panicOn(err)
+	defer vm.Close()
+	inc := NewIncrState(vm, nil)
+	r := &Repl{cfg: NewGIConfig(), lvm: vm, inc: inc}
+
+	dir, err := ioutil.TempDir("", "gi-listen")
+	panicOn(err)
+	defer os.RemoveAll(dir)
+	addr := "unix:" + filepath.Join(dir, "gi.sock")
+
+	cv.Convey("`gi -listen unix:path` serves evals to several clients, who share one interpreter, and each reply carries output, result, error, and elapsed time", t, func() {
+		s, err := r.listen(addr)
+		panicOn(err)
+		go s.serve()
+		defer s.Close()
+
+		a, err := DialRepl(addr)
+		panicOn(err)
+		defer a.Close()
+		b, err := DialRepl(addr)
+		panicOn(err)
+		defer b.Close()
+
+		reply, err := a.Eval("x := 21")
+		panicOn(err)
+		cv.So(reply.Error, cv.ShouldEqual, "")
+
+		// b sees what a declared.
+		reply, err = b.Eval("x * 2")
+		panicOn(err)
+		cv.So(reply.Result, cv.ShouldEqual, "42LL")
+		cv.So(reply.Elapsed, cv.ShouldBeGreaterThan, 0)
+
+		reply, err = a.Eval(`println("hello")`)
+		panicOn(err)
+		cv.So(reply.Stdout, cv.ShouldEqual, "hello\n")
+		cv.So(reply.Result, cv.ShouldEqual, "")
+
+		reply, err = b.Eval(`var m map[string]int; m["a"] = 1`)
+		panicOn(err)
+		cv.So(reply.Error, cv.ShouldNotEqual, "")
+
+		reply, err = b.Eval("y := )")
+		panicOn(err)
+		cv.So(reply.Error, cv.ShouldNotEqual, "")
+
+		// concurrent clients are run one at a time.
+		_, err = a.Eval("n := 0")
+		panicOn(err)
+		var wg sync.WaitGroup
+		for _, c := range []*ReplClient{a, b} {
+			wg.Add(1)
+			go func(c *ReplClient) {
+				defer wg.Done()
+				for i := 0; i < 20; i++ {
+					reply, err := c.Eval("n++")
+					panicOn(err)
+					if reply.Error != "" {
+						panic(reply.Error)
+					}
+				}
+			}(c)
+		}
+		wg.Wait()
+		reply, err = a.Eval("n")
+		panicOn(err)
+		cv.So(reply.Result, cv.ShouldEqual, "40LL")
+	})
+
+	cv.Convey("listen addresses name their network", t, func() {
+		network, where, err := parseListenAddr("tcp:127.0.0.1:7777")
+		panicOn(err)
+		cv.So(network, cv.ShouldEqual, "tcp")
+		cv.So(where, cv.ShouldEqual, "127.0.0.1:7777")
+		_, _, err = parseListenAddr("/tmp/gi.sock")
+		cv.So(err, cv.ShouldNotBeNil)
+		_, _, err = parseListenAddr("udp:127.0.0.1:7777")
+		cv.So(err, cv.ShouldNotBeNil)
+	})
+}
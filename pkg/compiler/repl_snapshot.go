@@ -0,0 +1,374 @@
+package compiler
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gijit/gi/pkg/constant"
+	"github.com/gijit/gi/pkg/format"
+	"github.com/gijit/gi/pkg/types"
+)
+
+// snapshotLua renders a Lua value as a Go literal.
+// Composite values carry their runtime type (__typ or
+// __constructor); basic values inside them get theirs
+// from the enclosing type. Pointers are followed, so
+// sharing between variables is not preserved, and a
+// cycle is an error.
+const snapshotLua = `
+local function __snapTypeName(typ, pkgPrefix)
+   local s = typ.__str
+   if pkgPrefix ~= "" then
+      s = string.gsub(s, "%f[%w_.]" .. pkgPrefix .. "%.", "")
+   end
+   return s
+end
+
+local function __snapQuote(s)
+   return '"' .. string.gsub(s, '[%c"\\\128-\255]', function(c)
+      if c == '"' then return '\\"' end
+      if c == '\\' then return '\\\\' end
+      if c == '\n' then return '\\n' end
+      if c == '\t' then return '\\t' end
+      return string.format("\\x%02x", string.byte(c))
+   end) .. '"'
+end
+
+local function __snapNumber(v, isFloat)
+   if type(v) == "cdata" then
+      local s = tostring(v)
+      s = string.gsub(s, "U?LL$", "")
+      return s
+   end
+   if v ~= v or v == math.huge or v == -math.huge then
+      error("cannot write " .. tostring(v) .. " as a Go literal", 0)
+   end
+   local s = string.format("%.15g", v)
+   if tonumber(s) ~= v then
+      s = string.format("%.17g", v)
+   end
+   if isFloat and not string.find(s, "[.e]") then
+      s = s .. ".0"
+   end
+   return s
+end
+
+__gijit_golit = function(v, typ, pkgPrefix, seen)
+   seen = seen or {}
+   if v == nil then
+      return "nil"
+   end
+   local ty = type(v)
+   if ty == "boolean" then
+      return tostring(v)
+   elseif ty == "string" then
+      return __snapQuote(v)
+   elseif ty == "number" or ty == "cdata" then
+      local k = typ and typ.kind
+      return __snapNumber(v, k == nil or k == __kindFloat32 or k == __kindFloat64)
+   elseif ty ~= "table" then
+      error("cannot write a " .. ty .. " as a Go literal", 0)
+   end
+
+   -- composite values know their own type.
+   local own = rawget(v, "__typ") or rawget(v, "__constructor")
+   if own ~= nil and own.kind == __kindPtr and typ ~= nil and typ.kind == __kindStruct then
+      -- a struct value, held through its pointer.
+      v = rawget(v, "__target") or v
+   else
+      typ = own or typ
+   end
+   if typ == nil or typ.kind == nil then
+      error("cannot write an untyped Lua table as a Go literal", 0)
+   end
+   local val = rawget(v, "__val")
+   if val ~= nil and type(val) ~= "table" then
+      -- a boxed basic value.
+      return __gijit_golit(val, typ, pkgPrefix, seen)
+   end
+   if typ.__nil ~= nil and rawequal(v, typ.__nil) then
+      return "nil"
+   end
+   if seen[v] then
+      error("cannot write a cyclic value as a Go literal", 0)
+   end
+   seen[v] = true
+
+   local parts = {}
+   local kind = typ.kind
+   local name = __snapTypeName(typ, pkgPrefix)
+   if kind == __kindStruct then
+      for i, fld in ipairs(typ.fields) do
+         parts[i] = fld.__name .. ": " .. __gijit_golit(v[fld.__prop], fld.__typ, pkgPrefix, seen)
+      end
+   elseif kind == __kindSlice or kind == __kindArray then
+      local arr = v.__array
+      for i = 0, v.__length - 1 do
+         parts[i + 1] = __gijit_golit(arr[v.__offset + i], typ.elem, pkgPrefix, seen)
+      end
+   elseif kind == __kindMap then
+      local keyKind = typ.key.kind
+      for ks, e in pairs(v.__val) do
+         local key
+         if keyKind == __kindString then
+            key = __snapQuote(ks)
+         elseif keyKind == __kindBool then
+            key = ks
+         elseif keyKind >= __kindInt and keyKind <= __kindFloat64 then
+            key = string.gsub(ks, "U?LL$", "")
+         else
+            error("cannot write a map with " .. typ.key.__str .. " keys as a Go literal", 0)
+         end
+         if e == __intentionalNilValue then
+            e = nil
+         end
+         parts[#parts + 1] = key .. ": " .. __gijit_golit(e, typ.elem, pkgPrefix, seen)
+      end
+      table.sort(parts)
+   elseif kind == __kindPtr then
+      seen[v] = nil
+      if typ.elem.kind == __kindStruct then
+         return "&" .. __gijit_golit(v.__target, typ.elem, pkgPrefix, seen)
+      end
+      return "func() " .. name .. " { v := " ..
+         __gijit_golit(v.__get(), typ.elem, pkgPrefix, seen) .. "; return &v }()"
+   else
+      error("cannot write a value of type " .. typ.__str .. " as a Go literal", 0)
+   end
+   seen[v] = nil
+   return name .. "{" .. table.concat(parts, ", ") .. "}"
+end
+
+-- __gijit_snapshotGlobals lists the globals __ls would.
+__gijit_snapshotGlobals = function()
+   local names = {}
+   for _, k in ipairs(__sorted_keys(_G)) do
+      if string.sub(k, 1, 2) ~= "__" and
+         (__built_in_starting_symbol_list == nil or
+          not __built_in_starting_symbol_list[k]) then
+         names[#names + 1] = k
+      end
+   end
+   return table.concat(names, " ")
+end
+`
+
+// snapshotHeader starts every snapshot file.
+const snapshotHeader = "// gijit snapshot: restore with `gi -restore <file>` or :restore <file>.\n"
+
+// Snapshot renders the session's state as Go source
+// which, replayed into a fresh interpreter, rebuilds it:
+// imports, type declarations, constants, and the source
+// of funcs and methods, then the user's global variables
+// with their values written as Go literals. Globals
+// without a Go type, such as those set in raw Lua mode,
+// and values that cannot be written as literals (funcs
+// and channels, say) are left out, and listed in
+// comments at the end.
+func (ic *IncrState) Snapshot() ([]byte, error) {
+	pkg := ic.curTypesPkg()
+	if pkg == nil {
+		return nil, fmt.Errorf("no current package")
+	}
+	qf := types.RelativeTo(pkg)
+	scope := pkg.Scope()
+	srcs := ic.CurPkg.Arch.FuncSrcCache
+
+	var imports, decls []string
+	var skipped []string
+	var objs []types.Object
+	for _, name := range scope.Names() {
+		if !strings.HasPrefix(name, "__") {
+			objs = append(objs, scope.Lookup(name))
+		}
+	}
+	// session order, so each declaration follows what it uses.
+	sort.Slice(objs, func(i, j int) bool { return objs[i].Pos() < objs[j].Pos() })
+
+	for _, obj := range objs {
+		switch o := obj.(type) {
+		case *types.PkgName:
+			imp := o.Imported()
+			if imp.Name() == o.Name() {
+				imports = append(imports, fmt.Sprintf("import %s", strconv.Quote(imp.Path())))
+			} else {
+				imports = append(imports, fmt.Sprintf("import %s %s", o.Name(), strconv.Quote(imp.Path())))
+			}
+		case *types.TypeName:
+			if o.IsAlias() {
+				decls = append(decls, fmt.Sprintf("type %s = %s", o.Name(), types.TypeString(o.Type(), qf)))
+				continue
+			}
+			decls = append(decls, fmt.Sprintf("type %s %s", o.Name(), types.TypeString(o.Type().Underlying(), qf)))
+			if named, ok := o.Type().(*types.Named); ok {
+				for i := 0; i < named.NumMethods(); i++ {
+					m := named.Method(i)
+					if src, ok := srcs[o.Name()+"."+m.Name()]; ok {
+						decls = append(decls, src)
+					} else {
+						skipped = append(skipped, fmt.Sprintf("method %s.%s: source unknown", o.Name(), m.Name()))
+					}
+				}
+			}
+		case *types.Const:
+			decls = append(decls, constDecl(o, qf))
+		case *types.Func:
+			if src, ok := srcs[o.Name()]; ok {
+				decls = append(decls, src)
+			} else {
+				skipped = append(skipped, fmt.Sprintf("func %s: source unknown", o.Name()))
+			}
+		}
+	}
+
+	vars, varSkipped, err := ic.snapshotVars(pkg)
+	if err != nil {
+		return nil, err
+	}
+	skipped = append(skipped, varSkipped...)
+
+	// a package clause, for format.Source, that we drop after.
+	const pkgClause = "package main\n"
+	var buf bytes.Buffer
+	buf.WriteString(pkgClause)
+	for _, section := range [][]string{imports, decls, vars} {
+		if len(section) == 0 {
+			continue
+		}
+		buf.WriteString("\n")
+		for _, s := range section {
+			buf.WriteString(strings.TrimSpace(s))
+			buf.WriteString("\n")
+		}
+	}
+	if len(skipped) > 0 {
+		buf.WriteString("\n// not saved:\n")
+		for _, s := range skipped {
+			fmt.Fprintf(&buf, "//   %s\n", s)
+		}
+	}
+	src := buf.Bytes()
+	if formatted, err := format.Source(src); err == nil {
+		src = formatted
+	}
+	src = bytes.TrimPrefix(src, []byte(pkgClause))
+	return append([]byte(snapshotHeader), src...), nil
+}
+
+func constDecl(c *types.Const, qf types.Qualifier) string {
+	val := c.Val()
+	lit := val.ExactString()
+	if val.Kind() == constant.Float {
+		// ExactString can give a fraction, like 1/3.
+		if f, ok := constant.Float64Val(val); ok {
+			lit = strconv.FormatFloat(f, 'g', -1, 64)
+			if !strings.ContainsAny(lit, ".eE") {
+				lit += ".0"
+			}
+		}
+	}
+	if b, ok := c.Type().(*types.Basic); ok && b.Info()&types.IsUntyped != 0 {
+		return fmt.Sprintf("const %s = %s", c.Name(), lit)
+	}
+	return fmt.Sprintf("const %s %s = %s", c.Name(), types.TypeString(c.Type(), qf), lit)
+}
+
+// snapshotVars writes a var declaration, with its
+// current value, for each of the globals __ls would list.
+func (ic *IncrState) snapshotVars(pkg *types.Package) (vars, skipped []string, err error) {
+	tk := ic.goro.newTicket(snapshotLua, false)
+	err = tk.Do()
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not set up snapshot: '%v'", err)
+	}
+	tk = ic.goro.newTicket("__gijit_snapshotOut = __gijit_snapshotGlobals()", false)
+	tk.varname["__gijit_snapshotOut"] = nil
+	tk.gettyp = GetString
+	err = tk.Do()
+	if err != nil {
+		return nil, nil, err
+	}
+	names, _ := tk.varname["__gijit_snapshotOut"].(string)
+
+	qf := types.RelativeTo(pkg)
+	for _, name := range strings.Fields(names) {
+		v, ok := pkg.Scope().Lookup(name).(*types.Var)
+		if !ok {
+			if pkg.Scope().Lookup(name) == nil {
+				skipped = append(skipped, fmt.Sprintf("%s: no Go type", name))
+			}
+			continue
+		}
+		// an error comes back marked with a leading '!',
+		// which no literal starts with.
+		code := fmt.Sprintf(`
+local ok, lit = pcall(__gijit_golit, %s, nil, %q)
+if not ok then lit = "!" .. tostring(lit) end
+__gijit_snapshotOut = lit
+`, name, pkg.Name())
+		tk := ic.goro.newTicket(code, false)
+		tk.varname["__gijit_snapshotOut"] = nil
+		tk.gettyp = GetString
+		err = tk.Do()
+		if err != nil {
+			return nil, nil, err
+		}
+		lit, _ := tk.varname["__gijit_snapshotOut"].(string)
+		if strings.HasPrefix(lit, "!") {
+			skipped = append(skipped, fmt.Sprintf("%s: %s", name, lit[1:]))
+			continue
+		}
+		vars = append(vars, fmt.Sprintf("var %s %s = %s", name, types.TypeString(v.Type(), qf), lit))
+	}
+	return
+}
+
+// snapshot writes the session's state to path. It backs
+// the :snapshot command.
+func (r *Repl) snapshot(path string) error {
+	src, err := r.inc.Snapshot()
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(expandHome(path), src, 0644)
+}
+
+// restore replaces the interpreter with a fresh one
+// rebuilt from the snapshot at path. It backs :restore
+// and `gi -restore`. On error, the current interpreter
+// is kept.
+func (r *Repl) restore(path string) error {
+	src, err := ioutil.ReadFile(expandHome(path))
+	if err != nil {
+		return err
+	}
+	if !bytes.HasPrefix(src, []byte(snapshotHeader)) {
+		return fmt.Errorf("'%s' is not a gijit snapshot", path)
+	}
+	lvm, inc, err := r.newInterpreter()
+	if err != nil {
+		return err
+	}
+	translation, err := TranslateAndCatchPanic(inc, src)
+	if err == nil {
+		err = LuaRun(lvm, translation, true)
+	}
+	if err != nil {
+		lvm.Close()
+		return fmt.Errorf("could not restore '%s': %v", path, err)
+	}
+	r.useInterpreter(lvm, inc)
+	return nil
+}
+
+func expandHome(path string) string {
+	if home := os.Getenv("HOME"); home != "" {
+		path = strings.Replace(path, "~/", home+"/", 1)
+	}
+	return path
+}
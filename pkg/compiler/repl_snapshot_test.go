@@ -0,0 +1,87 @@
+package compiler
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test1604SnapshotAndRestore(t *testing.T) {
+
+	vm, err := NewLuaVmWithPrelude(nil)
+	panicOn(err)
+	inc := NewIncrState(vm, nil)
+	r := &Repl{cfg: NewGIConfig(), lvm: vm, inc: inc}
+	defer func() { r.lvm.Close() }()
+
+	cv.Convey(":snapshot saves types, funcs, and global variables with their Go types, and :restore rebuilds a fresh interpreter into the same state", t, func() {
+		code := `
+type point struct { X, Y float64; Tag string }
+type grid struct { name string; pts []point; idx map[string]int; p *point; any interface{} }
+func (g *grid) add(p point) { g.pts = append(g.pts, p) }
+func add(a, b int) int { return a + b }
+const K = 7
+g := &grid{name: "g\"1\n", idx: map[string]int{"a": 1, "b": 2}, any: 2.5}
+g.add(point{1, 2.5, "x"})
+g.p = &point{Tag: "q"}
+n := add(1, K)
+var u8 uint8 = 250
+fn := add
+`
+		translation, err := inc.Tr([]byte(code))
+		panicOn(err)
+		LuaRunAndReport(vm, string(translation))
+
+		dir, err := ioutil.TempDir("", "gi-snapshot")
+		panicOn(err)
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "work.snap")
+		panicOn(r.snapshot(path))
+		snap, err := ioutil.ReadFile(path)
+		panicOn(err)
+		cv.So(string(snap), cv.ShouldContainSubstring, "var n int = 8\n")
+		cv.So(string(snap), cv.ShouldContainSubstring, "var u8 uint8 = 250\n")
+		cv.So(string(snap), cv.ShouldContainSubstring, `idx: map[string]int{"a": 1, "b": 2}`)
+		cv.So(string(snap), cv.ShouldContainSubstring, "//   fn: cannot write a function as a Go literal\n")
+
+		panicOn(r.restore(path))
+		cv.So(r.lvm, cv.ShouldNotEqual, vm)
+
+		h, err := newKernelHandler(r)
+		panicOn(err)
+		var stdout, stderr bytes.Buffer
+		for expr, want := range map[string]string{
+			"n":                     "8LL",
+			"add(n, K)":             "15LL",
+			`g.name == "g\"1\n"`:    "true",
+			"len(g.pts)":            "1",
+			"g.pts[0].Y":            "2.5",
+			"g.pts[0].Tag == \"x\"": "true",
+			"g.idx[\"b\"]":          "2LL",
+			"g.p.Tag == \"q\"":      "true",
+			"g.any.(float64)":       "2.5",
+			"u8 + 5":                "255ULL",
+		} {
+			res, err := h.Execute(expr, &stdout, &stderr)
+			panicOn(err)
+			cv.So(strings.Trim(res, "`"), cv.ShouldEqual, strings.Trim(want, "`"))
+		}
+		_, err = h.Execute("g.add(point{Tag: \"y\"})", &stdout, &stderr)
+		panicOn(err)
+		res, err := h.Execute("len(g.pts)", &stdout, &stderr)
+		panicOn(err)
+		cv.So(res, cv.ShouldEqual, "2")
+
+		// not a snapshot: refused, and the state is kept.
+		panicOn(ioutil.WriteFile(path, []byte("x := 1\n"), 0644))
+		cv.So(r.restore(path), cv.ShouldNotBeNil)
+		res, err = h.Execute("n", &stdout, &stderr)
+		panicOn(err)
+		cv.So(res, cv.ShouldEqual, "8LL")
+	})
+}
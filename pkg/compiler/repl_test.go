@@ -3,7 +3,6 @@ package compiler
 import (
 	"fmt"
 	"os"
-	"runtime"
 	"testing"
 
 	//"github.com/gijit/gi/pkg/verb"
@@ -15,19 +14,8 @@ func init() {
 	defaultTestMode = true
 }
 
-func init() {
-	runtime.LockOSThread()
-}
-
 func TestMain(m *testing.M) {
-	if reserveMainThread {
-		go func() {
-			os.Exit(m.Run())
-		}()
-		MainCThread()
-	} else {
-		os.Exit(m.Run())
-	}
+	os.Exit(m.Run())
 }
 
 var matchesLuaSrc = cv.ShouldMatchModuloWhiteSpaceAndLuaComments
@@ -1004,8 +992,8 @@ m := 1%a
 		cv.So(string(translation), matchesLuaSrc,
 			`
 	a = 0LL;
-    b = __integerByZeroCheck(1LL / a);
-    m = __integerByZeroCheck(1LL % a);
+    b = __integerQuo(1LL, a);
+    m = __integerRem(1LL, a);
 `)
 
 		codeWithCatch := `
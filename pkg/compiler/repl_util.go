@@ -9,8 +9,6 @@ import (
 	"runtime/debug"
 	"strconv"
 	"strings"
-
-	"github.com/gijit/gi/pkg/verb"
 )
 
 func TranslateAndCatchPanic(inc *IncrState, src []byte) (translation string, err error) {
@@ -18,7 +16,7 @@ func TranslateAndCatchPanic(inc *IncrState, src []byte) (translation string, err
 		recov := recover()
 		if recov != nil {
 			msg := fmt.Sprintf("problem detected during Go static type checking: '%v'", recov)
-			if verb.Verbose {
+			if inc.cfg.verbose() {
 				msg += fmt.Sprintf("\n%s\n", string(debug.Stack()))
 			}
 			err = fmt.Errorf(msg)
@@ -29,7 +27,7 @@ func TranslateAndCatchPanic(inc *IncrState, src []byte) (translation string, err
 
 	prependAnsOK := true
 	by, err := inc.TrWithPrepend([]byte(src), prependAnsOK)
-	if err != nil {
+	if _, vetted := err.(*giGoError); err != nil && !vetted {
 		// try without allowing prepend of ans
 		prependAnsOK = false
 		by, err = inc.TrWithPrepend([]byte(src), prependAnsOK)
@@ -46,30 +44,26 @@ func TranslateAndCatchPanic(inc *IncrState, src []byte) (translation string, err
 			t2 = t2[:nt2-1]
 		}
 	}
-	p("go:'%s'  -->  '%s'\n", src, t2)
+	if inc.cfg.verbose() {
+		fmt.Fprintf(inc.cfg.diag(), "go:'%s'  -->  '%s'\n", src, t2)
+	}
 	return translation, err
 }
 
-func readHistory(histFn string) (history []string, err error) {
+// readHistory reads the entries in histFn; old
+// is true if it was in the old line format.
+func readHistory(histFn string) (history []*histEntry, old bool, err error) {
 	if !FileExists(histFn) {
-		return nil, nil
+		return nil, false, nil
 	}
 	by, err := ioutil.ReadFile(histFn)
 	if err != nil {
-		return nil, err
-	}
-	splt := strings.Split(string(by), "\n")
-	n := len(splt)
-
-	// avoid returning an extra blank history line
-	// at the end of the history file.
-	if n > 0 && strings.TrimSpace(splt[n-1]) == "" {
-		return splt[:n-1], nil
+		return nil, false, err
 	}
-	return splt, nil
+	return parseHistory(by)
 }
 
-func removeCommands(history []string, histFn string, histFile *os.File, rms string) (history2 []string, histFile2 *os.File, beg int, end int, err error) {
+func removeCommands(history []*histEntry, histFn string, histFile *os.File, rms string, diag io.Writer) (history2 []*histEntry, histFile2 *os.File, beg int, end int, err error) {
 
 	beg = -1
 	end = -1
@@ -87,7 +81,7 @@ func removeCommands(history []string, histFn string, histFile *os.File, rms stri
 		k := num[0]
 		beg = k - 1
 		end = k - 1
-		fmt.Printf("remove history %03d.\n", k)
+		fmt.Fprintf(diag, "remove history %03d.\n", k)
 		history2 = append(history[:k-1], history[k:]...)
 	case 2:
 		if num[1] < num[0] {
@@ -96,24 +90,20 @@ func removeCommands(history []string, histFn string, histFile *os.File, rms stri
 		}
 		beg = num[0] - 1
 		end = num[1] - 1
-		fmt.Printf("remove history %03d - %03d.\n", num[0], num[1])
+		fmt.Fprintf(diag, "remove history %03d - %03d.\n", num[0], num[1])
 		history2 = append(history[:num[0]-1], history[num[1]:]...)
 	}
 
+	if histFile == nil {
+		return
+	}
 	histFile.Close()
-	os.Remove(histFn)
-	histFile2, err = os.OpenFile(histFn,
-		os.O_WRONLY|os.O_CREATE|os.O_APPEND|os.O_SYNC,
-		0600)
+	histFile2, err = rewriteHistory(histFn, history2)
 	panicOn(err)
-	// print new history to file
-	for i := range history2 {
-		fmt.Fprintf(histFile2, "%s\n", history2[i])
-	}
 	return
 }
 
-func getHistoryRange(lows string, history []string) (slc []int, err error) {
+func getHistoryRange(lows string, history []*histEntry) (slc []int, err error) {
 	parts := strings.Split(lows, "-")
 	if len(parts) > 2 {
 		return nil, fmt.Errorf("bad history range request, more than one '-' found.")
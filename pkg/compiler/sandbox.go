@@ -0,0 +1,127 @@
+package compiler
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gijit/gi/pkg/types"
+)
+
+// SandboxPolicy is what interpreted code may reach when
+// GIConfig.Sandbox is set, for running untrusted
+// snippets. Breaking it is a type checking error where
+// that can be known, and otherwise a runtime panic.
+type SandboxPolicy struct {
+
+	// AllowImports lists the packages that may be
+	// imported. Any other import, shadowed or from
+	// source, is refused when it is type checked.
+	AllowImports map[string]bool
+
+	// AllowLua and AllowZygo keep __lua() and __zygo(),
+	// which run arbitrary Lua and zygomys code.
+	AllowLua  bool
+	AllowZygo bool
+
+	// HiddenGlobals are the Lua globals that translated
+	// code may not reach; touching one panics.
+	HiddenGlobals []string
+}
+
+// NewSandboxPolicy allows the pure computation packages,
+// leaving out os, io/ioutil, runtime, runtime/debug and
+// reflect, and hides the host access in the Lua runtime.
+func NewSandboxPolicy() *SandboxPolicy {
+	allow := make(map[string]bool)
+	for _, path := range []string{
+		"bytes",
+		"context",
+		"encoding/binary",
+		"errors",
+		"fmt",
+		"io",
+		"math",
+		"math/rand",
+		"regexp",
+		"strconv",
+		"strings",
+		"sync",
+		"sync/atomic",
+		"time",
+	} {
+		allow[path] = true
+	}
+	return &SandboxPolicy{
+		AllowImports: allow,
+		HiddenGlobals: []string{
+			"io", "os", "ffi", "debug", "jit",
+			"load", "loadstring", "loadfile", "dofile",
+			"require", "package", "module",
+			"getfenv", "setfenv",
+			"__zygo", "__go_run_import", "__go_compile_import",
+		},
+	}
+}
+
+// checkImport refuses an import that the policy does
+// not allow; sb may be nil, meaning no sandbox.
+func (sb *SandboxPolicy) checkImport(path string) error {
+	if sb == nil || sb.AllowImports[path] {
+		return nil
+	}
+	var allowed []string
+	for p := range sb.AllowImports {
+		allowed = append(allowed, p)
+	}
+	sort.Strings(allowed)
+	return fmt.Errorf("sandbox: import of \"%s\" is not allowed; allowed are: %s",
+		path, strings.Join(allowed, ", "))
+}
+
+// newPkgPrelude returns what to add to the scope of a
+// new main package under the sandbox sb.
+func newPkgPrelude(sb *SandboxPolicy) func(pkg *types.Package) {
+	return func(pkg *types.Package) {
+		addSandboxedPrelude(pkg, sb)
+	}
+}
+
+// sandboxLuaSetup makes __gijitMainEval run each
+// chunk in __gijit_sandboxEnv: globals are read from
+// and written to _G, except the hidden ones, which
+// are a runtime error to read. A translated global that happens to
+// share a hidden name stays in the sandbox table.
+const sandboxLuaSetup = `
+local hidden = {}
+for _, name in ipairs({%s}) do
+   hidden[name] = true
+end
+__gijit_sandboxEnv = setmetatable({}, {
+   __index = function(t, k)
+      if hidden[k] then
+         __throwRuntimeError("sandbox: " .. tostring(k) .. " is not available")
+      end
+      return _G[k]
+   end,
+   __newindex = function(t, k, v)
+      if hidden[k] then
+         rawset(t, k, v)
+      else
+         _G[k] = v
+      end
+   end,
+})
+`
+
+func (lvm *LuaVm) setupSandbox(sb *SandboxPolicy) error {
+	var names []string
+	for _, name := range sb.HiddenGlobals {
+		names = append(names, fmt.Sprintf("%q", name))
+	}
+	err := LuaRun(lvm, fmt.Sprintf(sandboxLuaSetup, strings.Join(names, ", ")), false)
+	if err != nil {
+		return fmt.Errorf("could not set up the sandbox: '%v'", err)
+	}
+	return nil
+}
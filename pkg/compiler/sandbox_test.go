@@ -0,0 +1,57 @@
+package compiler
+
+import (
+	"testing"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test1611SandboxKeepsInterpretedCodeFromTheHost(t *testing.T) {
+
+	cfg := NewGIConfig()
+	cfg.Sandbox = NewSandboxPolicy()
+	vm, err := NewLuaVmWithPrelude(cfg)
+	panicOn(err)
+	defer vm.Close()
+	inc := NewIncrState(vm, cfg)
+
+	// run returns the translation error, else the eval's.
+	run := func(src string) string {
+		translation, err := TranslateAndCatchPanic(inc, []byte(src))
+		if err != nil {
+			return err.Error()
+		}
+		panicOn(LuaRun(vm, translation, true))
+		lastErr, err := lastEvalErr(vm)
+		panicOn(err)
+		return lastErr
+	}
+
+	cv.Convey("in the sandbox, host packages and the Lua escape hatches are refused when type checked", t, func() {
+		cv.So(run(`import "os"`), cv.ShouldContainSubstring, `sandbox: import of "os" is not allowed`)
+		cv.So(run(`import "io/ioutil"`), cv.ShouldContainSubstring, `sandbox: import of "io/ioutil" is not allowed`)
+		cv.So(run(`import "runtime/debug"`), cv.ShouldContainSubstring, `sandbox: import of "runtime/debug" is not allowed`)
+		cv.So(run(`x := __lua("os.exit(1)")`), cv.ShouldContainSubstring, "undeclared name: __lua")
+		cv.So(run(`x, err := __zygo("(+ 1 2)")`), cv.ShouldContainSubstring, "undeclared name: __zygo")
+
+		// an allowed import gets past the sandbox.
+		cv.So(run(`import "regexp"`), cv.ShouldNotContainSubstring, "sandbox")
+	})
+
+	cv.Convey("the Lua runtime's host access is out of reach at run time, while ordinary Go still runs", t, func() {
+		panicOn(LuaRun(vm, `x = io`, true))
+		lastErr, err := lastEvalErr(vm)
+		panicOn(err)
+		cv.So(lastErr, cv.ShouldContainSubstring, "sandbox: io is not available")
+
+		panicOn(LuaRun(vm, `f = loadstring("return 1")`, true))
+		lastErr, err = lastEvalErr(vm)
+		panicOn(err)
+		cv.So(lastErr, cv.ShouldContainSubstring, "sandbox: loadstring is not available")
+
+		cv.So(run(`type pt struct { a int }; caught := false; func f() { defer func() { caught = recover() != nil }(); panic("x") }; f(); p := pt{a: 2}; n := 0; go func() { n = p.a * 21 }()`), cv.ShouldEqual, "")
+		LuaMustBool(vm, "caught", true)
+		cv.So(run(`m := n`), cv.ShouldEqual, "")
+		LuaMustInt64(vm, "m", 42)
+	})
+}
@@ -0,0 +1,117 @@
+package compiler
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"runtime/debug"
+)
+
+// scriptLuaSetup prepares the vm to run a script. Lua's
+// print is line buffered, so its output keeps its place
+// among writes from Go, and survives an os.Exit. An
+// uncaught panic is reported on stderr, as Go would.
+const scriptLuaSetup = `
+io.stdout:setvbuf("line")
+
+__errHandlerForEval = function(err)
+   __lastEvalErr = tostring(err)
+   io.stdout:flush()
+   io.stderr:write("panic: " .. debug.traceback(tostring(err), 2) .. "\n")
+   return err
+end
+
+__evalDeadlocked = function(co)
+   __lastEvalErr = __deadlockReport()
+   io.stdout:flush()
+   io.stderr:write(__lastEvalErr .. "\n")
+end
+`
+
+// RunScript runs the Go program in cfg.ScriptPath with
+// cfg.ScriptArgs as its arguments, and returns the exit
+// status: 0 once main returns, or 1 for an uncaught panic
+// or a program that does not compile. A script that calls
+// os.Exit leaves with its own status. This is
+// `gi script.go args...`, and so `#!/usr/bin/env gi`.
+func RunScript(cfg *GIConfig) int {
+	src, err := ioutil.ReadFile(cfg.ScriptPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "gi: %v\n", err)
+		return 1
+	}
+	lvm, err := NewLuaVmWithPrelude(cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "gi: %v\n", err)
+		return 1
+	}
+	defer lvm.Close()
+	inc := NewIncrState(lvm, cfg)
+	return runScript(lvm, inc, cfg.ScriptPath, src, cfg.ScriptArgs)
+}
+
+func runScript(lvm *LuaVm, inc *IncrState, path string, src []byte, args []string) int {
+	// the script sees itself as the program being run.
+	inc.args = append([]string{path}, args...)
+
+	translation, err := translateScript(inc, stripShebang(src))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", path, err)
+		return 1
+	}
+	err = LuaRun(lvm, scriptLuaSetup, false)
+	if err == nil {
+		err = LuaRun(lvm, string(translation), true)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", path, err)
+		return 1
+	}
+	lastErr, err := lastEvalErr(lvm)
+	if err != nil || lastErr != "" {
+		return 1
+	}
+	return 0
+}
+
+// stripShebang comments out a leading #! line, keeping
+// the line numbers of what follows.
+func stripShebang(src []byte) []byte {
+	if !bytes.HasPrefix(src, []byte("#!")) {
+		return src
+	}
+	cp := append([]byte(nil), src...)
+	cp[0], cp[1] = '/', '/'
+	return cp
+}
+
+// translateScript is FullPackage, with the type
+// checker's panics turned into errors.
+func translateScript(inc *IncrState, src []byte) (translation []byte, err error) {
+	defer func() {
+		recov := recover()
+		if recov != nil {
+			msg := fmt.Sprintf("%v", recov)
+			if inc.cfg.verbose() {
+				msg += fmt.Sprintf("\n%s\n", string(debug.Stack()))
+			}
+			err = fmt.Errorf("%s", msg)
+		}
+	}()
+	return inc.FullPackage(src, "main", 0)
+}
+
+// lastEvalErr fetches the error, if any, that the
+// eval coroutine recorded for the last run.
+func lastEvalErr(lvm *LuaVm) (string, error) {
+	tk := lvm.goro.newTicket("", false)
+	tk.varname["__lastEvalErr"] = nil
+	tk.gettyp = GetString
+	err := tk.Do()
+	if err != nil {
+		return "", err
+	}
+	s, _ := tk.varname["__lastEvalErr"].(string)
+	return s, nil
+}
@@ -0,0 +1,68 @@
+package compiler
+
+import (
+	"os"
+	"testing"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test1606RunScriptFile(t *testing.T) {
+
+	cv.Convey("a Go file with a #! line runs as a script: main() is called, and an uncaught panic gives exit status 1", t, func() {
+		vm, err := NewLuaVmWithPrelude(nil)
+		panicOn(err)
+		defer vm.Close()
+		inc := NewIncrState(vm, nil)
+
+		src := `#!/usr/bin/env gi
+package main
+
+type acc struct{ tot int }
+
+func (a *acc) add(n int) { a.tot += n }
+
+var result int
+
+func main() {
+	a := &acc{}
+	for i := 1; i <= 3; i++ {
+		a.add(i * 7)
+	}
+	result = a.tot
+	println("result is", result)
+}
+`
+		status := runScript(vm, inc, "sum.go", []byte(src), []string{"a", "b"})
+		cv.So(status, cv.ShouldEqual, 0)
+		err = LuaRun(vm, `assert(__packages["main"].result == 42LL)`, false)
+		cv.So(err, cv.ShouldBeNil)
+
+		// the script's os.Args are its own, and the
+		// host's are left alone.
+		panicOn(inc.RunTimeGiImportFunc("os", "", 0))
+		err = LuaRun(vm, `assert(#os.Args == 3 and os.Args[0] == "sum.go" and os.Args[1] == "a" and os.Args[2] == "b")`, false)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(os.Args[0], cv.ShouldNotEqual, "sum.go")
+
+		vm2, err := NewLuaVmWithPrelude(nil)
+		panicOn(err)
+		defer vm2.Close()
+		inc2 := NewIncrState(vm2, nil)
+		src = `package main
+
+func main() {
+	panic("on purpose")
+}
+`
+		status = runScript(vm2, inc2, "boom.go", []byte(src), nil)
+		cv.So(status, cv.ShouldEqual, 1)
+
+		// does not compile
+		vm3, err := NewLuaVmWithPrelude(nil)
+		panicOn(err)
+		defer vm3.Close()
+		status = runScript(vm3, NewIncrState(vm3, nil), "bad.go", []byte("package main\nfunc main() { undefinedThing() }\n"), nil)
+		cv.So(status, cv.ShouldEqual, 1)
+	})
+}
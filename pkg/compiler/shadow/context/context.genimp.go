@@ -0,0 +1,63 @@
+package shadow_context
+
+import (
+	"context"
+	"time"
+)
+
+var Pkg = make(map[string]interface{})
+var Ctor = make(map[string]interface{})
+var Proxy = make(map[string]interface{})
+
+func init() {
+    Pkg["Background"] = context.Background
+    Pkg["Canceled"] = context.Canceled
+    Pkg["Context"] = GijitShadow_InterfaceConvertTo2_Context
+    Proxy["Context"] = (*GijitShadow_Proxy_Context)(nil)
+    Pkg["DeadlineExceeded"] = context.DeadlineExceeded
+    Pkg["TODO"] = context.TODO
+    Pkg["WithCancel"] = context.WithCancel
+    Pkg["WithDeadline"] = context.WithDeadline
+    Pkg["WithTimeout"] = context.WithTimeout
+    Pkg["WithValue"] = context.WithValue
+
+}
+func GijitShadow_InterfaceConvertTo2_Context(x interface{}) (y context.Context, b bool) {
+	y, b = x.(context.Context)
+	return
+}
+
+func GijitShadow_InterfaceConvertTo1_Context(x interface{}) context.Context {
+	return x.(context.Context)
+}
+
+type GijitShadow_Proxy_Context struct {
+	Method_Deadline func() (time.Time, bool)
+	Method_Done func() <-chan struct{}
+	Method_Err func() error
+	Method_Value func(a0 interface{}) interface{}
+}
+
+func (p *GijitShadow_Proxy_Context) Deadline() (time.Time, bool) {
+	return p.Method_Deadline()
+}
+
+func (p *GijitShadow_Proxy_Context) Done() <-chan struct{} {
+	return p.Method_Done()
+}
+
+func (p *GijitShadow_Proxy_Context) Err() error {
+	return p.Method_Err()
+}
+
+func (p *GijitShadow_Proxy_Context) Value(a0 interface{}) interface{} {
+	return p.Method_Value(a0)
+}
+
+
+
+ func InitLua() string {
+  return `
+__type__.context ={};
+
+`}
\ No newline at end of file
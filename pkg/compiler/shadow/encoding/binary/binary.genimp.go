@@ -4,10 +4,12 @@ import "encoding/binary"
 
 var Pkg = make(map[string]interface{})
 var Ctor = make(map[string]interface{})
+var Proxy = make(map[string]interface{})
 
 func init() {
     Pkg["BigEndian"] = binary.BigEndian
     Pkg["ByteOrder"] = GijitShadow_InterfaceConvertTo2_ByteOrder
+    Proxy["ByteOrder"] = (*GijitShadow_Proxy_ByteOrder)(nil)
     Pkg["LittleEndian"] = binary.LittleEndian
     Pkg["MaxVarintLen16"] = binary.MaxVarintLen16
     Pkg["MaxVarintLen32"] = binary.MaxVarintLen32
@@ -32,6 +34,44 @@ func GijitShadow_InterfaceConvertTo1_ByteOrder(x interface{}) binary.ByteOrder {
 	return x.(binary.ByteOrder)
 }
 
+type GijitShadow_Proxy_ByteOrder struct {
+	Method_PutUint16 func(a0 []byte, a1 uint16) 
+	Method_PutUint32 func(a0 []byte, a1 uint32) 
+	Method_PutUint64 func(a0 []byte, a1 uint64) 
+	Method_String func() string
+	Method_Uint16 func(a0 []byte) uint16
+	Method_Uint32 func(a0 []byte) uint32
+	Method_Uint64 func(a0 []byte) uint64
+}
+
+func (p *GijitShadow_Proxy_ByteOrder) PutUint16(a0 []byte, a1 uint16)  {
+	p.Method_PutUint16(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_ByteOrder) PutUint32(a0 []byte, a1 uint32)  {
+	p.Method_PutUint32(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_ByteOrder) PutUint64(a0 []byte, a1 uint64)  {
+	p.Method_PutUint64(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_ByteOrder) String() string {
+	return p.Method_String()
+}
+
+func (p *GijitShadow_Proxy_ByteOrder) Uint16(a0 []byte) uint16 {
+	return p.Method_Uint16(a0)
+}
+
+func (p *GijitShadow_Proxy_ByteOrder) Uint32(a0 []byte) uint32 {
+	return p.Method_Uint32(a0)
+}
+
+func (p *GijitShadow_Proxy_ByteOrder) Uint64(a0 []byte) uint64 {
+	return p.Method_Uint64(a0)
+}
+
 
 
  func InitLua() string {
@@ -4,12 +4,17 @@ import "encoding"
 
 var Pkg = make(map[string]interface{})
 var Ctor = make(map[string]interface{})
+var Proxy = make(map[string]interface{})
 
 func init() {
     Pkg["BinaryMarshaler"] = GijitShadow_InterfaceConvertTo2_BinaryMarshaler
+    Proxy["BinaryMarshaler"] = (*GijitShadow_Proxy_BinaryMarshaler)(nil)
     Pkg["BinaryUnmarshaler"] = GijitShadow_InterfaceConvertTo2_BinaryUnmarshaler
+    Proxy["BinaryUnmarshaler"] = (*GijitShadow_Proxy_BinaryUnmarshaler)(nil)
     Pkg["TextMarshaler"] = GijitShadow_InterfaceConvertTo2_TextMarshaler
+    Proxy["TextMarshaler"] = (*GijitShadow_Proxy_TextMarshaler)(nil)
     Pkg["TextUnmarshaler"] = GijitShadow_InterfaceConvertTo2_TextUnmarshaler
+    Proxy["TextUnmarshaler"] = (*GijitShadow_Proxy_TextUnmarshaler)(nil)
 
 }
 func GijitShadow_InterfaceConvertTo2_BinaryMarshaler(x interface{}) (y encoding.BinaryMarshaler, b bool) {
@@ -21,6 +26,14 @@ func GijitShadow_InterfaceConvertTo1_BinaryMarshaler(x interface{}) encoding.Bin
 	return x.(encoding.BinaryMarshaler)
 }
 
+type GijitShadow_Proxy_BinaryMarshaler struct {
+	Method_MarshalBinary func() ([]byte, error)
+}
+
+func (p *GijitShadow_Proxy_BinaryMarshaler) MarshalBinary() ([]byte, error) {
+	return p.Method_MarshalBinary()
+}
+
 
 func GijitShadow_InterfaceConvertTo2_BinaryUnmarshaler(x interface{}) (y encoding.BinaryUnmarshaler, b bool) {
 	y, b = x.(encoding.BinaryUnmarshaler)
@@ -31,6 +44,14 @@ func GijitShadow_InterfaceConvertTo1_BinaryUnmarshaler(x interface{}) encoding.B
 	return x.(encoding.BinaryUnmarshaler)
 }
 
+type GijitShadow_Proxy_BinaryUnmarshaler struct {
+	Method_UnmarshalBinary func(a0 []byte) error
+}
+
+func (p *GijitShadow_Proxy_BinaryUnmarshaler) UnmarshalBinary(a0 []byte) error {
+	return p.Method_UnmarshalBinary(a0)
+}
+
 
 func GijitShadow_InterfaceConvertTo2_TextMarshaler(x interface{}) (y encoding.TextMarshaler, b bool) {
 	y, b = x.(encoding.TextMarshaler)
@@ -41,6 +62,14 @@ func GijitShadow_InterfaceConvertTo1_TextMarshaler(x interface{}) encoding.TextM
 	return x.(encoding.TextMarshaler)
 }
 
+type GijitShadow_Proxy_TextMarshaler struct {
+	Method_MarshalText func() ([]byte, error)
+}
+
+func (p *GijitShadow_Proxy_TextMarshaler) MarshalText() ([]byte, error) {
+	return p.Method_MarshalText()
+}
+
 
 func GijitShadow_InterfaceConvertTo2_TextUnmarshaler(x interface{}) (y encoding.TextUnmarshaler, b bool) {
 	y, b = x.(encoding.TextUnmarshaler)
@@ -51,6 +80,14 @@ func GijitShadow_InterfaceConvertTo1_TextUnmarshaler(x interface{}) encoding.Tex
 	return x.(encoding.TextUnmarshaler)
 }
 
+type GijitShadow_Proxy_TextUnmarshaler struct {
+	Method_UnmarshalText func(a0 []byte) error
+}
+
+func (p *GijitShadow_Proxy_TextUnmarshaler) UnmarshalText(a0 []byte) error {
+	return p.Method_UnmarshalText(a0)
+}
+
 
 
  func InitLua() string {
@@ -4,10 +4,12 @@ import "fmt"
 
 var Pkg = make(map[string]interface{})
 var Ctor = make(map[string]interface{})
+var Proxy = make(map[string]interface{})
 
 func init() {
     Pkg["Errorf"] = fmt.Errorf
     Pkg["Formatter"] = GijitShadow_InterfaceConvertTo2_Formatter
+    Proxy["Formatter"] = (*GijitShadow_Proxy_Formatter)(nil)
     Pkg["Fprint"] = fmt.Fprint
     Pkg["Fprintf"] = fmt.Fprintf
     Pkg["Fprintln"] = fmt.Fprintln
@@ -15,14 +17,17 @@ func init() {
     Pkg["Fscanf"] = fmt.Fscanf
     Pkg["Fscanln"] = fmt.Fscanln
     Pkg["GoStringer"] = GijitShadow_InterfaceConvertTo2_GoStringer
+    Proxy["GoStringer"] = (*GijitShadow_Proxy_GoStringer)(nil)
     Pkg["Print"] = fmt.Print
     Pkg["Printf"] = fmt.Printf
     Pkg["Println"] = fmt.Println
     Pkg["Scan"] = fmt.Scan
     Pkg["ScanState"] = GijitShadow_InterfaceConvertTo2_ScanState
+    Proxy["ScanState"] = (*GijitShadow_Proxy_ScanState)(nil)
     Pkg["Scanf"] = fmt.Scanf
     Pkg["Scanln"] = fmt.Scanln
     Pkg["Scanner"] = GijitShadow_InterfaceConvertTo2_Scanner
+    Proxy["Scanner"] = (*GijitShadow_Proxy_Scanner)(nil)
     Pkg["Sprint"] = fmt.Sprint
     Pkg["Sprintf"] = fmt.Sprintf
     Pkg["Sprintln"] = fmt.Sprintln
@@ -30,7 +35,9 @@ func init() {
     Pkg["Sscanf"] = fmt.Sscanf
     Pkg["Sscanln"] = fmt.Sscanln
     Pkg["State"] = GijitShadow_InterfaceConvertTo2_State
+    Proxy["State"] = (*GijitShadow_Proxy_State)(nil)
     Pkg["Stringer"] = GijitShadow_InterfaceConvertTo2_Stringer
+    Proxy["Stringer"] = (*GijitShadow_Proxy_Stringer)(nil)
 
 }
 func GijitShadow_InterfaceConvertTo2_Formatter(x interface{}) (y fmt.Formatter, b bool) {
@@ -42,6 +49,14 @@ func GijitShadow_InterfaceConvertTo1_Formatter(x interface{}) fmt.Formatter {
 	return x.(fmt.Formatter)
 }
 
+type GijitShadow_Proxy_Formatter struct {
+	Method_Format func(a0 fmt.State, a1 rune) 
+}
+
+func (p *GijitShadow_Proxy_Formatter) Format(a0 fmt.State, a1 rune)  {
+	p.Method_Format(a0, a1)
+}
+
 
 func GijitShadow_InterfaceConvertTo2_GoStringer(x interface{}) (y fmt.GoStringer, b bool) {
 	y, b = x.(fmt.GoStringer)
@@ -52,6 +67,14 @@ func GijitShadow_InterfaceConvertTo1_GoStringer(x interface{}) fmt.GoStringer {
 	return x.(fmt.GoStringer)
 }
 
+type GijitShadow_Proxy_GoStringer struct {
+	Method_GoString func() string
+}
+
+func (p *GijitShadow_Proxy_GoStringer) GoString() string {
+	return p.Method_GoString()
+}
+
 
 func GijitShadow_InterfaceConvertTo2_ScanState(x interface{}) (y fmt.ScanState, b bool) {
 	y, b = x.(fmt.ScanState)
@@ -62,6 +85,39 @@ func GijitShadow_InterfaceConvertTo1_ScanState(x interface{}) fmt.ScanState {
 	return x.(fmt.ScanState)
 }
 
+type GijitShadow_Proxy_ScanState struct {
+	Method_Read func(a0 []byte) (int, error)
+	Method_ReadRune func() (rune, int, error)
+	Method_SkipSpace func() 
+	Method_Token func(a0 bool, a1 func(rune) bool) ([]byte, error)
+	Method_UnreadRune func() error
+	Method_Width func() (int, bool)
+}
+
+func (p *GijitShadow_Proxy_ScanState) Read(a0 []byte) (int, error) {
+	return p.Method_Read(a0)
+}
+
+func (p *GijitShadow_Proxy_ScanState) ReadRune() (rune, int, error) {
+	return p.Method_ReadRune()
+}
+
+func (p *GijitShadow_Proxy_ScanState) SkipSpace()  {
+	p.Method_SkipSpace()
+}
+
+func (p *GijitShadow_Proxy_ScanState) Token(a0 bool, a1 func(rune) bool) ([]byte, error) {
+	return p.Method_Token(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_ScanState) UnreadRune() error {
+	return p.Method_UnreadRune()
+}
+
+func (p *GijitShadow_Proxy_ScanState) Width() (int, bool) {
+	return p.Method_Width()
+}
+
 
 func GijitShadow_InterfaceConvertTo2_Scanner(x interface{}) (y fmt.Scanner, b bool) {
 	y, b = x.(fmt.Scanner)
@@ -72,6 +128,14 @@ func GijitShadow_InterfaceConvertTo1_Scanner(x interface{}) fmt.Scanner {
 	return x.(fmt.Scanner)
 }
 
+type GijitShadow_Proxy_Scanner struct {
+	Method_Scan func(a0 fmt.ScanState, a1 rune) error
+}
+
+func (p *GijitShadow_Proxy_Scanner) Scan(a0 fmt.ScanState, a1 rune) error {
+	return p.Method_Scan(a0, a1)
+}
+
 
 func GijitShadow_InterfaceConvertTo2_State(x interface{}) (y fmt.State, b bool) {
 	y, b = x.(fmt.State)
@@ -82,6 +146,29 @@ func GijitShadow_InterfaceConvertTo1_State(x interface{}) fmt.State {
 	return x.(fmt.State)
 }
 
+type GijitShadow_Proxy_State struct {
+	Method_Flag func(a0 int) bool
+	Method_Precision func() (int, bool)
+	Method_Width func() (int, bool)
+	Method_Write func(a0 []byte) (int, error)
+}
+
+func (p *GijitShadow_Proxy_State) Flag(a0 int) bool {
+	return p.Method_Flag(a0)
+}
+
+func (p *GijitShadow_Proxy_State) Precision() (int, bool) {
+	return p.Method_Precision()
+}
+
+func (p *GijitShadow_Proxy_State) Width() (int, bool) {
+	return p.Method_Width()
+}
+
+func (p *GijitShadow_Proxy_State) Write(a0 []byte) (int, error) {
+	return p.Method_Write(a0)
+}
+
 
 func GijitShadow_InterfaceConvertTo2_Stringer(x interface{}) (y fmt.Stringer, b bool) {
 	y, b = x.(fmt.Stringer)
@@ -92,6 +179,14 @@ func GijitShadow_InterfaceConvertTo1_Stringer(x interface{}) fmt.Stringer {
 	return x.(fmt.Stringer)
 }
 
+type GijitShadow_Proxy_Stringer struct {
+	Method_String func() string
+}
+
+func (p *GijitShadow_Proxy_Stringer) String() string {
+	return p.Method_String()
+}
+
 
 
  func InitLua() string {
@@ -3,24 +3,41 @@ package shadow_blas
 import "gonum.org/v1/gonum/blas"
 
 var Pkg = make(map[string]interface{})
+var Proxy = make(map[string]interface{})
 
 func init() {
 	Pkg["Complex128"] = GijitShadow_InterfaceConvertTo2_Complex128
+	Proxy["Complex128"] = (*GijitShadow_Proxy_Complex128)(nil)
 	Pkg["Complex128Level1"] = GijitShadow_InterfaceConvertTo2_Complex128Level1
+	Proxy["Complex128Level1"] = (*GijitShadow_Proxy_Complex128Level1)(nil)
 	Pkg["Complex128Level2"] = GijitShadow_InterfaceConvertTo2_Complex128Level2
+	Proxy["Complex128Level2"] = (*GijitShadow_Proxy_Complex128Level2)(nil)
 	Pkg["Complex128Level3"] = GijitShadow_InterfaceConvertTo2_Complex128Level3
+	Proxy["Complex128Level3"] = (*GijitShadow_Proxy_Complex128Level3)(nil)
 	Pkg["Complex64"] = GijitShadow_InterfaceConvertTo2_Complex64
+	Proxy["Complex64"] = (*GijitShadow_Proxy_Complex64)(nil)
 	Pkg["Complex64Level1"] = GijitShadow_InterfaceConvertTo2_Complex64Level1
+	Proxy["Complex64Level1"] = (*GijitShadow_Proxy_Complex64Level1)(nil)
 	Pkg["Complex64Level2"] = GijitShadow_InterfaceConvertTo2_Complex64Level2
+	Proxy["Complex64Level2"] = (*GijitShadow_Proxy_Complex64Level2)(nil)
 	Pkg["Complex64Level3"] = GijitShadow_InterfaceConvertTo2_Complex64Level3
+	Proxy["Complex64Level3"] = (*GijitShadow_Proxy_Complex64Level3)(nil)
 	Pkg["Float32"] = GijitShadow_InterfaceConvertTo2_Float32
+	Proxy["Float32"] = (*GijitShadow_Proxy_Float32)(nil)
 	Pkg["Float32Level1"] = GijitShadow_InterfaceConvertTo2_Float32Level1
+	Proxy["Float32Level1"] = (*GijitShadow_Proxy_Float32Level1)(nil)
 	Pkg["Float32Level2"] = GijitShadow_InterfaceConvertTo2_Float32Level2
+	Proxy["Float32Level2"] = (*GijitShadow_Proxy_Float32Level2)(nil)
 	Pkg["Float32Level3"] = GijitShadow_InterfaceConvertTo2_Float32Level3
+	Proxy["Float32Level3"] = (*GijitShadow_Proxy_Float32Level3)(nil)
 	Pkg["Float64"] = GijitShadow_InterfaceConvertTo2_Float64
+	Proxy["Float64"] = (*GijitShadow_Proxy_Float64)(nil)
 	Pkg["Float64Level1"] = GijitShadow_InterfaceConvertTo2_Float64Level1
+	Proxy["Float64Level1"] = (*GijitShadow_Proxy_Float64Level1)(nil)
 	Pkg["Float64Level2"] = GijitShadow_InterfaceConvertTo2_Float64Level2
+	Proxy["Float64Level2"] = (*GijitShadow_Proxy_Float64Level2)(nil)
 	Pkg["Float64Level3"] = GijitShadow_InterfaceConvertTo2_Float64Level3
+	Proxy["Float64Level3"] = (*GijitShadow_Proxy_Float64Level3)(nil)
 
 }
 func GijitShadow_InterfaceConvertTo2_Complex128(x interface{}) (y blas.Complex128, b bool) {
@@ -32,6 +49,189 @@ func GijitShadow_InterfaceConvertTo1_Complex128(x interface{}) blas.Complex128 {
 	return x.(blas.Complex128)
 }
 
+type GijitShadow_Proxy_Complex128 struct {
+	Method_Dzasum func(a0 int, a1 []complex128, a2 int) float64
+	Method_Dznrm2 func(a0 int, a1 []complex128, a2 int) float64
+	Method_Izamax func(a0 int, a1 []complex128, a2 int) int
+	Method_Zaxpy  func(a0 int, a1 complex128, a2 []complex128, a3 int, a4 []complex128, a5 int)
+	Method_Zcopy  func(a0 int, a1 []complex128, a2 int, a3 []complex128, a4 int)
+	Method_Zdotc  func(a0 int, a1 []complex128, a2 int, a3 []complex128, a4 int) complex128
+	Method_Zdotu  func(a0 int, a1 []complex128, a2 int, a3 []complex128, a4 int) complex128
+	Method_Zdscal func(a0 int, a1 float64, a2 []complex128, a3 int)
+	Method_Zgbmv  func(a0 blas.Transpose, a1 int, a2 int, a3 int, a4 int, a5 complex128, a6 []complex128, a7 int, a8 []complex128, a9 int, a10 complex128, a11 []complex128, a12 int)
+	Method_Zgemm  func(a0 blas.Transpose, a1 blas.Transpose, a2 int, a3 int, a4 int, a5 complex128, a6 []complex128, a7 int, a8 []complex128, a9 int, a10 complex128, a11 []complex128, a12 int)
+	Method_Zgemv  func(a0 blas.Transpose, a1 int, a2 int, a3 complex128, a4 []complex128, a5 int, a6 []complex128, a7 int, a8 complex128, a9 []complex128, a10 int)
+	Method_Zgerc  func(a0 int, a1 int, a2 complex128, a3 []complex128, a4 int, a5 []complex128, a6 int, a7 []complex128, a8 int)
+	Method_Zgeru  func(a0 int, a1 int, a2 complex128, a3 []complex128, a4 int, a5 []complex128, a6 int, a7 []complex128, a8 int)
+	Method_Zhbmv  func(a0 blas.Uplo, a1 int, a2 int, a3 complex128, a4 []complex128, a5 int, a6 []complex128, a7 int, a8 complex128, a9 []complex128, a10 int)
+	Method_Zhemm  func(a0 blas.Side, a1 blas.Uplo, a2 int, a3 int, a4 complex128, a5 []complex128, a6 int, a7 []complex128, a8 int, a9 complex128, a10 []complex128, a11 int)
+	Method_Zhemv  func(a0 blas.Uplo, a1 int, a2 complex128, a3 []complex128, a4 int, a5 []complex128, a6 int, a7 complex128, a8 []complex128, a9 int)
+	Method_Zher   func(a0 blas.Uplo, a1 int, a2 float64, a3 []complex128, a4 int, a5 []complex128, a6 int)
+	Method_Zher2  func(a0 blas.Uplo, a1 int, a2 complex128, a3 []complex128, a4 int, a5 []complex128, a6 int, a7 []complex128, a8 int)
+	Method_Zher2k func(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 complex128, a5 []complex128, a6 int, a7 []complex128, a8 int, a9 float64, a10 []complex128, a11 int)
+	Method_Zherk  func(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 float64, a5 []complex128, a6 int, a7 float64, a8 []complex128, a9 int)
+	Method_Zhpmv  func(a0 blas.Uplo, a1 int, a2 complex128, a3 []complex128, a4 []complex128, a5 int, a6 complex128, a7 []complex128, a8 int)
+	Method_Zhpr   func(a0 blas.Uplo, a1 int, a2 float64, a3 []complex128, a4 int, a5 []complex128)
+	Method_Zhpr2  func(a0 blas.Uplo, a1 int, a2 complex128, a3 []complex128, a4 int, a5 []complex128, a6 int, a7 []complex128)
+	Method_Zscal  func(a0 int, a1 complex128, a2 []complex128, a3 int)
+	Method_Zswap  func(a0 int, a1 []complex128, a2 int, a3 []complex128, a4 int)
+	Method_Zsymm  func(a0 blas.Side, a1 blas.Uplo, a2 int, a3 int, a4 complex128, a5 []complex128, a6 int, a7 []complex128, a8 int, a9 complex128, a10 []complex128, a11 int)
+	Method_Zsyr2k func(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 complex128, a5 []complex128, a6 int, a7 []complex128, a8 int, a9 complex128, a10 []complex128, a11 int)
+	Method_Zsyrk  func(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 complex128, a5 []complex128, a6 int, a7 complex128, a8 []complex128, a9 int)
+	Method_Ztbmv  func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 int, a5 []complex128, a6 int, a7 []complex128, a8 int)
+	Method_Ztbsv  func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 int, a5 []complex128, a6 int, a7 []complex128, a8 int)
+	Method_Ztpmv  func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []complex128, a5 []complex128, a6 int)
+	Method_Ztpsv  func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []complex128, a5 []complex128, a6 int)
+	Method_Ztrmm  func(a0 blas.Side, a1 blas.Uplo, a2 blas.Transpose, a3 blas.Diag, a4 int, a5 int, a6 complex128, a7 []complex128, a8 int, a9 []complex128, a10 int)
+	Method_Ztrmv  func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []complex128, a5 int, a6 []complex128, a7 int)
+	Method_Ztrsm  func(a0 blas.Side, a1 blas.Uplo, a2 blas.Transpose, a3 blas.Diag, a4 int, a5 int, a6 complex128, a7 []complex128, a8 int, a9 []complex128, a10 int)
+	Method_Ztrsv  func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []complex128, a5 int, a6 []complex128, a7 int)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Dzasum(a0 int, a1 []complex128, a2 int) float64 {
+	return p.Method_Dzasum(a0, a1, a2)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Dznrm2(a0 int, a1 []complex128, a2 int) float64 {
+	return p.Method_Dznrm2(a0, a1, a2)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Izamax(a0 int, a1 []complex128, a2 int) int {
+	return p.Method_Izamax(a0, a1, a2)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Zaxpy(a0 int, a1 complex128, a2 []complex128, a3 int, a4 []complex128, a5 int) {
+	p.Method_Zaxpy(a0, a1, a2, a3, a4, a5)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Zcopy(a0 int, a1 []complex128, a2 int, a3 []complex128, a4 int) {
+	p.Method_Zcopy(a0, a1, a2, a3, a4)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Zdotc(a0 int, a1 []complex128, a2 int, a3 []complex128, a4 int) complex128 {
+	return p.Method_Zdotc(a0, a1, a2, a3, a4)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Zdotu(a0 int, a1 []complex128, a2 int, a3 []complex128, a4 int) complex128 {
+	return p.Method_Zdotu(a0, a1, a2, a3, a4)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Zdscal(a0 int, a1 float64, a2 []complex128, a3 int) {
+	p.Method_Zdscal(a0, a1, a2, a3)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Zgbmv(a0 blas.Transpose, a1 int, a2 int, a3 int, a4 int, a5 complex128, a6 []complex128, a7 int, a8 []complex128, a9 int, a10 complex128, a11 []complex128, a12 int) {
+	p.Method_Zgbmv(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Zgemm(a0 blas.Transpose, a1 blas.Transpose, a2 int, a3 int, a4 int, a5 complex128, a6 []complex128, a7 int, a8 []complex128, a9 int, a10 complex128, a11 []complex128, a12 int) {
+	p.Method_Zgemm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Zgemv(a0 blas.Transpose, a1 int, a2 int, a3 complex128, a4 []complex128, a5 int, a6 []complex128, a7 int, a8 complex128, a9 []complex128, a10 int) {
+	p.Method_Zgemv(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Zgerc(a0 int, a1 int, a2 complex128, a3 []complex128, a4 int, a5 []complex128, a6 int, a7 []complex128, a8 int) {
+	p.Method_Zgerc(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Zgeru(a0 int, a1 int, a2 complex128, a3 []complex128, a4 int, a5 []complex128, a6 int, a7 []complex128, a8 int) {
+	p.Method_Zgeru(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Zhbmv(a0 blas.Uplo, a1 int, a2 int, a3 complex128, a4 []complex128, a5 int, a6 []complex128, a7 int, a8 complex128, a9 []complex128, a10 int) {
+	p.Method_Zhbmv(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Zhemm(a0 blas.Side, a1 blas.Uplo, a2 int, a3 int, a4 complex128, a5 []complex128, a6 int, a7 []complex128, a8 int, a9 complex128, a10 []complex128, a11 int) {
+	p.Method_Zhemm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Zhemv(a0 blas.Uplo, a1 int, a2 complex128, a3 []complex128, a4 int, a5 []complex128, a6 int, a7 complex128, a8 []complex128, a9 int) {
+	p.Method_Zhemv(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Zher(a0 blas.Uplo, a1 int, a2 float64, a3 []complex128, a4 int, a5 []complex128, a6 int) {
+	p.Method_Zher(a0, a1, a2, a3, a4, a5, a6)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Zher2(a0 blas.Uplo, a1 int, a2 complex128, a3 []complex128, a4 int, a5 []complex128, a6 int, a7 []complex128, a8 int) {
+	p.Method_Zher2(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Zher2k(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 complex128, a5 []complex128, a6 int, a7 []complex128, a8 int, a9 float64, a10 []complex128, a11 int) {
+	p.Method_Zher2k(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Zherk(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 float64, a5 []complex128, a6 int, a7 float64, a8 []complex128, a9 int) {
+	p.Method_Zherk(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Zhpmv(a0 blas.Uplo, a1 int, a2 complex128, a3 []complex128, a4 []complex128, a5 int, a6 complex128, a7 []complex128, a8 int) {
+	p.Method_Zhpmv(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Zhpr(a0 blas.Uplo, a1 int, a2 float64, a3 []complex128, a4 int, a5 []complex128) {
+	p.Method_Zhpr(a0, a1, a2, a3, a4, a5)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Zhpr2(a0 blas.Uplo, a1 int, a2 complex128, a3 []complex128, a4 int, a5 []complex128, a6 int, a7 []complex128) {
+	p.Method_Zhpr2(a0, a1, a2, a3, a4, a5, a6, a7)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Zscal(a0 int, a1 complex128, a2 []complex128, a3 int) {
+	p.Method_Zscal(a0, a1, a2, a3)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Zswap(a0 int, a1 []complex128, a2 int, a3 []complex128, a4 int) {
+	p.Method_Zswap(a0, a1, a2, a3, a4)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Zsymm(a0 blas.Side, a1 blas.Uplo, a2 int, a3 int, a4 complex128, a5 []complex128, a6 int, a7 []complex128, a8 int, a9 complex128, a10 []complex128, a11 int) {
+	p.Method_Zsymm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Zsyr2k(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 complex128, a5 []complex128, a6 int, a7 []complex128, a8 int, a9 complex128, a10 []complex128, a11 int) {
+	p.Method_Zsyr2k(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Zsyrk(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 complex128, a5 []complex128, a6 int, a7 complex128, a8 []complex128, a9 int) {
+	p.Method_Zsyrk(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Ztbmv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 int, a5 []complex128, a6 int, a7 []complex128, a8 int) {
+	p.Method_Ztbmv(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Ztbsv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 int, a5 []complex128, a6 int, a7 []complex128, a8 int) {
+	p.Method_Ztbsv(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Ztpmv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []complex128, a5 []complex128, a6 int) {
+	p.Method_Ztpmv(a0, a1, a2, a3, a4, a5, a6)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Ztpsv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []complex128, a5 []complex128, a6 int) {
+	p.Method_Ztpsv(a0, a1, a2, a3, a4, a5, a6)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Ztrmm(a0 blas.Side, a1 blas.Uplo, a2 blas.Transpose, a3 blas.Diag, a4 int, a5 int, a6 complex128, a7 []complex128, a8 int, a9 []complex128, a10 int) {
+	p.Method_Ztrmm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Ztrmv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []complex128, a5 int, a6 []complex128, a7 int) {
+	p.Method_Ztrmv(a0, a1, a2, a3, a4, a5, a6, a7)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Ztrsm(a0 blas.Side, a1 blas.Uplo, a2 blas.Transpose, a3 blas.Diag, a4 int, a5 int, a6 complex128, a7 []complex128, a8 int, a9 []complex128, a10 int) {
+	p.Method_Ztrsm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
+}
+
+func (p *GijitShadow_Proxy_Complex128) Ztrsv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []complex128, a5 int, a6 []complex128, a7 int) {
+	p.Method_Ztrsv(a0, a1, a2, a3, a4, a5, a6, a7)
+}
+
 func GijitShadow_InterfaceConvertTo2_Complex128Level1(x interface{}) (y blas.Complex128Level1, b bool) {
 	y, b = x.(blas.Complex128Level1)
 	return
@@ -41,71 +241,815 @@ func GijitShadow_InterfaceConvertTo1_Complex128Level1(x interface{}) blas.Comple
 	return x.(blas.Complex128Level1)
 }
 
+type GijitShadow_Proxy_Complex128Level1 struct {
+	Method_Dzasum func(a0 int, a1 []complex128, a2 int) float64
+	Method_Dznrm2 func(a0 int, a1 []complex128, a2 int) float64
+	Method_Izamax func(a0 int, a1 []complex128, a2 int) int
+	Method_Zaxpy  func(a0 int, a1 complex128, a2 []complex128, a3 int, a4 []complex128, a5 int)
+	Method_Zcopy  func(a0 int, a1 []complex128, a2 int, a3 []complex128, a4 int)
+	Method_Zdotc  func(a0 int, a1 []complex128, a2 int, a3 []complex128, a4 int) complex128
+	Method_Zdotu  func(a0 int, a1 []complex128, a2 int, a3 []complex128, a4 int) complex128
+	Method_Zdscal func(a0 int, a1 float64, a2 []complex128, a3 int)
+	Method_Zscal  func(a0 int, a1 complex128, a2 []complex128, a3 int)
+	Method_Zswap  func(a0 int, a1 []complex128, a2 int, a3 []complex128, a4 int)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level1) Dzasum(a0 int, a1 []complex128, a2 int) float64 {
+	return p.Method_Dzasum(a0, a1, a2)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level1) Dznrm2(a0 int, a1 []complex128, a2 int) float64 {
+	return p.Method_Dznrm2(a0, a1, a2)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level1) Izamax(a0 int, a1 []complex128, a2 int) int {
+	return p.Method_Izamax(a0, a1, a2)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level1) Zaxpy(a0 int, a1 complex128, a2 []complex128, a3 int, a4 []complex128, a5 int) {
+	p.Method_Zaxpy(a0, a1, a2, a3, a4, a5)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level1) Zcopy(a0 int, a1 []complex128, a2 int, a3 []complex128, a4 int) {
+	p.Method_Zcopy(a0, a1, a2, a3, a4)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level1) Zdotc(a0 int, a1 []complex128, a2 int, a3 []complex128, a4 int) complex128 {
+	return p.Method_Zdotc(a0, a1, a2, a3, a4)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level1) Zdotu(a0 int, a1 []complex128, a2 int, a3 []complex128, a4 int) complex128 {
+	return p.Method_Zdotu(a0, a1, a2, a3, a4)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level1) Zdscal(a0 int, a1 float64, a2 []complex128, a3 int) {
+	p.Method_Zdscal(a0, a1, a2, a3)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level1) Zscal(a0 int, a1 complex128, a2 []complex128, a3 int) {
+	p.Method_Zscal(a0, a1, a2, a3)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level1) Zswap(a0 int, a1 []complex128, a2 int, a3 []complex128, a4 int) {
+	p.Method_Zswap(a0, a1, a2, a3, a4)
+}
+
 func GijitShadow_InterfaceConvertTo2_Complex128Level2(x interface{}) (y blas.Complex128Level2, b bool) {
 	y, b = x.(blas.Complex128Level2)
 	return
 }
 
-func GijitShadow_InterfaceConvertTo1_Complex128Level2(x interface{}) blas.Complex128Level2 {
-	return x.(blas.Complex128Level2)
+func GijitShadow_InterfaceConvertTo1_Complex128Level2(x interface{}) blas.Complex128Level2 {
+	return x.(blas.Complex128Level2)
+}
+
+type GijitShadow_Proxy_Complex128Level2 struct {
+	Method_Zgbmv func(a0 blas.Transpose, a1 int, a2 int, a3 int, a4 int, a5 complex128, a6 []complex128, a7 int, a8 []complex128, a9 int, a10 complex128, a11 []complex128, a12 int)
+	Method_Zgemv func(a0 blas.Transpose, a1 int, a2 int, a3 complex128, a4 []complex128, a5 int, a6 []complex128, a7 int, a8 complex128, a9 []complex128, a10 int)
+	Method_Zgerc func(a0 int, a1 int, a2 complex128, a3 []complex128, a4 int, a5 []complex128, a6 int, a7 []complex128, a8 int)
+	Method_Zgeru func(a0 int, a1 int, a2 complex128, a3 []complex128, a4 int, a5 []complex128, a6 int, a7 []complex128, a8 int)
+	Method_Zhbmv func(a0 blas.Uplo, a1 int, a2 int, a3 complex128, a4 []complex128, a5 int, a6 []complex128, a7 int, a8 complex128, a9 []complex128, a10 int)
+	Method_Zhemv func(a0 blas.Uplo, a1 int, a2 complex128, a3 []complex128, a4 int, a5 []complex128, a6 int, a7 complex128, a8 []complex128, a9 int)
+	Method_Zher  func(a0 blas.Uplo, a1 int, a2 float64, a3 []complex128, a4 int, a5 []complex128, a6 int)
+	Method_Zher2 func(a0 blas.Uplo, a1 int, a2 complex128, a3 []complex128, a4 int, a5 []complex128, a6 int, a7 []complex128, a8 int)
+	Method_Zhpmv func(a0 blas.Uplo, a1 int, a2 complex128, a3 []complex128, a4 []complex128, a5 int, a6 complex128, a7 []complex128, a8 int)
+	Method_Zhpr  func(a0 blas.Uplo, a1 int, a2 float64, a3 []complex128, a4 int, a5 []complex128)
+	Method_Zhpr2 func(a0 blas.Uplo, a1 int, a2 complex128, a3 []complex128, a4 int, a5 []complex128, a6 int, a7 []complex128)
+	Method_Ztbmv func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 int, a5 []complex128, a6 int, a7 []complex128, a8 int)
+	Method_Ztbsv func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 int, a5 []complex128, a6 int, a7 []complex128, a8 int)
+	Method_Ztpmv func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []complex128, a5 []complex128, a6 int)
+	Method_Ztpsv func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []complex128, a5 []complex128, a6 int)
+	Method_Ztrmv func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []complex128, a5 int, a6 []complex128, a7 int)
+	Method_Ztrsv func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []complex128, a5 int, a6 []complex128, a7 int)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level2) Zgbmv(a0 blas.Transpose, a1 int, a2 int, a3 int, a4 int, a5 complex128, a6 []complex128, a7 int, a8 []complex128, a9 int, a10 complex128, a11 []complex128, a12 int) {
+	p.Method_Zgbmv(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level2) Zgemv(a0 blas.Transpose, a1 int, a2 int, a3 complex128, a4 []complex128, a5 int, a6 []complex128, a7 int, a8 complex128, a9 []complex128, a10 int) {
+	p.Method_Zgemv(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level2) Zgerc(a0 int, a1 int, a2 complex128, a3 []complex128, a4 int, a5 []complex128, a6 int, a7 []complex128, a8 int) {
+	p.Method_Zgerc(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level2) Zgeru(a0 int, a1 int, a2 complex128, a3 []complex128, a4 int, a5 []complex128, a6 int, a7 []complex128, a8 int) {
+	p.Method_Zgeru(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level2) Zhbmv(a0 blas.Uplo, a1 int, a2 int, a3 complex128, a4 []complex128, a5 int, a6 []complex128, a7 int, a8 complex128, a9 []complex128, a10 int) {
+	p.Method_Zhbmv(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level2) Zhemv(a0 blas.Uplo, a1 int, a2 complex128, a3 []complex128, a4 int, a5 []complex128, a6 int, a7 complex128, a8 []complex128, a9 int) {
+	p.Method_Zhemv(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level2) Zher(a0 blas.Uplo, a1 int, a2 float64, a3 []complex128, a4 int, a5 []complex128, a6 int) {
+	p.Method_Zher(a0, a1, a2, a3, a4, a5, a6)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level2) Zher2(a0 blas.Uplo, a1 int, a2 complex128, a3 []complex128, a4 int, a5 []complex128, a6 int, a7 []complex128, a8 int) {
+	p.Method_Zher2(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level2) Zhpmv(a0 blas.Uplo, a1 int, a2 complex128, a3 []complex128, a4 []complex128, a5 int, a6 complex128, a7 []complex128, a8 int) {
+	p.Method_Zhpmv(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level2) Zhpr(a0 blas.Uplo, a1 int, a2 float64, a3 []complex128, a4 int, a5 []complex128) {
+	p.Method_Zhpr(a0, a1, a2, a3, a4, a5)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level2) Zhpr2(a0 blas.Uplo, a1 int, a2 complex128, a3 []complex128, a4 int, a5 []complex128, a6 int, a7 []complex128) {
+	p.Method_Zhpr2(a0, a1, a2, a3, a4, a5, a6, a7)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level2) Ztbmv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 int, a5 []complex128, a6 int, a7 []complex128, a8 int) {
+	p.Method_Ztbmv(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level2) Ztbsv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 int, a5 []complex128, a6 int, a7 []complex128, a8 int) {
+	p.Method_Ztbsv(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level2) Ztpmv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []complex128, a5 []complex128, a6 int) {
+	p.Method_Ztpmv(a0, a1, a2, a3, a4, a5, a6)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level2) Ztpsv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []complex128, a5 []complex128, a6 int) {
+	p.Method_Ztpsv(a0, a1, a2, a3, a4, a5, a6)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level2) Ztrmv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []complex128, a5 int, a6 []complex128, a7 int) {
+	p.Method_Ztrmv(a0, a1, a2, a3, a4, a5, a6, a7)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level2) Ztrsv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []complex128, a5 int, a6 []complex128, a7 int) {
+	p.Method_Ztrsv(a0, a1, a2, a3, a4, a5, a6, a7)
+}
+
+func GijitShadow_InterfaceConvertTo2_Complex128Level3(x interface{}) (y blas.Complex128Level3, b bool) {
+	y, b = x.(blas.Complex128Level3)
+	return
+}
+
+func GijitShadow_InterfaceConvertTo1_Complex128Level3(x interface{}) blas.Complex128Level3 {
+	return x.(blas.Complex128Level3)
+}
+
+type GijitShadow_Proxy_Complex128Level3 struct {
+	Method_Zgemm  func(a0 blas.Transpose, a1 blas.Transpose, a2 int, a3 int, a4 int, a5 complex128, a6 []complex128, a7 int, a8 []complex128, a9 int, a10 complex128, a11 []complex128, a12 int)
+	Method_Zhemm  func(a0 blas.Side, a1 blas.Uplo, a2 int, a3 int, a4 complex128, a5 []complex128, a6 int, a7 []complex128, a8 int, a9 complex128, a10 []complex128, a11 int)
+	Method_Zher2k func(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 complex128, a5 []complex128, a6 int, a7 []complex128, a8 int, a9 float64, a10 []complex128, a11 int)
+	Method_Zherk  func(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 float64, a5 []complex128, a6 int, a7 float64, a8 []complex128, a9 int)
+	Method_Zsymm  func(a0 blas.Side, a1 blas.Uplo, a2 int, a3 int, a4 complex128, a5 []complex128, a6 int, a7 []complex128, a8 int, a9 complex128, a10 []complex128, a11 int)
+	Method_Zsyr2k func(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 complex128, a5 []complex128, a6 int, a7 []complex128, a8 int, a9 complex128, a10 []complex128, a11 int)
+	Method_Zsyrk  func(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 complex128, a5 []complex128, a6 int, a7 complex128, a8 []complex128, a9 int)
+	Method_Ztrmm  func(a0 blas.Side, a1 blas.Uplo, a2 blas.Transpose, a3 blas.Diag, a4 int, a5 int, a6 complex128, a7 []complex128, a8 int, a9 []complex128, a10 int)
+	Method_Ztrsm  func(a0 blas.Side, a1 blas.Uplo, a2 blas.Transpose, a3 blas.Diag, a4 int, a5 int, a6 complex128, a7 []complex128, a8 int, a9 []complex128, a10 int)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level3) Zgemm(a0 blas.Transpose, a1 blas.Transpose, a2 int, a3 int, a4 int, a5 complex128, a6 []complex128, a7 int, a8 []complex128, a9 int, a10 complex128, a11 []complex128, a12 int) {
+	p.Method_Zgemm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level3) Zhemm(a0 blas.Side, a1 blas.Uplo, a2 int, a3 int, a4 complex128, a5 []complex128, a6 int, a7 []complex128, a8 int, a9 complex128, a10 []complex128, a11 int) {
+	p.Method_Zhemm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level3) Zher2k(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 complex128, a5 []complex128, a6 int, a7 []complex128, a8 int, a9 float64, a10 []complex128, a11 int) {
+	p.Method_Zher2k(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level3) Zherk(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 float64, a5 []complex128, a6 int, a7 float64, a8 []complex128, a9 int) {
+	p.Method_Zherk(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level3) Zsymm(a0 blas.Side, a1 blas.Uplo, a2 int, a3 int, a4 complex128, a5 []complex128, a6 int, a7 []complex128, a8 int, a9 complex128, a10 []complex128, a11 int) {
+	p.Method_Zsymm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level3) Zsyr2k(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 complex128, a5 []complex128, a6 int, a7 []complex128, a8 int, a9 complex128, a10 []complex128, a11 int) {
+	p.Method_Zsyr2k(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level3) Zsyrk(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 complex128, a5 []complex128, a6 int, a7 complex128, a8 []complex128, a9 int) {
+	p.Method_Zsyrk(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level3) Ztrmm(a0 blas.Side, a1 blas.Uplo, a2 blas.Transpose, a3 blas.Diag, a4 int, a5 int, a6 complex128, a7 []complex128, a8 int, a9 []complex128, a10 int) {
+	p.Method_Ztrmm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
+}
+
+func (p *GijitShadow_Proxy_Complex128Level3) Ztrsm(a0 blas.Side, a1 blas.Uplo, a2 blas.Transpose, a3 blas.Diag, a4 int, a5 int, a6 complex128, a7 []complex128, a8 int, a9 []complex128, a10 int) {
+	p.Method_Ztrsm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
+}
+
+func GijitShadow_InterfaceConvertTo2_Complex64(x interface{}) (y blas.Complex64, b bool) {
+	y, b = x.(blas.Complex64)
+	return
+}
+
+func GijitShadow_InterfaceConvertTo1_Complex64(x interface{}) blas.Complex64 {
+	return x.(blas.Complex64)
+}
+
+type GijitShadow_Proxy_Complex64 struct {
+	Method_Caxpy  func(a0 int, a1 complex64, a2 []complex64, a3 int, a4 []complex64, a5 int)
+	Method_Ccopy  func(a0 int, a1 []complex64, a2 int, a3 []complex64, a4 int)
+	Method_Cdotc  func(a0 int, a1 []complex64, a2 int, a3 []complex64, a4 int) complex64
+	Method_Cdotu  func(a0 int, a1 []complex64, a2 int, a3 []complex64, a4 int) complex64
+	Method_Cgbmv  func(a0 blas.Transpose, a1 int, a2 int, a3 int, a4 int, a5 complex64, a6 []complex64, a7 int, a8 []complex64, a9 int, a10 complex64, a11 []complex64, a12 int)
+	Method_Cgemm  func(a0 blas.Transpose, a1 blas.Transpose, a2 int, a3 int, a4 int, a5 complex64, a6 []complex64, a7 int, a8 []complex64, a9 int, a10 complex64, a11 []complex64, a12 int)
+	Method_Cgemv  func(a0 blas.Transpose, a1 int, a2 int, a3 complex64, a4 []complex64, a5 int, a6 []complex64, a7 int, a8 complex64, a9 []complex64, a10 int)
+	Method_Cgerc  func(a0 int, a1 int, a2 complex64, a3 []complex64, a4 int, a5 []complex64, a6 int, a7 []complex64, a8 int)
+	Method_Cgeru  func(a0 int, a1 int, a2 complex64, a3 []complex64, a4 int, a5 []complex64, a6 int, a7 []complex64, a8 int)
+	Method_Chbmv  func(a0 blas.Uplo, a1 int, a2 int, a3 complex64, a4 []complex64, a5 int, a6 []complex64, a7 int, a8 complex64, a9 []complex64, a10 int)
+	Method_Chemm  func(a0 blas.Side, a1 blas.Uplo, a2 int, a3 int, a4 complex64, a5 []complex64, a6 int, a7 []complex64, a8 int, a9 complex64, a10 []complex64, a11 int)
+	Method_Chemv  func(a0 blas.Uplo, a1 int, a2 complex64, a3 []complex64, a4 int, a5 []complex64, a6 int, a7 complex64, a8 []complex64, a9 int)
+	Method_Cher   func(a0 blas.Uplo, a1 int, a2 float32, a3 []complex64, a4 int, a5 []complex64, a6 int)
+	Method_Cher2  func(a0 blas.Uplo, a1 int, a2 complex64, a3 []complex64, a4 int, a5 []complex64, a6 int, a7 []complex64, a8 int)
+	Method_Cher2k func(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 complex64, a5 []complex64, a6 int, a7 []complex64, a8 int, a9 float32, a10 []complex64, a11 int)
+	Method_Cherk  func(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 float32, a5 []complex64, a6 int, a7 float32, a8 []complex64, a9 int)
+	Method_Chpmv  func(a0 blas.Uplo, a1 int, a2 complex64, a3 []complex64, a4 []complex64, a5 int, a6 complex64, a7 []complex64, a8 int)
+	Method_Chpr   func(a0 blas.Uplo, a1 int, a2 float32, a3 []complex64, a4 int, a5 []complex64)
+	Method_Chpr2  func(a0 blas.Uplo, a1 int, a2 complex64, a3 []complex64, a4 int, a5 []complex64, a6 int, a7 []complex64)
+	Method_Cscal  func(a0 int, a1 complex64, a2 []complex64, a3 int)
+	Method_Csscal func(a0 int, a1 float32, a2 []complex64, a3 int)
+	Method_Cswap  func(a0 int, a1 []complex64, a2 int, a3 []complex64, a4 int)
+	Method_Csymm  func(a0 blas.Side, a1 blas.Uplo, a2 int, a3 int, a4 complex64, a5 []complex64, a6 int, a7 []complex64, a8 int, a9 complex64, a10 []complex64, a11 int)
+	Method_Csyr2k func(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 complex64, a5 []complex64, a6 int, a7 []complex64, a8 int, a9 complex64, a10 []complex64, a11 int)
+	Method_Csyrk  func(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 complex64, a5 []complex64, a6 int, a7 complex64, a8 []complex64, a9 int)
+	Method_Ctbmv  func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 int, a5 []complex64, a6 int, a7 []complex64, a8 int)
+	Method_Ctbsv  func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 int, a5 []complex64, a6 int, a7 []complex64, a8 int)
+	Method_Ctpmv  func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []complex64, a5 []complex64, a6 int)
+	Method_Ctpsv  func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []complex64, a5 []complex64, a6 int)
+	Method_Ctrmm  func(a0 blas.Side, a1 blas.Uplo, a2 blas.Transpose, a3 blas.Diag, a4 int, a5 int, a6 complex64, a7 []complex64, a8 int, a9 []complex64, a10 int)
+	Method_Ctrmv  func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []complex64, a5 int, a6 []complex64, a7 int)
+	Method_Ctrsm  func(a0 blas.Side, a1 blas.Uplo, a2 blas.Transpose, a3 blas.Diag, a4 int, a5 int, a6 complex64, a7 []complex64, a8 int, a9 []complex64, a10 int)
+	Method_Ctrsv  func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []complex64, a5 int, a6 []complex64, a7 int)
+	Method_Icamax func(a0 int, a1 []complex64, a2 int) int
+	Method_Scasum func(a0 int, a1 []complex64, a2 int) float32
+	Method_Scnrm2 func(a0 int, a1 []complex64, a2 int) float32
+}
+
+func (p *GijitShadow_Proxy_Complex64) Caxpy(a0 int, a1 complex64, a2 []complex64, a3 int, a4 []complex64, a5 int) {
+	p.Method_Caxpy(a0, a1, a2, a3, a4, a5)
+}
+
+func (p *GijitShadow_Proxy_Complex64) Ccopy(a0 int, a1 []complex64, a2 int, a3 []complex64, a4 int) {
+	p.Method_Ccopy(a0, a1, a2, a3, a4)
+}
+
+func (p *GijitShadow_Proxy_Complex64) Cdotc(a0 int, a1 []complex64, a2 int, a3 []complex64, a4 int) complex64 {
+	return p.Method_Cdotc(a0, a1, a2, a3, a4)
+}
+
+func (p *GijitShadow_Proxy_Complex64) Cdotu(a0 int, a1 []complex64, a2 int, a3 []complex64, a4 int) complex64 {
+	return p.Method_Cdotu(a0, a1, a2, a3, a4)
+}
+
+func (p *GijitShadow_Proxy_Complex64) Cgbmv(a0 blas.Transpose, a1 int, a2 int, a3 int, a4 int, a5 complex64, a6 []complex64, a7 int, a8 []complex64, a9 int, a10 complex64, a11 []complex64, a12 int) {
+	p.Method_Cgbmv(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12)
+}
+
+func (p *GijitShadow_Proxy_Complex64) Cgemm(a0 blas.Transpose, a1 blas.Transpose, a2 int, a3 int, a4 int, a5 complex64, a6 []complex64, a7 int, a8 []complex64, a9 int, a10 complex64, a11 []complex64, a12 int) {
+	p.Method_Cgemm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12)
+}
+
+func (p *GijitShadow_Proxy_Complex64) Cgemv(a0 blas.Transpose, a1 int, a2 int, a3 complex64, a4 []complex64, a5 int, a6 []complex64, a7 int, a8 complex64, a9 []complex64, a10 int) {
+	p.Method_Cgemv(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
+}
+
+func (p *GijitShadow_Proxy_Complex64) Cgerc(a0 int, a1 int, a2 complex64, a3 []complex64, a4 int, a5 []complex64, a6 int, a7 []complex64, a8 int) {
+	p.Method_Cgerc(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Complex64) Cgeru(a0 int, a1 int, a2 complex64, a3 []complex64, a4 int, a5 []complex64, a6 int, a7 []complex64, a8 int) {
+	p.Method_Cgeru(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Complex64) Chbmv(a0 blas.Uplo, a1 int, a2 int, a3 complex64, a4 []complex64, a5 int, a6 []complex64, a7 int, a8 complex64, a9 []complex64, a10 int) {
+	p.Method_Chbmv(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
+}
+
+func (p *GijitShadow_Proxy_Complex64) Chemm(a0 blas.Side, a1 blas.Uplo, a2 int, a3 int, a4 complex64, a5 []complex64, a6 int, a7 []complex64, a8 int, a9 complex64, a10 []complex64, a11 int) {
+	p.Method_Chemm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11)
+}
+
+func (p *GijitShadow_Proxy_Complex64) Chemv(a0 blas.Uplo, a1 int, a2 complex64, a3 []complex64, a4 int, a5 []complex64, a6 int, a7 complex64, a8 []complex64, a9 int) {
+	p.Method_Chemv(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9)
+}
+
+func (p *GijitShadow_Proxy_Complex64) Cher(a0 blas.Uplo, a1 int, a2 float32, a3 []complex64, a4 int, a5 []complex64, a6 int) {
+	p.Method_Cher(a0, a1, a2, a3, a4, a5, a6)
+}
+
+func (p *GijitShadow_Proxy_Complex64) Cher2(a0 blas.Uplo, a1 int, a2 complex64, a3 []complex64, a4 int, a5 []complex64, a6 int, a7 []complex64, a8 int) {
+	p.Method_Cher2(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Complex64) Cher2k(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 complex64, a5 []complex64, a6 int, a7 []complex64, a8 int, a9 float32, a10 []complex64, a11 int) {
+	p.Method_Cher2k(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11)
+}
+
+func (p *GijitShadow_Proxy_Complex64) Cherk(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 float32, a5 []complex64, a6 int, a7 float32, a8 []complex64, a9 int) {
+	p.Method_Cherk(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9)
+}
+
+func (p *GijitShadow_Proxy_Complex64) Chpmv(a0 blas.Uplo, a1 int, a2 complex64, a3 []complex64, a4 []complex64, a5 int, a6 complex64, a7 []complex64, a8 int) {
+	p.Method_Chpmv(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Complex64) Chpr(a0 blas.Uplo, a1 int, a2 float32, a3 []complex64, a4 int, a5 []complex64) {
+	p.Method_Chpr(a0, a1, a2, a3, a4, a5)
+}
+
+func (p *GijitShadow_Proxy_Complex64) Chpr2(a0 blas.Uplo, a1 int, a2 complex64, a3 []complex64, a4 int, a5 []complex64, a6 int, a7 []complex64) {
+	p.Method_Chpr2(a0, a1, a2, a3, a4, a5, a6, a7)
+}
+
+func (p *GijitShadow_Proxy_Complex64) Cscal(a0 int, a1 complex64, a2 []complex64, a3 int) {
+	p.Method_Cscal(a0, a1, a2, a3)
+}
+
+func (p *GijitShadow_Proxy_Complex64) Csscal(a0 int, a1 float32, a2 []complex64, a3 int) {
+	p.Method_Csscal(a0, a1, a2, a3)
+}
+
+func (p *GijitShadow_Proxy_Complex64) Cswap(a0 int, a1 []complex64, a2 int, a3 []complex64, a4 int) {
+	p.Method_Cswap(a0, a1, a2, a3, a4)
+}
+
+func (p *GijitShadow_Proxy_Complex64) Csymm(a0 blas.Side, a1 blas.Uplo, a2 int, a3 int, a4 complex64, a5 []complex64, a6 int, a7 []complex64, a8 int, a9 complex64, a10 []complex64, a11 int) {
+	p.Method_Csymm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11)
+}
+
+func (p *GijitShadow_Proxy_Complex64) Csyr2k(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 complex64, a5 []complex64, a6 int, a7 []complex64, a8 int, a9 complex64, a10 []complex64, a11 int) {
+	p.Method_Csyr2k(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11)
+}
+
+func (p *GijitShadow_Proxy_Complex64) Csyrk(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 complex64, a5 []complex64, a6 int, a7 complex64, a8 []complex64, a9 int) {
+	p.Method_Csyrk(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9)
+}
+
+func (p *GijitShadow_Proxy_Complex64) Ctbmv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 int, a5 []complex64, a6 int, a7 []complex64, a8 int) {
+	p.Method_Ctbmv(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Complex64) Ctbsv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 int, a5 []complex64, a6 int, a7 []complex64, a8 int) {
+	p.Method_Ctbsv(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Complex64) Ctpmv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []complex64, a5 []complex64, a6 int) {
+	p.Method_Ctpmv(a0, a1, a2, a3, a4, a5, a6)
+}
+
+func (p *GijitShadow_Proxy_Complex64) Ctpsv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []complex64, a5 []complex64, a6 int) {
+	p.Method_Ctpsv(a0, a1, a2, a3, a4, a5, a6)
+}
+
+func (p *GijitShadow_Proxy_Complex64) Ctrmm(a0 blas.Side, a1 blas.Uplo, a2 blas.Transpose, a3 blas.Diag, a4 int, a5 int, a6 complex64, a7 []complex64, a8 int, a9 []complex64, a10 int) {
+	p.Method_Ctrmm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
+}
+
+func (p *GijitShadow_Proxy_Complex64) Ctrmv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []complex64, a5 int, a6 []complex64, a7 int) {
+	p.Method_Ctrmv(a0, a1, a2, a3, a4, a5, a6, a7)
+}
+
+func (p *GijitShadow_Proxy_Complex64) Ctrsm(a0 blas.Side, a1 blas.Uplo, a2 blas.Transpose, a3 blas.Diag, a4 int, a5 int, a6 complex64, a7 []complex64, a8 int, a9 []complex64, a10 int) {
+	p.Method_Ctrsm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
+}
+
+func (p *GijitShadow_Proxy_Complex64) Ctrsv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []complex64, a5 int, a6 []complex64, a7 int) {
+	p.Method_Ctrsv(a0, a1, a2, a3, a4, a5, a6, a7)
+}
+
+func (p *GijitShadow_Proxy_Complex64) Icamax(a0 int, a1 []complex64, a2 int) int {
+	return p.Method_Icamax(a0, a1, a2)
+}
+
+func (p *GijitShadow_Proxy_Complex64) Scasum(a0 int, a1 []complex64, a2 int) float32 {
+	return p.Method_Scasum(a0, a1, a2)
+}
+
+func (p *GijitShadow_Proxy_Complex64) Scnrm2(a0 int, a1 []complex64, a2 int) float32 {
+	return p.Method_Scnrm2(a0, a1, a2)
+}
+
+func GijitShadow_InterfaceConvertTo2_Complex64Level1(x interface{}) (y blas.Complex64Level1, b bool) {
+	y, b = x.(blas.Complex64Level1)
+	return
+}
+
+func GijitShadow_InterfaceConvertTo1_Complex64Level1(x interface{}) blas.Complex64Level1 {
+	return x.(blas.Complex64Level1)
+}
+
+type GijitShadow_Proxy_Complex64Level1 struct {
+	Method_Caxpy  func(a0 int, a1 complex64, a2 []complex64, a3 int, a4 []complex64, a5 int)
+	Method_Ccopy  func(a0 int, a1 []complex64, a2 int, a3 []complex64, a4 int)
+	Method_Cdotc  func(a0 int, a1 []complex64, a2 int, a3 []complex64, a4 int) complex64
+	Method_Cdotu  func(a0 int, a1 []complex64, a2 int, a3 []complex64, a4 int) complex64
+	Method_Cscal  func(a0 int, a1 complex64, a2 []complex64, a3 int)
+	Method_Csscal func(a0 int, a1 float32, a2 []complex64, a3 int)
+	Method_Cswap  func(a0 int, a1 []complex64, a2 int, a3 []complex64, a4 int)
+	Method_Icamax func(a0 int, a1 []complex64, a2 int) int
+	Method_Scasum func(a0 int, a1 []complex64, a2 int) float32
+	Method_Scnrm2 func(a0 int, a1 []complex64, a2 int) float32
+}
+
+func (p *GijitShadow_Proxy_Complex64Level1) Caxpy(a0 int, a1 complex64, a2 []complex64, a3 int, a4 []complex64, a5 int) {
+	p.Method_Caxpy(a0, a1, a2, a3, a4, a5)
+}
+
+func (p *GijitShadow_Proxy_Complex64Level1) Ccopy(a0 int, a1 []complex64, a2 int, a3 []complex64, a4 int) {
+	p.Method_Ccopy(a0, a1, a2, a3, a4)
+}
+
+func (p *GijitShadow_Proxy_Complex64Level1) Cdotc(a0 int, a1 []complex64, a2 int, a3 []complex64, a4 int) complex64 {
+	return p.Method_Cdotc(a0, a1, a2, a3, a4)
+}
+
+func (p *GijitShadow_Proxy_Complex64Level1) Cdotu(a0 int, a1 []complex64, a2 int, a3 []complex64, a4 int) complex64 {
+	return p.Method_Cdotu(a0, a1, a2, a3, a4)
+}
+
+func (p *GijitShadow_Proxy_Complex64Level1) Cscal(a0 int, a1 complex64, a2 []complex64, a3 int) {
+	p.Method_Cscal(a0, a1, a2, a3)
+}
+
+func (p *GijitShadow_Proxy_Complex64Level1) Csscal(a0 int, a1 float32, a2 []complex64, a3 int) {
+	p.Method_Csscal(a0, a1, a2, a3)
+}
+
+func (p *GijitShadow_Proxy_Complex64Level1) Cswap(a0 int, a1 []complex64, a2 int, a3 []complex64, a4 int) {
+	p.Method_Cswap(a0, a1, a2, a3, a4)
+}
+
+func (p *GijitShadow_Proxy_Complex64Level1) Icamax(a0 int, a1 []complex64, a2 int) int {
+	return p.Method_Icamax(a0, a1, a2)
+}
+
+func (p *GijitShadow_Proxy_Complex64Level1) Scasum(a0 int, a1 []complex64, a2 int) float32 {
+	return p.Method_Scasum(a0, a1, a2)
+}
+
+func (p *GijitShadow_Proxy_Complex64Level1) Scnrm2(a0 int, a1 []complex64, a2 int) float32 {
+	return p.Method_Scnrm2(a0, a1, a2)
+}
+
+func GijitShadow_InterfaceConvertTo2_Complex64Level2(x interface{}) (y blas.Complex64Level2, b bool) {
+	y, b = x.(blas.Complex64Level2)
+	return
+}
+
+func GijitShadow_InterfaceConvertTo1_Complex64Level2(x interface{}) blas.Complex64Level2 {
+	return x.(blas.Complex64Level2)
+}
+
+type GijitShadow_Proxy_Complex64Level2 struct {
+	Method_Cgbmv func(a0 blas.Transpose, a1 int, a2 int, a3 int, a4 int, a5 complex64, a6 []complex64, a7 int, a8 []complex64, a9 int, a10 complex64, a11 []complex64, a12 int)
+	Method_Cgemv func(a0 blas.Transpose, a1 int, a2 int, a3 complex64, a4 []complex64, a5 int, a6 []complex64, a7 int, a8 complex64, a9 []complex64, a10 int)
+	Method_Cgerc func(a0 int, a1 int, a2 complex64, a3 []complex64, a4 int, a5 []complex64, a6 int, a7 []complex64, a8 int)
+	Method_Cgeru func(a0 int, a1 int, a2 complex64, a3 []complex64, a4 int, a5 []complex64, a6 int, a7 []complex64, a8 int)
+	Method_Chbmv func(a0 blas.Uplo, a1 int, a2 int, a3 complex64, a4 []complex64, a5 int, a6 []complex64, a7 int, a8 complex64, a9 []complex64, a10 int)
+	Method_Chemv func(a0 blas.Uplo, a1 int, a2 complex64, a3 []complex64, a4 int, a5 []complex64, a6 int, a7 complex64, a8 []complex64, a9 int)
+	Method_Cher  func(a0 blas.Uplo, a1 int, a2 float32, a3 []complex64, a4 int, a5 []complex64, a6 int)
+	Method_Cher2 func(a0 blas.Uplo, a1 int, a2 complex64, a3 []complex64, a4 int, a5 []complex64, a6 int, a7 []complex64, a8 int)
+	Method_Chpmv func(a0 blas.Uplo, a1 int, a2 complex64, a3 []complex64, a4 []complex64, a5 int, a6 complex64, a7 []complex64, a8 int)
+	Method_Chpr  func(a0 blas.Uplo, a1 int, a2 float32, a3 []complex64, a4 int, a5 []complex64)
+	Method_Chpr2 func(a0 blas.Uplo, a1 int, a2 complex64, a3 []complex64, a4 int, a5 []complex64, a6 int, a7 []complex64)
+	Method_Ctbmv func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 int, a5 []complex64, a6 int, a7 []complex64, a8 int)
+	Method_Ctbsv func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 int, a5 []complex64, a6 int, a7 []complex64, a8 int)
+	Method_Ctpmv func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []complex64, a5 []complex64, a6 int)
+	Method_Ctpsv func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []complex64, a5 []complex64, a6 int)
+	Method_Ctrmv func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []complex64, a5 int, a6 []complex64, a7 int)
+	Method_Ctrsv func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []complex64, a5 int, a6 []complex64, a7 int)
+}
+
+func (p *GijitShadow_Proxy_Complex64Level2) Cgbmv(a0 blas.Transpose, a1 int, a2 int, a3 int, a4 int, a5 complex64, a6 []complex64, a7 int, a8 []complex64, a9 int, a10 complex64, a11 []complex64, a12 int) {
+	p.Method_Cgbmv(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12)
+}
+
+func (p *GijitShadow_Proxy_Complex64Level2) Cgemv(a0 blas.Transpose, a1 int, a2 int, a3 complex64, a4 []complex64, a5 int, a6 []complex64, a7 int, a8 complex64, a9 []complex64, a10 int) {
+	p.Method_Cgemv(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
+}
+
+func (p *GijitShadow_Proxy_Complex64Level2) Cgerc(a0 int, a1 int, a2 complex64, a3 []complex64, a4 int, a5 []complex64, a6 int, a7 []complex64, a8 int) {
+	p.Method_Cgerc(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Complex64Level2) Cgeru(a0 int, a1 int, a2 complex64, a3 []complex64, a4 int, a5 []complex64, a6 int, a7 []complex64, a8 int) {
+	p.Method_Cgeru(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Complex64Level2) Chbmv(a0 blas.Uplo, a1 int, a2 int, a3 complex64, a4 []complex64, a5 int, a6 []complex64, a7 int, a8 complex64, a9 []complex64, a10 int) {
+	p.Method_Chbmv(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
+}
+
+func (p *GijitShadow_Proxy_Complex64Level2) Chemv(a0 blas.Uplo, a1 int, a2 complex64, a3 []complex64, a4 int, a5 []complex64, a6 int, a7 complex64, a8 []complex64, a9 int) {
+	p.Method_Chemv(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9)
+}
+
+func (p *GijitShadow_Proxy_Complex64Level2) Cher(a0 blas.Uplo, a1 int, a2 float32, a3 []complex64, a4 int, a5 []complex64, a6 int) {
+	p.Method_Cher(a0, a1, a2, a3, a4, a5, a6)
+}
+
+func (p *GijitShadow_Proxy_Complex64Level2) Cher2(a0 blas.Uplo, a1 int, a2 complex64, a3 []complex64, a4 int, a5 []complex64, a6 int, a7 []complex64, a8 int) {
+	p.Method_Cher2(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Complex64Level2) Chpmv(a0 blas.Uplo, a1 int, a2 complex64, a3 []complex64, a4 []complex64, a5 int, a6 complex64, a7 []complex64, a8 int) {
+	p.Method_Chpmv(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Complex64Level2) Chpr(a0 blas.Uplo, a1 int, a2 float32, a3 []complex64, a4 int, a5 []complex64) {
+	p.Method_Chpr(a0, a1, a2, a3, a4, a5)
+}
+
+func (p *GijitShadow_Proxy_Complex64Level2) Chpr2(a0 blas.Uplo, a1 int, a2 complex64, a3 []complex64, a4 int, a5 []complex64, a6 int, a7 []complex64) {
+	p.Method_Chpr2(a0, a1, a2, a3, a4, a5, a6, a7)
+}
+
+func (p *GijitShadow_Proxy_Complex64Level2) Ctbmv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 int, a5 []complex64, a6 int, a7 []complex64, a8 int) {
+	p.Method_Ctbmv(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Complex64Level2) Ctbsv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 int, a5 []complex64, a6 int, a7 []complex64, a8 int) {
+	p.Method_Ctbsv(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Complex64Level2) Ctpmv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []complex64, a5 []complex64, a6 int) {
+	p.Method_Ctpmv(a0, a1, a2, a3, a4, a5, a6)
+}
+
+func (p *GijitShadow_Proxy_Complex64Level2) Ctpsv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []complex64, a5 []complex64, a6 int) {
+	p.Method_Ctpsv(a0, a1, a2, a3, a4, a5, a6)
+}
+
+func (p *GijitShadow_Proxy_Complex64Level2) Ctrmv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []complex64, a5 int, a6 []complex64, a7 int) {
+	p.Method_Ctrmv(a0, a1, a2, a3, a4, a5, a6, a7)
+}
+
+func (p *GijitShadow_Proxy_Complex64Level2) Ctrsv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []complex64, a5 int, a6 []complex64, a7 int) {
+	p.Method_Ctrsv(a0, a1, a2, a3, a4, a5, a6, a7)
+}
+
+func GijitShadow_InterfaceConvertTo2_Complex64Level3(x interface{}) (y blas.Complex64Level3, b bool) {
+	y, b = x.(blas.Complex64Level3)
+	return
+}
+
+func GijitShadow_InterfaceConvertTo1_Complex64Level3(x interface{}) blas.Complex64Level3 {
+	return x.(blas.Complex64Level3)
+}
+
+type GijitShadow_Proxy_Complex64Level3 struct {
+	Method_Cgemm  func(a0 blas.Transpose, a1 blas.Transpose, a2 int, a3 int, a4 int, a5 complex64, a6 []complex64, a7 int, a8 []complex64, a9 int, a10 complex64, a11 []complex64, a12 int)
+	Method_Chemm  func(a0 blas.Side, a1 blas.Uplo, a2 int, a3 int, a4 complex64, a5 []complex64, a6 int, a7 []complex64, a8 int, a9 complex64, a10 []complex64, a11 int)
+	Method_Cher2k func(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 complex64, a5 []complex64, a6 int, a7 []complex64, a8 int, a9 float32, a10 []complex64, a11 int)
+	Method_Cherk  func(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 float32, a5 []complex64, a6 int, a7 float32, a8 []complex64, a9 int)
+	Method_Csymm  func(a0 blas.Side, a1 blas.Uplo, a2 int, a3 int, a4 complex64, a5 []complex64, a6 int, a7 []complex64, a8 int, a9 complex64, a10 []complex64, a11 int)
+	Method_Csyr2k func(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 complex64, a5 []complex64, a6 int, a7 []complex64, a8 int, a9 complex64, a10 []complex64, a11 int)
+	Method_Csyrk  func(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 complex64, a5 []complex64, a6 int, a7 complex64, a8 []complex64, a9 int)
+	Method_Ctrmm  func(a0 blas.Side, a1 blas.Uplo, a2 blas.Transpose, a3 blas.Diag, a4 int, a5 int, a6 complex64, a7 []complex64, a8 int, a9 []complex64, a10 int)
+	Method_Ctrsm  func(a0 blas.Side, a1 blas.Uplo, a2 blas.Transpose, a3 blas.Diag, a4 int, a5 int, a6 complex64, a7 []complex64, a8 int, a9 []complex64, a10 int)
+}
+
+func (p *GijitShadow_Proxy_Complex64Level3) Cgemm(a0 blas.Transpose, a1 blas.Transpose, a2 int, a3 int, a4 int, a5 complex64, a6 []complex64, a7 int, a8 []complex64, a9 int, a10 complex64, a11 []complex64, a12 int) {
+	p.Method_Cgemm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12)
+}
+
+func (p *GijitShadow_Proxy_Complex64Level3) Chemm(a0 blas.Side, a1 blas.Uplo, a2 int, a3 int, a4 complex64, a5 []complex64, a6 int, a7 []complex64, a8 int, a9 complex64, a10 []complex64, a11 int) {
+	p.Method_Chemm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11)
+}
+
+func (p *GijitShadow_Proxy_Complex64Level3) Cher2k(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 complex64, a5 []complex64, a6 int, a7 []complex64, a8 int, a9 float32, a10 []complex64, a11 int) {
+	p.Method_Cher2k(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11)
+}
+
+func (p *GijitShadow_Proxy_Complex64Level3) Cherk(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 float32, a5 []complex64, a6 int, a7 float32, a8 []complex64, a9 int) {
+	p.Method_Cherk(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9)
+}
+
+func (p *GijitShadow_Proxy_Complex64Level3) Csymm(a0 blas.Side, a1 blas.Uplo, a2 int, a3 int, a4 complex64, a5 []complex64, a6 int, a7 []complex64, a8 int, a9 complex64, a10 []complex64, a11 int) {
+	p.Method_Csymm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11)
+}
+
+func (p *GijitShadow_Proxy_Complex64Level3) Csyr2k(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 complex64, a5 []complex64, a6 int, a7 []complex64, a8 int, a9 complex64, a10 []complex64, a11 int) {
+	p.Method_Csyr2k(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11)
+}
+
+func (p *GijitShadow_Proxy_Complex64Level3) Csyrk(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 complex64, a5 []complex64, a6 int, a7 complex64, a8 []complex64, a9 int) {
+	p.Method_Csyrk(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9)
+}
+
+func (p *GijitShadow_Proxy_Complex64Level3) Ctrmm(a0 blas.Side, a1 blas.Uplo, a2 blas.Transpose, a3 blas.Diag, a4 int, a5 int, a6 complex64, a7 []complex64, a8 int, a9 []complex64, a10 int) {
+	p.Method_Ctrmm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
+}
+
+func (p *GijitShadow_Proxy_Complex64Level3) Ctrsm(a0 blas.Side, a1 blas.Uplo, a2 blas.Transpose, a3 blas.Diag, a4 int, a5 int, a6 complex64, a7 []complex64, a8 int, a9 []complex64, a10 int) {
+	p.Method_Ctrsm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
+}
+
+func GijitShadow_NewStruct_DrotmParams() *blas.DrotmParams {
+	return &blas.DrotmParams{}
+}
+
+func GijitShadow_InterfaceConvertTo2_Float32(x interface{}) (y blas.Float32, b bool) {
+	y, b = x.(blas.Float32)
+	return
+}
+
+func GijitShadow_InterfaceConvertTo1_Float32(x interface{}) blas.Float32 {
+	return x.(blas.Float32)
+}
+
+type GijitShadow_Proxy_Float32 struct {
+	Method_Dsdot  func(a0 int, a1 []float32, a2 int, a3 []float32, a4 int) float64
+	Method_Isamax func(a0 int, a1 []float32, a2 int) int
+	Method_Sasum  func(a0 int, a1 []float32, a2 int) float32
+	Method_Saxpy  func(a0 int, a1 float32, a2 []float32, a3 int, a4 []float32, a5 int)
+	Method_Scopy  func(a0 int, a1 []float32, a2 int, a3 []float32, a4 int)
+	Method_Sdot   func(a0 int, a1 []float32, a2 int, a3 []float32, a4 int) float32
+	Method_Sdsdot func(a0 int, a1 float32, a2 []float32, a3 int, a4 []float32, a5 int) float32
+	Method_Sgbmv  func(a0 blas.Transpose, a1 int, a2 int, a3 int, a4 int, a5 float32, a6 []float32, a7 int, a8 []float32, a9 int, a10 float32, a11 []float32, a12 int)
+	Method_Sgemm  func(a0 blas.Transpose, a1 blas.Transpose, a2 int, a3 int, a4 int, a5 float32, a6 []float32, a7 int, a8 []float32, a9 int, a10 float32, a11 []float32, a12 int)
+	Method_Sgemv  func(a0 blas.Transpose, a1 int, a2 int, a3 float32, a4 []float32, a5 int, a6 []float32, a7 int, a8 float32, a9 []float32, a10 int)
+	Method_Sger   func(a0 int, a1 int, a2 float32, a3 []float32, a4 int, a5 []float32, a6 int, a7 []float32, a8 int)
+	Method_Snrm2  func(a0 int, a1 []float32, a2 int) float32
+	Method_Srot   func(a0 int, a1 []float32, a2 int, a3 []float32, a4 int, a5 float32, a6 float32)
+	Method_Srotg  func(a0 float32, a1 float32) (float32, float32, float32, float32)
+	Method_Srotm  func(a0 int, a1 []float32, a2 int, a3 []float32, a4 int, a5 blas.SrotmParams)
+	Method_Srotmg func(a0 float32, a1 float32, a2 float32, a3 float32) (blas.SrotmParams, float32, float32, float32)
+	Method_Ssbmv  func(a0 blas.Uplo, a1 int, a2 int, a3 float32, a4 []float32, a5 int, a6 []float32, a7 int, a8 float32, a9 []float32, a10 int)
+	Method_Sscal  func(a0 int, a1 float32, a2 []float32, a3 int)
+	Method_Sspmv  func(a0 blas.Uplo, a1 int, a2 float32, a3 []float32, a4 []float32, a5 int, a6 float32, a7 []float32, a8 int)
+	Method_Sspr   func(a0 blas.Uplo, a1 int, a2 float32, a3 []float32, a4 int, a5 []float32)
+	Method_Sspr2  func(a0 blas.Uplo, a1 int, a2 float32, a3 []float32, a4 int, a5 []float32, a6 int, a7 []float32)
+	Method_Sswap  func(a0 int, a1 []float32, a2 int, a3 []float32, a4 int)
+	Method_Ssymm  func(a0 blas.Side, a1 blas.Uplo, a2 int, a3 int, a4 float32, a5 []float32, a6 int, a7 []float32, a8 int, a9 float32, a10 []float32, a11 int)
+	Method_Ssymv  func(a0 blas.Uplo, a1 int, a2 float32, a3 []float32, a4 int, a5 []float32, a6 int, a7 float32, a8 []float32, a9 int)
+	Method_Ssyr   func(a0 blas.Uplo, a1 int, a2 float32, a3 []float32, a4 int, a5 []float32, a6 int)
+	Method_Ssyr2  func(a0 blas.Uplo, a1 int, a2 float32, a3 []float32, a4 int, a5 []float32, a6 int, a7 []float32, a8 int)
+	Method_Ssyr2k func(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 float32, a5 []float32, a6 int, a7 []float32, a8 int, a9 float32, a10 []float32, a11 int)
+	Method_Ssyrk  func(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 float32, a5 []float32, a6 int, a7 float32, a8 []float32, a9 int)
+	Method_Stbmv  func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 int, a5 []float32, a6 int, a7 []float32, a8 int)
+	Method_Stbsv  func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 int, a5 []float32, a6 int, a7 []float32, a8 int)
+	Method_Stpmv  func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []float32, a5 []float32, a6 int)
+	Method_Stpsv  func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []float32, a5 []float32, a6 int)
+	Method_Strmm  func(a0 blas.Side, a1 blas.Uplo, a2 blas.Transpose, a3 blas.Diag, a4 int, a5 int, a6 float32, a7 []float32, a8 int, a9 []float32, a10 int)
+	Method_Strmv  func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []float32, a5 int, a6 []float32, a7 int)
+	Method_Strsm  func(a0 blas.Side, a1 blas.Uplo, a2 blas.Transpose, a3 blas.Diag, a4 int, a5 int, a6 float32, a7 []float32, a8 int, a9 []float32, a10 int)
+	Method_Strsv  func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []float32, a5 int, a6 []float32, a7 int)
+}
+
+func (p *GijitShadow_Proxy_Float32) Dsdot(a0 int, a1 []float32, a2 int, a3 []float32, a4 int) float64 {
+	return p.Method_Dsdot(a0, a1, a2, a3, a4)
+}
+
+func (p *GijitShadow_Proxy_Float32) Isamax(a0 int, a1 []float32, a2 int) int {
+	return p.Method_Isamax(a0, a1, a2)
+}
+
+func (p *GijitShadow_Proxy_Float32) Sasum(a0 int, a1 []float32, a2 int) float32 {
+	return p.Method_Sasum(a0, a1, a2)
+}
+
+func (p *GijitShadow_Proxy_Float32) Saxpy(a0 int, a1 float32, a2 []float32, a3 int, a4 []float32, a5 int) {
+	p.Method_Saxpy(a0, a1, a2, a3, a4, a5)
+}
+
+func (p *GijitShadow_Proxy_Float32) Scopy(a0 int, a1 []float32, a2 int, a3 []float32, a4 int) {
+	p.Method_Scopy(a0, a1, a2, a3, a4)
+}
+
+func (p *GijitShadow_Proxy_Float32) Sdot(a0 int, a1 []float32, a2 int, a3 []float32, a4 int) float32 {
+	return p.Method_Sdot(a0, a1, a2, a3, a4)
+}
+
+func (p *GijitShadow_Proxy_Float32) Sdsdot(a0 int, a1 float32, a2 []float32, a3 int, a4 []float32, a5 int) float32 {
+	return p.Method_Sdsdot(a0, a1, a2, a3, a4, a5)
+}
+
+func (p *GijitShadow_Proxy_Float32) Sgbmv(a0 blas.Transpose, a1 int, a2 int, a3 int, a4 int, a5 float32, a6 []float32, a7 int, a8 []float32, a9 int, a10 float32, a11 []float32, a12 int) {
+	p.Method_Sgbmv(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12)
+}
+
+func (p *GijitShadow_Proxy_Float32) Sgemm(a0 blas.Transpose, a1 blas.Transpose, a2 int, a3 int, a4 int, a5 float32, a6 []float32, a7 int, a8 []float32, a9 int, a10 float32, a11 []float32, a12 int) {
+	p.Method_Sgemm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12)
+}
+
+func (p *GijitShadow_Proxy_Float32) Sgemv(a0 blas.Transpose, a1 int, a2 int, a3 float32, a4 []float32, a5 int, a6 []float32, a7 int, a8 float32, a9 []float32, a10 int) {
+	p.Method_Sgemv(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
+}
+
+func (p *GijitShadow_Proxy_Float32) Sger(a0 int, a1 int, a2 float32, a3 []float32, a4 int, a5 []float32, a6 int, a7 []float32, a8 int) {
+	p.Method_Sger(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Float32) Snrm2(a0 int, a1 []float32, a2 int) float32 {
+	return p.Method_Snrm2(a0, a1, a2)
+}
+
+func (p *GijitShadow_Proxy_Float32) Srot(a0 int, a1 []float32, a2 int, a3 []float32, a4 int, a5 float32, a6 float32) {
+	p.Method_Srot(a0, a1, a2, a3, a4, a5, a6)
+}
+
+func (p *GijitShadow_Proxy_Float32) Srotg(a0 float32, a1 float32) (float32, float32, float32, float32) {
+	return p.Method_Srotg(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_Float32) Srotm(a0 int, a1 []float32, a2 int, a3 []float32, a4 int, a5 blas.SrotmParams) {
+	p.Method_Srotm(a0, a1, a2, a3, a4, a5)
+}
+
+func (p *GijitShadow_Proxy_Float32) Srotmg(a0 float32, a1 float32, a2 float32, a3 float32) (blas.SrotmParams, float32, float32, float32) {
+	return p.Method_Srotmg(a0, a1, a2, a3)
+}
+
+func (p *GijitShadow_Proxy_Float32) Ssbmv(a0 blas.Uplo, a1 int, a2 int, a3 float32, a4 []float32, a5 int, a6 []float32, a7 int, a8 float32, a9 []float32, a10 int) {
+	p.Method_Ssbmv(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
+}
+
+func (p *GijitShadow_Proxy_Float32) Sscal(a0 int, a1 float32, a2 []float32, a3 int) {
+	p.Method_Sscal(a0, a1, a2, a3)
+}
+
+func (p *GijitShadow_Proxy_Float32) Sspmv(a0 blas.Uplo, a1 int, a2 float32, a3 []float32, a4 []float32, a5 int, a6 float32, a7 []float32, a8 int) {
+	p.Method_Sspmv(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Float32) Sspr(a0 blas.Uplo, a1 int, a2 float32, a3 []float32, a4 int, a5 []float32) {
+	p.Method_Sspr(a0, a1, a2, a3, a4, a5)
+}
+
+func (p *GijitShadow_Proxy_Float32) Sspr2(a0 blas.Uplo, a1 int, a2 float32, a3 []float32, a4 int, a5 []float32, a6 int, a7 []float32) {
+	p.Method_Sspr2(a0, a1, a2, a3, a4, a5, a6, a7)
+}
+
+func (p *GijitShadow_Proxy_Float32) Sswap(a0 int, a1 []float32, a2 int, a3 []float32, a4 int) {
+	p.Method_Sswap(a0, a1, a2, a3, a4)
+}
+
+func (p *GijitShadow_Proxy_Float32) Ssymm(a0 blas.Side, a1 blas.Uplo, a2 int, a3 int, a4 float32, a5 []float32, a6 int, a7 []float32, a8 int, a9 float32, a10 []float32, a11 int) {
+	p.Method_Ssymm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11)
 }
 
-func GijitShadow_InterfaceConvertTo2_Complex128Level3(x interface{}) (y blas.Complex128Level3, b bool) {
-	y, b = x.(blas.Complex128Level3)
-	return
+func (p *GijitShadow_Proxy_Float32) Ssymv(a0 blas.Uplo, a1 int, a2 float32, a3 []float32, a4 int, a5 []float32, a6 int, a7 float32, a8 []float32, a9 int) {
+	p.Method_Ssymv(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9)
 }
 
-func GijitShadow_InterfaceConvertTo1_Complex128Level3(x interface{}) blas.Complex128Level3 {
-	return x.(blas.Complex128Level3)
+func (p *GijitShadow_Proxy_Float32) Ssyr(a0 blas.Uplo, a1 int, a2 float32, a3 []float32, a4 int, a5 []float32, a6 int) {
+	p.Method_Ssyr(a0, a1, a2, a3, a4, a5, a6)
 }
 
-func GijitShadow_InterfaceConvertTo2_Complex64(x interface{}) (y blas.Complex64, b bool) {
-	y, b = x.(blas.Complex64)
-	return
+func (p *GijitShadow_Proxy_Float32) Ssyr2(a0 blas.Uplo, a1 int, a2 float32, a3 []float32, a4 int, a5 []float32, a6 int, a7 []float32, a8 int) {
+	p.Method_Ssyr2(a0, a1, a2, a3, a4, a5, a6, a7, a8)
 }
 
-func GijitShadow_InterfaceConvertTo1_Complex64(x interface{}) blas.Complex64 {
-	return x.(blas.Complex64)
+func (p *GijitShadow_Proxy_Float32) Ssyr2k(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 float32, a5 []float32, a6 int, a7 []float32, a8 int, a9 float32, a10 []float32, a11 int) {
+	p.Method_Ssyr2k(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11)
 }
 
-func GijitShadow_InterfaceConvertTo2_Complex64Level1(x interface{}) (y blas.Complex64Level1, b bool) {
-	y, b = x.(blas.Complex64Level1)
-	return
+func (p *GijitShadow_Proxy_Float32) Ssyrk(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 float32, a5 []float32, a6 int, a7 float32, a8 []float32, a9 int) {
+	p.Method_Ssyrk(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9)
 }
 
-func GijitShadow_InterfaceConvertTo1_Complex64Level1(x interface{}) blas.Complex64Level1 {
-	return x.(blas.Complex64Level1)
+func (p *GijitShadow_Proxy_Float32) Stbmv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 int, a5 []float32, a6 int, a7 []float32, a8 int) {
+	p.Method_Stbmv(a0, a1, a2, a3, a4, a5, a6, a7, a8)
 }
 
-func GijitShadow_InterfaceConvertTo2_Complex64Level2(x interface{}) (y blas.Complex64Level2, b bool) {
-	y, b = x.(blas.Complex64Level2)
-	return
+func (p *GijitShadow_Proxy_Float32) Stbsv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 int, a5 []float32, a6 int, a7 []float32, a8 int) {
+	p.Method_Stbsv(a0, a1, a2, a3, a4, a5, a6, a7, a8)
 }
 
-func GijitShadow_InterfaceConvertTo1_Complex64Level2(x interface{}) blas.Complex64Level2 {
-	return x.(blas.Complex64Level2)
+func (p *GijitShadow_Proxy_Float32) Stpmv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []float32, a5 []float32, a6 int) {
+	p.Method_Stpmv(a0, a1, a2, a3, a4, a5, a6)
 }
 
-func GijitShadow_InterfaceConvertTo2_Complex64Level3(x interface{}) (y blas.Complex64Level3, b bool) {
-	y, b = x.(blas.Complex64Level3)
-	return
+func (p *GijitShadow_Proxy_Float32) Stpsv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []float32, a5 []float32, a6 int) {
+	p.Method_Stpsv(a0, a1, a2, a3, a4, a5, a6)
 }
 
-func GijitShadow_InterfaceConvertTo1_Complex64Level3(x interface{}) blas.Complex64Level3 {
-	return x.(blas.Complex64Level3)
+func (p *GijitShadow_Proxy_Float32) Strmm(a0 blas.Side, a1 blas.Uplo, a2 blas.Transpose, a3 blas.Diag, a4 int, a5 int, a6 float32, a7 []float32, a8 int, a9 []float32, a10 int) {
+	p.Method_Strmm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
 }
 
-func GijitShadow_NewStruct_DrotmParams() *blas.DrotmParams {
-	return &blas.DrotmParams{}
+func (p *GijitShadow_Proxy_Float32) Strmv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []float32, a5 int, a6 []float32, a7 int) {
+	p.Method_Strmv(a0, a1, a2, a3, a4, a5, a6, a7)
 }
 
-func GijitShadow_InterfaceConvertTo2_Float32(x interface{}) (y blas.Float32, b bool) {
-	y, b = x.(blas.Float32)
-	return
+func (p *GijitShadow_Proxy_Float32) Strsm(a0 blas.Side, a1 blas.Uplo, a2 blas.Transpose, a3 blas.Diag, a4 int, a5 int, a6 float32, a7 []float32, a8 int, a9 []float32, a10 int) {
+	p.Method_Strsm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
 }
 
-func GijitShadow_InterfaceConvertTo1_Float32(x interface{}) blas.Float32 {
-	return x.(blas.Float32)
+func (p *GijitShadow_Proxy_Float32) Strsv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []float32, a5 int, a6 []float32, a7 int) {
+	p.Method_Strsv(a0, a1, a2, a3, a4, a5, a6, a7)
 }
 
 func GijitShadow_InterfaceConvertTo2_Float32Level1(x interface{}) (y blas.Float32Level1, b bool) {
@@ -117,6 +1061,79 @@ func GijitShadow_InterfaceConvertTo1_Float32Level1(x interface{}) blas.Float32Le
 	return x.(blas.Float32Level1)
 }
 
+type GijitShadow_Proxy_Float32Level1 struct {
+	Method_Dsdot  func(a0 int, a1 []float32, a2 int, a3 []float32, a4 int) float64
+	Method_Isamax func(a0 int, a1 []float32, a2 int) int
+	Method_Sasum  func(a0 int, a1 []float32, a2 int) float32
+	Method_Saxpy  func(a0 int, a1 float32, a2 []float32, a3 int, a4 []float32, a5 int)
+	Method_Scopy  func(a0 int, a1 []float32, a2 int, a3 []float32, a4 int)
+	Method_Sdot   func(a0 int, a1 []float32, a2 int, a3 []float32, a4 int) float32
+	Method_Sdsdot func(a0 int, a1 float32, a2 []float32, a3 int, a4 []float32, a5 int) float32
+	Method_Snrm2  func(a0 int, a1 []float32, a2 int) float32
+	Method_Srot   func(a0 int, a1 []float32, a2 int, a3 []float32, a4 int, a5 float32, a6 float32)
+	Method_Srotg  func(a0 float32, a1 float32) (float32, float32, float32, float32)
+	Method_Srotm  func(a0 int, a1 []float32, a2 int, a3 []float32, a4 int, a5 blas.SrotmParams)
+	Method_Srotmg func(a0 float32, a1 float32, a2 float32, a3 float32) (blas.SrotmParams, float32, float32, float32)
+	Method_Sscal  func(a0 int, a1 float32, a2 []float32, a3 int)
+	Method_Sswap  func(a0 int, a1 []float32, a2 int, a3 []float32, a4 int)
+}
+
+func (p *GijitShadow_Proxy_Float32Level1) Dsdot(a0 int, a1 []float32, a2 int, a3 []float32, a4 int) float64 {
+	return p.Method_Dsdot(a0, a1, a2, a3, a4)
+}
+
+func (p *GijitShadow_Proxy_Float32Level1) Isamax(a0 int, a1 []float32, a2 int) int {
+	return p.Method_Isamax(a0, a1, a2)
+}
+
+func (p *GijitShadow_Proxy_Float32Level1) Sasum(a0 int, a1 []float32, a2 int) float32 {
+	return p.Method_Sasum(a0, a1, a2)
+}
+
+func (p *GijitShadow_Proxy_Float32Level1) Saxpy(a0 int, a1 float32, a2 []float32, a3 int, a4 []float32, a5 int) {
+	p.Method_Saxpy(a0, a1, a2, a3, a4, a5)
+}
+
+func (p *GijitShadow_Proxy_Float32Level1) Scopy(a0 int, a1 []float32, a2 int, a3 []float32, a4 int) {
+	p.Method_Scopy(a0, a1, a2, a3, a4)
+}
+
+func (p *GijitShadow_Proxy_Float32Level1) Sdot(a0 int, a1 []float32, a2 int, a3 []float32, a4 int) float32 {
+	return p.Method_Sdot(a0, a1, a2, a3, a4)
+}
+
+func (p *GijitShadow_Proxy_Float32Level1) Sdsdot(a0 int, a1 float32, a2 []float32, a3 int, a4 []float32, a5 int) float32 {
+	return p.Method_Sdsdot(a0, a1, a2, a3, a4, a5)
+}
+
+func (p *GijitShadow_Proxy_Float32Level1) Snrm2(a0 int, a1 []float32, a2 int) float32 {
+	return p.Method_Snrm2(a0, a1, a2)
+}
+
+func (p *GijitShadow_Proxy_Float32Level1) Srot(a0 int, a1 []float32, a2 int, a3 []float32, a4 int, a5 float32, a6 float32) {
+	p.Method_Srot(a0, a1, a2, a3, a4, a5, a6)
+}
+
+func (p *GijitShadow_Proxy_Float32Level1) Srotg(a0 float32, a1 float32) (float32, float32, float32, float32) {
+	return p.Method_Srotg(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_Float32Level1) Srotm(a0 int, a1 []float32, a2 int, a3 []float32, a4 int, a5 blas.SrotmParams) {
+	p.Method_Srotm(a0, a1, a2, a3, a4, a5)
+}
+
+func (p *GijitShadow_Proxy_Float32Level1) Srotmg(a0 float32, a1 float32, a2 float32, a3 float32) (blas.SrotmParams, float32, float32, float32) {
+	return p.Method_Srotmg(a0, a1, a2, a3)
+}
+
+func (p *GijitShadow_Proxy_Float32Level1) Sscal(a0 int, a1 float32, a2 []float32, a3 int) {
+	p.Method_Sscal(a0, a1, a2, a3)
+}
+
+func (p *GijitShadow_Proxy_Float32Level1) Sswap(a0 int, a1 []float32, a2 int, a3 []float32, a4 int) {
+	p.Method_Sswap(a0, a1, a2, a3, a4)
+}
+
 func GijitShadow_InterfaceConvertTo2_Float32Level2(x interface{}) (y blas.Float32Level2, b bool) {
 	y, b = x.(blas.Float32Level2)
 	return
@@ -126,6 +1143,89 @@ func GijitShadow_InterfaceConvertTo1_Float32Level2(x interface{}) blas.Float32Le
 	return x.(blas.Float32Level2)
 }
 
+type GijitShadow_Proxy_Float32Level2 struct {
+	Method_Sgbmv func(a0 blas.Transpose, a1 int, a2 int, a3 int, a4 int, a5 float32, a6 []float32, a7 int, a8 []float32, a9 int, a10 float32, a11 []float32, a12 int)
+	Method_Sgemv func(a0 blas.Transpose, a1 int, a2 int, a3 float32, a4 []float32, a5 int, a6 []float32, a7 int, a8 float32, a9 []float32, a10 int)
+	Method_Sger  func(a0 int, a1 int, a2 float32, a3 []float32, a4 int, a5 []float32, a6 int, a7 []float32, a8 int)
+	Method_Ssbmv func(a0 blas.Uplo, a1 int, a2 int, a3 float32, a4 []float32, a5 int, a6 []float32, a7 int, a8 float32, a9 []float32, a10 int)
+	Method_Sspmv func(a0 blas.Uplo, a1 int, a2 float32, a3 []float32, a4 []float32, a5 int, a6 float32, a7 []float32, a8 int)
+	Method_Sspr  func(a0 blas.Uplo, a1 int, a2 float32, a3 []float32, a4 int, a5 []float32)
+	Method_Sspr2 func(a0 blas.Uplo, a1 int, a2 float32, a3 []float32, a4 int, a5 []float32, a6 int, a7 []float32)
+	Method_Ssymv func(a0 blas.Uplo, a1 int, a2 float32, a3 []float32, a4 int, a5 []float32, a6 int, a7 float32, a8 []float32, a9 int)
+	Method_Ssyr  func(a0 blas.Uplo, a1 int, a2 float32, a3 []float32, a4 int, a5 []float32, a6 int)
+	Method_Ssyr2 func(a0 blas.Uplo, a1 int, a2 float32, a3 []float32, a4 int, a5 []float32, a6 int, a7 []float32, a8 int)
+	Method_Stbmv func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 int, a5 []float32, a6 int, a7 []float32, a8 int)
+	Method_Stbsv func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 int, a5 []float32, a6 int, a7 []float32, a8 int)
+	Method_Stpmv func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []float32, a5 []float32, a6 int)
+	Method_Stpsv func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []float32, a5 []float32, a6 int)
+	Method_Strmv func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []float32, a5 int, a6 []float32, a7 int)
+	Method_Strsv func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []float32, a5 int, a6 []float32, a7 int)
+}
+
+func (p *GijitShadow_Proxy_Float32Level2) Sgbmv(a0 blas.Transpose, a1 int, a2 int, a3 int, a4 int, a5 float32, a6 []float32, a7 int, a8 []float32, a9 int, a10 float32, a11 []float32, a12 int) {
+	p.Method_Sgbmv(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12)
+}
+
+func (p *GijitShadow_Proxy_Float32Level2) Sgemv(a0 blas.Transpose, a1 int, a2 int, a3 float32, a4 []float32, a5 int, a6 []float32, a7 int, a8 float32, a9 []float32, a10 int) {
+	p.Method_Sgemv(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
+}
+
+func (p *GijitShadow_Proxy_Float32Level2) Sger(a0 int, a1 int, a2 float32, a3 []float32, a4 int, a5 []float32, a6 int, a7 []float32, a8 int) {
+	p.Method_Sger(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Float32Level2) Ssbmv(a0 blas.Uplo, a1 int, a2 int, a3 float32, a4 []float32, a5 int, a6 []float32, a7 int, a8 float32, a9 []float32, a10 int) {
+	p.Method_Ssbmv(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
+}
+
+func (p *GijitShadow_Proxy_Float32Level2) Sspmv(a0 blas.Uplo, a1 int, a2 float32, a3 []float32, a4 []float32, a5 int, a6 float32, a7 []float32, a8 int) {
+	p.Method_Sspmv(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Float32Level2) Sspr(a0 blas.Uplo, a1 int, a2 float32, a3 []float32, a4 int, a5 []float32) {
+	p.Method_Sspr(a0, a1, a2, a3, a4, a5)
+}
+
+func (p *GijitShadow_Proxy_Float32Level2) Sspr2(a0 blas.Uplo, a1 int, a2 float32, a3 []float32, a4 int, a5 []float32, a6 int, a7 []float32) {
+	p.Method_Sspr2(a0, a1, a2, a3, a4, a5, a6, a7)
+}
+
+func (p *GijitShadow_Proxy_Float32Level2) Ssymv(a0 blas.Uplo, a1 int, a2 float32, a3 []float32, a4 int, a5 []float32, a6 int, a7 float32, a8 []float32, a9 int) {
+	p.Method_Ssymv(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9)
+}
+
+func (p *GijitShadow_Proxy_Float32Level2) Ssyr(a0 blas.Uplo, a1 int, a2 float32, a3 []float32, a4 int, a5 []float32, a6 int) {
+	p.Method_Ssyr(a0, a1, a2, a3, a4, a5, a6)
+}
+
+func (p *GijitShadow_Proxy_Float32Level2) Ssyr2(a0 blas.Uplo, a1 int, a2 float32, a3 []float32, a4 int, a5 []float32, a6 int, a7 []float32, a8 int) {
+	p.Method_Ssyr2(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Float32Level2) Stbmv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 int, a5 []float32, a6 int, a7 []float32, a8 int) {
+	p.Method_Stbmv(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Float32Level2) Stbsv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 int, a5 []float32, a6 int, a7 []float32, a8 int) {
+	p.Method_Stbsv(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Float32Level2) Stpmv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []float32, a5 []float32, a6 int) {
+	p.Method_Stpmv(a0, a1, a2, a3, a4, a5, a6)
+}
+
+func (p *GijitShadow_Proxy_Float32Level2) Stpsv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []float32, a5 []float32, a6 int) {
+	p.Method_Stpsv(a0, a1, a2, a3, a4, a5, a6)
+}
+
+func (p *GijitShadow_Proxy_Float32Level2) Strmv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []float32, a5 int, a6 []float32, a7 int) {
+	p.Method_Strmv(a0, a1, a2, a3, a4, a5, a6, a7)
+}
+
+func (p *GijitShadow_Proxy_Float32Level2) Strsv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []float32, a5 int, a6 []float32, a7 int) {
+	p.Method_Strsv(a0, a1, a2, a3, a4, a5, a6, a7)
+}
+
 func GijitShadow_InterfaceConvertTo2_Float32Level3(x interface{}) (y blas.Float32Level3, b bool) {
 	y, b = x.(blas.Float32Level3)
 	return
@@ -135,6 +1235,39 @@ func GijitShadow_InterfaceConvertTo1_Float32Level3(x interface{}) blas.Float32Le
 	return x.(blas.Float32Level3)
 }
 
+type GijitShadow_Proxy_Float32Level3 struct {
+	Method_Sgemm  func(a0 blas.Transpose, a1 blas.Transpose, a2 int, a3 int, a4 int, a5 float32, a6 []float32, a7 int, a8 []float32, a9 int, a10 float32, a11 []float32, a12 int)
+	Method_Ssymm  func(a0 blas.Side, a1 blas.Uplo, a2 int, a3 int, a4 float32, a5 []float32, a6 int, a7 []float32, a8 int, a9 float32, a10 []float32, a11 int)
+	Method_Ssyr2k func(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 float32, a5 []float32, a6 int, a7 []float32, a8 int, a9 float32, a10 []float32, a11 int)
+	Method_Ssyrk  func(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 float32, a5 []float32, a6 int, a7 float32, a8 []float32, a9 int)
+	Method_Strmm  func(a0 blas.Side, a1 blas.Uplo, a2 blas.Transpose, a3 blas.Diag, a4 int, a5 int, a6 float32, a7 []float32, a8 int, a9 []float32, a10 int)
+	Method_Strsm  func(a0 blas.Side, a1 blas.Uplo, a2 blas.Transpose, a3 blas.Diag, a4 int, a5 int, a6 float32, a7 []float32, a8 int, a9 []float32, a10 int)
+}
+
+func (p *GijitShadow_Proxy_Float32Level3) Sgemm(a0 blas.Transpose, a1 blas.Transpose, a2 int, a3 int, a4 int, a5 float32, a6 []float32, a7 int, a8 []float32, a9 int, a10 float32, a11 []float32, a12 int) {
+	p.Method_Sgemm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12)
+}
+
+func (p *GijitShadow_Proxy_Float32Level3) Ssymm(a0 blas.Side, a1 blas.Uplo, a2 int, a3 int, a4 float32, a5 []float32, a6 int, a7 []float32, a8 int, a9 float32, a10 []float32, a11 int) {
+	p.Method_Ssymm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11)
+}
+
+func (p *GijitShadow_Proxy_Float32Level3) Ssyr2k(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 float32, a5 []float32, a6 int, a7 []float32, a8 int, a9 float32, a10 []float32, a11 int) {
+	p.Method_Ssyr2k(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11)
+}
+
+func (p *GijitShadow_Proxy_Float32Level3) Ssyrk(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 float32, a5 []float32, a6 int, a7 float32, a8 []float32, a9 int) {
+	p.Method_Ssyrk(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9)
+}
+
+func (p *GijitShadow_Proxy_Float32Level3) Strmm(a0 blas.Side, a1 blas.Uplo, a2 blas.Transpose, a3 blas.Diag, a4 int, a5 int, a6 float32, a7 []float32, a8 int, a9 []float32, a10 int) {
+	p.Method_Strmm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
+}
+
+func (p *GijitShadow_Proxy_Float32Level3) Strsm(a0 blas.Side, a1 blas.Uplo, a2 blas.Transpose, a3 blas.Diag, a4 int, a5 int, a6 float32, a7 []float32, a8 int, a9 []float32, a10 int) {
+	p.Method_Strsm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
+}
+
 func GijitShadow_InterfaceConvertTo2_Float64(x interface{}) (y blas.Float64, b bool) {
 	y, b = x.(blas.Float64)
 	return
@@ -144,6 +1277,179 @@ func GijitShadow_InterfaceConvertTo1_Float64(x interface{}) blas.Float64 {
 	return x.(blas.Float64)
 }
 
+type GijitShadow_Proxy_Float64 struct {
+	Method_Dasum  func(a0 int, a1 []float64, a2 int) float64
+	Method_Daxpy  func(a0 int, a1 float64, a2 []float64, a3 int, a4 []float64, a5 int)
+	Method_Dcopy  func(a0 int, a1 []float64, a2 int, a3 []float64, a4 int)
+	Method_Ddot   func(a0 int, a1 []float64, a2 int, a3 []float64, a4 int) float64
+	Method_Dgbmv  func(a0 blas.Transpose, a1 int, a2 int, a3 int, a4 int, a5 float64, a6 []float64, a7 int, a8 []float64, a9 int, a10 float64, a11 []float64, a12 int)
+	Method_Dgemm  func(a0 blas.Transpose, a1 blas.Transpose, a2 int, a3 int, a4 int, a5 float64, a6 []float64, a7 int, a8 []float64, a9 int, a10 float64, a11 []float64, a12 int)
+	Method_Dgemv  func(a0 blas.Transpose, a1 int, a2 int, a3 float64, a4 []float64, a5 int, a6 []float64, a7 int, a8 float64, a9 []float64, a10 int)
+	Method_Dger   func(a0 int, a1 int, a2 float64, a3 []float64, a4 int, a5 []float64, a6 int, a7 []float64, a8 int)
+	Method_Dnrm2  func(a0 int, a1 []float64, a2 int) float64
+	Method_Drot   func(a0 int, a1 []float64, a2 int, a3 []float64, a4 int, a5 float64, a6 float64)
+	Method_Drotg  func(a0 float64, a1 float64) (float64, float64, float64, float64)
+	Method_Drotm  func(a0 int, a1 []float64, a2 int, a3 []float64, a4 int, a5 blas.DrotmParams)
+	Method_Drotmg func(a0 float64, a1 float64, a2 float64, a3 float64) (blas.DrotmParams, float64, float64, float64)
+	Method_Dsbmv  func(a0 blas.Uplo, a1 int, a2 int, a3 float64, a4 []float64, a5 int, a6 []float64, a7 int, a8 float64, a9 []float64, a10 int)
+	Method_Dscal  func(a0 int, a1 float64, a2 []float64, a3 int)
+	Method_Dspmv  func(a0 blas.Uplo, a1 int, a2 float64, a3 []float64, a4 []float64, a5 int, a6 float64, a7 []float64, a8 int)
+	Method_Dspr   func(a0 blas.Uplo, a1 int, a2 float64, a3 []float64, a4 int, a5 []float64)
+	Method_Dspr2  func(a0 blas.Uplo, a1 int, a2 float64, a3 []float64, a4 int, a5 []float64, a6 int, a7 []float64)
+	Method_Dswap  func(a0 int, a1 []float64, a2 int, a3 []float64, a4 int)
+	Method_Dsymm  func(a0 blas.Side, a1 blas.Uplo, a2 int, a3 int, a4 float64, a5 []float64, a6 int, a7 []float64, a8 int, a9 float64, a10 []float64, a11 int)
+	Method_Dsymv  func(a0 blas.Uplo, a1 int, a2 float64, a3 []float64, a4 int, a5 []float64, a6 int, a7 float64, a8 []float64, a9 int)
+	Method_Dsyr   func(a0 blas.Uplo, a1 int, a2 float64, a3 []float64, a4 int, a5 []float64, a6 int)
+	Method_Dsyr2  func(a0 blas.Uplo, a1 int, a2 float64, a3 []float64, a4 int, a5 []float64, a6 int, a7 []float64, a8 int)
+	Method_Dsyr2k func(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 float64, a5 []float64, a6 int, a7 []float64, a8 int, a9 float64, a10 []float64, a11 int)
+	Method_Dsyrk  func(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 float64, a5 []float64, a6 int, a7 float64, a8 []float64, a9 int)
+	Method_Dtbmv  func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 int, a5 []float64, a6 int, a7 []float64, a8 int)
+	Method_Dtbsv  func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 int, a5 []float64, a6 int, a7 []float64, a8 int)
+	Method_Dtpmv  func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []float64, a5 []float64, a6 int)
+	Method_Dtpsv  func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []float64, a5 []float64, a6 int)
+	Method_Dtrmm  func(a0 blas.Side, a1 blas.Uplo, a2 blas.Transpose, a3 blas.Diag, a4 int, a5 int, a6 float64, a7 []float64, a8 int, a9 []float64, a10 int)
+	Method_Dtrmv  func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []float64, a5 int, a6 []float64, a7 int)
+	Method_Dtrsm  func(a0 blas.Side, a1 blas.Uplo, a2 blas.Transpose, a3 blas.Diag, a4 int, a5 int, a6 float64, a7 []float64, a8 int, a9 []float64, a10 int)
+	Method_Dtrsv  func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []float64, a5 int, a6 []float64, a7 int)
+	Method_Idamax func(a0 int, a1 []float64, a2 int) int
+}
+
+func (p *GijitShadow_Proxy_Float64) Dasum(a0 int, a1 []float64, a2 int) float64 {
+	return p.Method_Dasum(a0, a1, a2)
+}
+
+func (p *GijitShadow_Proxy_Float64) Daxpy(a0 int, a1 float64, a2 []float64, a3 int, a4 []float64, a5 int) {
+	p.Method_Daxpy(a0, a1, a2, a3, a4, a5)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dcopy(a0 int, a1 []float64, a2 int, a3 []float64, a4 int) {
+	p.Method_Dcopy(a0, a1, a2, a3, a4)
+}
+
+func (p *GijitShadow_Proxy_Float64) Ddot(a0 int, a1 []float64, a2 int, a3 []float64, a4 int) float64 {
+	return p.Method_Ddot(a0, a1, a2, a3, a4)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dgbmv(a0 blas.Transpose, a1 int, a2 int, a3 int, a4 int, a5 float64, a6 []float64, a7 int, a8 []float64, a9 int, a10 float64, a11 []float64, a12 int) {
+	p.Method_Dgbmv(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dgemm(a0 blas.Transpose, a1 blas.Transpose, a2 int, a3 int, a4 int, a5 float64, a6 []float64, a7 int, a8 []float64, a9 int, a10 float64, a11 []float64, a12 int) {
+	p.Method_Dgemm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dgemv(a0 blas.Transpose, a1 int, a2 int, a3 float64, a4 []float64, a5 int, a6 []float64, a7 int, a8 float64, a9 []float64, a10 int) {
+	p.Method_Dgemv(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dger(a0 int, a1 int, a2 float64, a3 []float64, a4 int, a5 []float64, a6 int, a7 []float64, a8 int) {
+	p.Method_Dger(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dnrm2(a0 int, a1 []float64, a2 int) float64 {
+	return p.Method_Dnrm2(a0, a1, a2)
+}
+
+func (p *GijitShadow_Proxy_Float64) Drot(a0 int, a1 []float64, a2 int, a3 []float64, a4 int, a5 float64, a6 float64) {
+	p.Method_Drot(a0, a1, a2, a3, a4, a5, a6)
+}
+
+func (p *GijitShadow_Proxy_Float64) Drotg(a0 float64, a1 float64) (float64, float64, float64, float64) {
+	return p.Method_Drotg(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_Float64) Drotm(a0 int, a1 []float64, a2 int, a3 []float64, a4 int, a5 blas.DrotmParams) {
+	p.Method_Drotm(a0, a1, a2, a3, a4, a5)
+}
+
+func (p *GijitShadow_Proxy_Float64) Drotmg(a0 float64, a1 float64, a2 float64, a3 float64) (blas.DrotmParams, float64, float64, float64) {
+	return p.Method_Drotmg(a0, a1, a2, a3)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dsbmv(a0 blas.Uplo, a1 int, a2 int, a3 float64, a4 []float64, a5 int, a6 []float64, a7 int, a8 float64, a9 []float64, a10 int) {
+	p.Method_Dsbmv(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dscal(a0 int, a1 float64, a2 []float64, a3 int) {
+	p.Method_Dscal(a0, a1, a2, a3)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dspmv(a0 blas.Uplo, a1 int, a2 float64, a3 []float64, a4 []float64, a5 int, a6 float64, a7 []float64, a8 int) {
+	p.Method_Dspmv(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dspr(a0 blas.Uplo, a1 int, a2 float64, a3 []float64, a4 int, a5 []float64) {
+	p.Method_Dspr(a0, a1, a2, a3, a4, a5)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dspr2(a0 blas.Uplo, a1 int, a2 float64, a3 []float64, a4 int, a5 []float64, a6 int, a7 []float64) {
+	p.Method_Dspr2(a0, a1, a2, a3, a4, a5, a6, a7)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dswap(a0 int, a1 []float64, a2 int, a3 []float64, a4 int) {
+	p.Method_Dswap(a0, a1, a2, a3, a4)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dsymm(a0 blas.Side, a1 blas.Uplo, a2 int, a3 int, a4 float64, a5 []float64, a6 int, a7 []float64, a8 int, a9 float64, a10 []float64, a11 int) {
+	p.Method_Dsymm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dsymv(a0 blas.Uplo, a1 int, a2 float64, a3 []float64, a4 int, a5 []float64, a6 int, a7 float64, a8 []float64, a9 int) {
+	p.Method_Dsymv(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dsyr(a0 blas.Uplo, a1 int, a2 float64, a3 []float64, a4 int, a5 []float64, a6 int) {
+	p.Method_Dsyr(a0, a1, a2, a3, a4, a5, a6)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dsyr2(a0 blas.Uplo, a1 int, a2 float64, a3 []float64, a4 int, a5 []float64, a6 int, a7 []float64, a8 int) {
+	p.Method_Dsyr2(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dsyr2k(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 float64, a5 []float64, a6 int, a7 []float64, a8 int, a9 float64, a10 []float64, a11 int) {
+	p.Method_Dsyr2k(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dsyrk(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 float64, a5 []float64, a6 int, a7 float64, a8 []float64, a9 int) {
+	p.Method_Dsyrk(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dtbmv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 int, a5 []float64, a6 int, a7 []float64, a8 int) {
+	p.Method_Dtbmv(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dtbsv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 int, a5 []float64, a6 int, a7 []float64, a8 int) {
+	p.Method_Dtbsv(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dtpmv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []float64, a5 []float64, a6 int) {
+	p.Method_Dtpmv(a0, a1, a2, a3, a4, a5, a6)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dtpsv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []float64, a5 []float64, a6 int) {
+	p.Method_Dtpsv(a0, a1, a2, a3, a4, a5, a6)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dtrmm(a0 blas.Side, a1 blas.Uplo, a2 blas.Transpose, a3 blas.Diag, a4 int, a5 int, a6 float64, a7 []float64, a8 int, a9 []float64, a10 int) {
+	p.Method_Dtrmm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dtrmv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []float64, a5 int, a6 []float64, a7 int) {
+	p.Method_Dtrmv(a0, a1, a2, a3, a4, a5, a6, a7)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dtrsm(a0 blas.Side, a1 blas.Uplo, a2 blas.Transpose, a3 blas.Diag, a4 int, a5 int, a6 float64, a7 []float64, a8 int, a9 []float64, a10 int) {
+	p.Method_Dtrsm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dtrsv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []float64, a5 int, a6 []float64, a7 int) {
+	p.Method_Dtrsv(a0, a1, a2, a3, a4, a5, a6, a7)
+}
+
+func (p *GijitShadow_Proxy_Float64) Idamax(a0 int, a1 []float64, a2 int) int {
+	return p.Method_Idamax(a0, a1, a2)
+}
+
 func GijitShadow_InterfaceConvertTo2_Float64Level1(x interface{}) (y blas.Float64Level1, b bool) {
 	y, b = x.(blas.Float64Level1)
 	return
@@ -153,6 +1459,69 @@ func GijitShadow_InterfaceConvertTo1_Float64Level1(x interface{}) blas.Float64Le
 	return x.(blas.Float64Level1)
 }
 
+type GijitShadow_Proxy_Float64Level1 struct {
+	Method_Dasum  func(a0 int, a1 []float64, a2 int) float64
+	Method_Daxpy  func(a0 int, a1 float64, a2 []float64, a3 int, a4 []float64, a5 int)
+	Method_Dcopy  func(a0 int, a1 []float64, a2 int, a3 []float64, a4 int)
+	Method_Ddot   func(a0 int, a1 []float64, a2 int, a3 []float64, a4 int) float64
+	Method_Dnrm2  func(a0 int, a1 []float64, a2 int) float64
+	Method_Drot   func(a0 int, a1 []float64, a2 int, a3 []float64, a4 int, a5 float64, a6 float64)
+	Method_Drotg  func(a0 float64, a1 float64) (float64, float64, float64, float64)
+	Method_Drotm  func(a0 int, a1 []float64, a2 int, a3 []float64, a4 int, a5 blas.DrotmParams)
+	Method_Drotmg func(a0 float64, a1 float64, a2 float64, a3 float64) (blas.DrotmParams, float64, float64, float64)
+	Method_Dscal  func(a0 int, a1 float64, a2 []float64, a3 int)
+	Method_Dswap  func(a0 int, a1 []float64, a2 int, a3 []float64, a4 int)
+	Method_Idamax func(a0 int, a1 []float64, a2 int) int
+}
+
+func (p *GijitShadow_Proxy_Float64Level1) Dasum(a0 int, a1 []float64, a2 int) float64 {
+	return p.Method_Dasum(a0, a1, a2)
+}
+
+func (p *GijitShadow_Proxy_Float64Level1) Daxpy(a0 int, a1 float64, a2 []float64, a3 int, a4 []float64, a5 int) {
+	p.Method_Daxpy(a0, a1, a2, a3, a4, a5)
+}
+
+func (p *GijitShadow_Proxy_Float64Level1) Dcopy(a0 int, a1 []float64, a2 int, a3 []float64, a4 int) {
+	p.Method_Dcopy(a0, a1, a2, a3, a4)
+}
+
+func (p *GijitShadow_Proxy_Float64Level1) Ddot(a0 int, a1 []float64, a2 int, a3 []float64, a4 int) float64 {
+	return p.Method_Ddot(a0, a1, a2, a3, a4)
+}
+
+func (p *GijitShadow_Proxy_Float64Level1) Dnrm2(a0 int, a1 []float64, a2 int) float64 {
+	return p.Method_Dnrm2(a0, a1, a2)
+}
+
+func (p *GijitShadow_Proxy_Float64Level1) Drot(a0 int, a1 []float64, a2 int, a3 []float64, a4 int, a5 float64, a6 float64) {
+	p.Method_Drot(a0, a1, a2, a3, a4, a5, a6)
+}
+
+func (p *GijitShadow_Proxy_Float64Level1) Drotg(a0 float64, a1 float64) (float64, float64, float64, float64) {
+	return p.Method_Drotg(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_Float64Level1) Drotm(a0 int, a1 []float64, a2 int, a3 []float64, a4 int, a5 blas.DrotmParams) {
+	p.Method_Drotm(a0, a1, a2, a3, a4, a5)
+}
+
+func (p *GijitShadow_Proxy_Float64Level1) Drotmg(a0 float64, a1 float64, a2 float64, a3 float64) (blas.DrotmParams, float64, float64, float64) {
+	return p.Method_Drotmg(a0, a1, a2, a3)
+}
+
+func (p *GijitShadow_Proxy_Float64Level1) Dscal(a0 int, a1 float64, a2 []float64, a3 int) {
+	p.Method_Dscal(a0, a1, a2, a3)
+}
+
+func (p *GijitShadow_Proxy_Float64Level1) Dswap(a0 int, a1 []float64, a2 int, a3 []float64, a4 int) {
+	p.Method_Dswap(a0, a1, a2, a3, a4)
+}
+
+func (p *GijitShadow_Proxy_Float64Level1) Idamax(a0 int, a1 []float64, a2 int) int {
+	return p.Method_Idamax(a0, a1, a2)
+}
+
 func GijitShadow_InterfaceConvertTo2_Float64Level2(x interface{}) (y blas.Float64Level2, b bool) {
 	y, b = x.(blas.Float64Level2)
 	return
@@ -162,6 +1531,89 @@ func GijitShadow_InterfaceConvertTo1_Float64Level2(x interface{}) blas.Float64Le
 	return x.(blas.Float64Level2)
 }
 
+type GijitShadow_Proxy_Float64Level2 struct {
+	Method_Dgbmv func(a0 blas.Transpose, a1 int, a2 int, a3 int, a4 int, a5 float64, a6 []float64, a7 int, a8 []float64, a9 int, a10 float64, a11 []float64, a12 int)
+	Method_Dgemv func(a0 blas.Transpose, a1 int, a2 int, a3 float64, a4 []float64, a5 int, a6 []float64, a7 int, a8 float64, a9 []float64, a10 int)
+	Method_Dger  func(a0 int, a1 int, a2 float64, a3 []float64, a4 int, a5 []float64, a6 int, a7 []float64, a8 int)
+	Method_Dsbmv func(a0 blas.Uplo, a1 int, a2 int, a3 float64, a4 []float64, a5 int, a6 []float64, a7 int, a8 float64, a9 []float64, a10 int)
+	Method_Dspmv func(a0 blas.Uplo, a1 int, a2 float64, a3 []float64, a4 []float64, a5 int, a6 float64, a7 []float64, a8 int)
+	Method_Dspr  func(a0 blas.Uplo, a1 int, a2 float64, a3 []float64, a4 int, a5 []float64)
+	Method_Dspr2 func(a0 blas.Uplo, a1 int, a2 float64, a3 []float64, a4 int, a5 []float64, a6 int, a7 []float64)
+	Method_Dsymv func(a0 blas.Uplo, a1 int, a2 float64, a3 []float64, a4 int, a5 []float64, a6 int, a7 float64, a8 []float64, a9 int)
+	Method_Dsyr  func(a0 blas.Uplo, a1 int, a2 float64, a3 []float64, a4 int, a5 []float64, a6 int)
+	Method_Dsyr2 func(a0 blas.Uplo, a1 int, a2 float64, a3 []float64, a4 int, a5 []float64, a6 int, a7 []float64, a8 int)
+	Method_Dtbmv func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 int, a5 []float64, a6 int, a7 []float64, a8 int)
+	Method_Dtbsv func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 int, a5 []float64, a6 int, a7 []float64, a8 int)
+	Method_Dtpmv func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []float64, a5 []float64, a6 int)
+	Method_Dtpsv func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []float64, a5 []float64, a6 int)
+	Method_Dtrmv func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []float64, a5 int, a6 []float64, a7 int)
+	Method_Dtrsv func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []float64, a5 int, a6 []float64, a7 int)
+}
+
+func (p *GijitShadow_Proxy_Float64Level2) Dgbmv(a0 blas.Transpose, a1 int, a2 int, a3 int, a4 int, a5 float64, a6 []float64, a7 int, a8 []float64, a9 int, a10 float64, a11 []float64, a12 int) {
+	p.Method_Dgbmv(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12)
+}
+
+func (p *GijitShadow_Proxy_Float64Level2) Dgemv(a0 blas.Transpose, a1 int, a2 int, a3 float64, a4 []float64, a5 int, a6 []float64, a7 int, a8 float64, a9 []float64, a10 int) {
+	p.Method_Dgemv(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
+}
+
+func (p *GijitShadow_Proxy_Float64Level2) Dger(a0 int, a1 int, a2 float64, a3 []float64, a4 int, a5 []float64, a6 int, a7 []float64, a8 int) {
+	p.Method_Dger(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Float64Level2) Dsbmv(a0 blas.Uplo, a1 int, a2 int, a3 float64, a4 []float64, a5 int, a6 []float64, a7 int, a8 float64, a9 []float64, a10 int) {
+	p.Method_Dsbmv(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
+}
+
+func (p *GijitShadow_Proxy_Float64Level2) Dspmv(a0 blas.Uplo, a1 int, a2 float64, a3 []float64, a4 []float64, a5 int, a6 float64, a7 []float64, a8 int) {
+	p.Method_Dspmv(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Float64Level2) Dspr(a0 blas.Uplo, a1 int, a2 float64, a3 []float64, a4 int, a5 []float64) {
+	p.Method_Dspr(a0, a1, a2, a3, a4, a5)
+}
+
+func (p *GijitShadow_Proxy_Float64Level2) Dspr2(a0 blas.Uplo, a1 int, a2 float64, a3 []float64, a4 int, a5 []float64, a6 int, a7 []float64) {
+	p.Method_Dspr2(a0, a1, a2, a3, a4, a5, a6, a7)
+}
+
+func (p *GijitShadow_Proxy_Float64Level2) Dsymv(a0 blas.Uplo, a1 int, a2 float64, a3 []float64, a4 int, a5 []float64, a6 int, a7 float64, a8 []float64, a9 int) {
+	p.Method_Dsymv(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9)
+}
+
+func (p *GijitShadow_Proxy_Float64Level2) Dsyr(a0 blas.Uplo, a1 int, a2 float64, a3 []float64, a4 int, a5 []float64, a6 int) {
+	p.Method_Dsyr(a0, a1, a2, a3, a4, a5, a6)
+}
+
+func (p *GijitShadow_Proxy_Float64Level2) Dsyr2(a0 blas.Uplo, a1 int, a2 float64, a3 []float64, a4 int, a5 []float64, a6 int, a7 []float64, a8 int) {
+	p.Method_Dsyr2(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Float64Level2) Dtbmv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 int, a5 []float64, a6 int, a7 []float64, a8 int) {
+	p.Method_Dtbmv(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Float64Level2) Dtbsv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 int, a5 []float64, a6 int, a7 []float64, a8 int) {
+	p.Method_Dtbsv(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
+
+func (p *GijitShadow_Proxy_Float64Level2) Dtpmv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []float64, a5 []float64, a6 int) {
+	p.Method_Dtpmv(a0, a1, a2, a3, a4, a5, a6)
+}
+
+func (p *GijitShadow_Proxy_Float64Level2) Dtpsv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []float64, a5 []float64, a6 int) {
+	p.Method_Dtpsv(a0, a1, a2, a3, a4, a5, a6)
+}
+
+func (p *GijitShadow_Proxy_Float64Level2) Dtrmv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []float64, a5 int, a6 []float64, a7 int) {
+	p.Method_Dtrmv(a0, a1, a2, a3, a4, a5, a6, a7)
+}
+
+func (p *GijitShadow_Proxy_Float64Level2) Dtrsv(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 []float64, a5 int, a6 []float64, a7 int) {
+	p.Method_Dtrsv(a0, a1, a2, a3, a4, a5, a6, a7)
+}
+
 func GijitShadow_InterfaceConvertTo2_Float64Level3(x interface{}) (y blas.Float64Level3, b bool) {
 	y, b = x.(blas.Float64Level3)
 	return
@@ -171,6 +1623,39 @@ func GijitShadow_InterfaceConvertTo1_Float64Level3(x interface{}) blas.Float64Le
 	return x.(blas.Float64Level3)
 }
 
+type GijitShadow_Proxy_Float64Level3 struct {
+	Method_Dgemm  func(a0 blas.Transpose, a1 blas.Transpose, a2 int, a3 int, a4 int, a5 float64, a6 []float64, a7 int, a8 []float64, a9 int, a10 float64, a11 []float64, a12 int)
+	Method_Dsymm  func(a0 blas.Side, a1 blas.Uplo, a2 int, a3 int, a4 float64, a5 []float64, a6 int, a7 []float64, a8 int, a9 float64, a10 []float64, a11 int)
+	Method_Dsyr2k func(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 float64, a5 []float64, a6 int, a7 []float64, a8 int, a9 float64, a10 []float64, a11 int)
+	Method_Dsyrk  func(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 float64, a5 []float64, a6 int, a7 float64, a8 []float64, a9 int)
+	Method_Dtrmm  func(a0 blas.Side, a1 blas.Uplo, a2 blas.Transpose, a3 blas.Diag, a4 int, a5 int, a6 float64, a7 []float64, a8 int, a9 []float64, a10 int)
+	Method_Dtrsm  func(a0 blas.Side, a1 blas.Uplo, a2 blas.Transpose, a3 blas.Diag, a4 int, a5 int, a6 float64, a7 []float64, a8 int, a9 []float64, a10 int)
+}
+
+func (p *GijitShadow_Proxy_Float64Level3) Dgemm(a0 blas.Transpose, a1 blas.Transpose, a2 int, a3 int, a4 int, a5 float64, a6 []float64, a7 int, a8 []float64, a9 int, a10 float64, a11 []float64, a12 int) {
+	p.Method_Dgemm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12)
+}
+
+func (p *GijitShadow_Proxy_Float64Level3) Dsymm(a0 blas.Side, a1 blas.Uplo, a2 int, a3 int, a4 float64, a5 []float64, a6 int, a7 []float64, a8 int, a9 float64, a10 []float64, a11 int) {
+	p.Method_Dsymm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11)
+}
+
+func (p *GijitShadow_Proxy_Float64Level3) Dsyr2k(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 float64, a5 []float64, a6 int, a7 []float64, a8 int, a9 float64, a10 []float64, a11 int) {
+	p.Method_Dsyr2k(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11)
+}
+
+func (p *GijitShadow_Proxy_Float64Level3) Dsyrk(a0 blas.Uplo, a1 blas.Transpose, a2 int, a3 int, a4 float64, a5 []float64, a6 int, a7 float64, a8 []float64, a9 int) {
+	p.Method_Dsyrk(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9)
+}
+
+func (p *GijitShadow_Proxy_Float64Level3) Dtrmm(a0 blas.Side, a1 blas.Uplo, a2 blas.Transpose, a3 blas.Diag, a4 int, a5 int, a6 float64, a7 []float64, a8 int, a9 []float64, a10 int) {
+	p.Method_Dtrmm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
+}
+
+func (p *GijitShadow_Proxy_Float64Level3) Dtrsm(a0 blas.Side, a1 blas.Uplo, a2 blas.Transpose, a3 blas.Diag, a4 int, a5 int, a6 float64, a7 []float64, a8 int, a9 []float64, a10 int) {
+	p.Method_Dtrsm(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10)
+}
+
 func GijitShadow_NewStruct_SrotmParams() *blas.SrotmParams {
 	return &blas.SrotmParams{}
 }
@@ -3,47 +3,85 @@ package shadow_graph
 import "gonum.org/v1/gonum/graph"
 
 var Pkg = make(map[string]interface{})
+var Proxy = make(map[string]interface{})
 
 func init() {
 	Pkg["Builder"] = GijitShadow_InterfaceConvertTo2_Builder
+	Proxy["Builder"] = (*GijitShadow_Proxy_Builder)(nil)
 	Pkg["Copy"] = graph.Copy
 	Pkg["CopyWeighted"] = graph.CopyWeighted
 	Pkg["Directed"] = GijitShadow_InterfaceConvertTo2_Directed
+	Proxy["Directed"] = (*GijitShadow_Proxy_Directed)(nil)
 	Pkg["DirectedBuilder"] = GijitShadow_InterfaceConvertTo2_DirectedBuilder
+	Proxy["DirectedBuilder"] = (*GijitShadow_Proxy_DirectedBuilder)(nil)
 	Pkg["DirectedMultigraph"] = GijitShadow_InterfaceConvertTo2_DirectedMultigraph
+	Proxy["DirectedMultigraph"] = (*GijitShadow_Proxy_DirectedMultigraph)(nil)
 	Pkg["DirectedMultigraphBuilder"] = GijitShadow_InterfaceConvertTo2_DirectedMultigraphBuilder
+	Proxy["DirectedMultigraphBuilder"] = (*GijitShadow_Proxy_DirectedMultigraphBuilder)(nil)
 	Pkg["DirectedWeightedBuilder"] = GijitShadow_InterfaceConvertTo2_DirectedWeightedBuilder
+	Proxy["DirectedWeightedBuilder"] = (*GijitShadow_Proxy_DirectedWeightedBuilder)(nil)
 	Pkg["DirectedWeightedMultigraphBuilder"] = GijitShadow_InterfaceConvertTo2_DirectedWeightedMultigraphBuilder
+	Proxy["DirectedWeightedMultigraphBuilder"] = (*GijitShadow_Proxy_DirectedWeightedMultigraphBuilder)(nil)
 	Pkg["Edge"] = GijitShadow_InterfaceConvertTo2_Edge
+	Proxy["Edge"] = (*GijitShadow_Proxy_Edge)(nil)
 	Pkg["EdgeAdder"] = GijitShadow_InterfaceConvertTo2_EdgeAdder
+	Proxy["EdgeAdder"] = (*GijitShadow_Proxy_EdgeAdder)(nil)
 	Pkg["EdgeRemover"] = GijitShadow_InterfaceConvertTo2_EdgeRemover
+	Proxy["EdgeRemover"] = (*GijitShadow_Proxy_EdgeRemover)(nil)
 	Pkg["Graph"] = GijitShadow_InterfaceConvertTo2_Graph
+	Proxy["Graph"] = (*GijitShadow_Proxy_Graph)(nil)
 	Pkg["Line"] = GijitShadow_InterfaceConvertTo2_Line
+	Proxy["Line"] = (*GijitShadow_Proxy_Line)(nil)
 	Pkg["LineAdder"] = GijitShadow_InterfaceConvertTo2_LineAdder
+	Proxy["LineAdder"] = (*GijitShadow_Proxy_LineAdder)(nil)
 	Pkg["LineRemover"] = GijitShadow_InterfaceConvertTo2_LineRemover
+	Proxy["LineRemover"] = (*GijitShadow_Proxy_LineRemover)(nil)
 	Pkg["Multigraph"] = GijitShadow_InterfaceConvertTo2_Multigraph
+	Proxy["Multigraph"] = (*GijitShadow_Proxy_Multigraph)(nil)
 	Pkg["MultigraphBuilder"] = GijitShadow_InterfaceConvertTo2_MultigraphBuilder
+	Proxy["MultigraphBuilder"] = (*GijitShadow_Proxy_MultigraphBuilder)(nil)
 	Pkg["Node"] = GijitShadow_InterfaceConvertTo2_Node
+	Proxy["Node"] = (*GijitShadow_Proxy_Node)(nil)
 	Pkg["NodeAdder"] = GijitShadow_InterfaceConvertTo2_NodeAdder
+	Proxy["NodeAdder"] = (*GijitShadow_Proxy_NodeAdder)(nil)
 	Pkg["NodeRemover"] = GijitShadow_InterfaceConvertTo2_NodeRemover
+	Proxy["NodeRemover"] = (*GijitShadow_Proxy_NodeRemover)(nil)
 	Pkg["Undirected"] = GijitShadow_InterfaceConvertTo2_Undirected
+	Proxy["Undirected"] = (*GijitShadow_Proxy_Undirected)(nil)
 	Pkg["UndirectedBuilder"] = GijitShadow_InterfaceConvertTo2_UndirectedBuilder
+	Proxy["UndirectedBuilder"] = (*GijitShadow_Proxy_UndirectedBuilder)(nil)
 	Pkg["UndirectedMultigraph"] = GijitShadow_InterfaceConvertTo2_UndirectedMultigraph
+	Proxy["UndirectedMultigraph"] = (*GijitShadow_Proxy_UndirectedMultigraph)(nil)
 	Pkg["UndirectedMultigraphBuilder"] = GijitShadow_InterfaceConvertTo2_UndirectedMultigraphBuilder
+	Proxy["UndirectedMultigraphBuilder"] = (*GijitShadow_Proxy_UndirectedMultigraphBuilder)(nil)
 	Pkg["UndirectedWeightedBuilder"] = GijitShadow_InterfaceConvertTo2_UndirectedWeightedBuilder
+	Proxy["UndirectedWeightedBuilder"] = (*GijitShadow_Proxy_UndirectedWeightedBuilder)(nil)
 	Pkg["UndirectedWeightedMultigraphBuilder"] = GijitShadow_InterfaceConvertTo2_UndirectedWeightedMultigraphBuilder
+	Proxy["UndirectedWeightedMultigraphBuilder"] = (*GijitShadow_Proxy_UndirectedWeightedMultigraphBuilder)(nil)
 	Pkg["Weighted"] = GijitShadow_InterfaceConvertTo2_Weighted
+	Proxy["Weighted"] = (*GijitShadow_Proxy_Weighted)(nil)
 	Pkg["WeightedBuilder"] = GijitShadow_InterfaceConvertTo2_WeightedBuilder
+	Proxy["WeightedBuilder"] = (*GijitShadow_Proxy_WeightedBuilder)(nil)
 	Pkg["WeightedDirected"] = GijitShadow_InterfaceConvertTo2_WeightedDirected
+	Proxy["WeightedDirected"] = (*GijitShadow_Proxy_WeightedDirected)(nil)
 	Pkg["WeightedDirectedMultigraph"] = GijitShadow_InterfaceConvertTo2_WeightedDirectedMultigraph
+	Proxy["WeightedDirectedMultigraph"] = (*GijitShadow_Proxy_WeightedDirectedMultigraph)(nil)
 	Pkg["WeightedEdge"] = GijitShadow_InterfaceConvertTo2_WeightedEdge
+	Proxy["WeightedEdge"] = (*GijitShadow_Proxy_WeightedEdge)(nil)
 	Pkg["WeightedEdgeAdder"] = GijitShadow_InterfaceConvertTo2_WeightedEdgeAdder
+	Proxy["WeightedEdgeAdder"] = (*GijitShadow_Proxy_WeightedEdgeAdder)(nil)
 	Pkg["WeightedLine"] = GijitShadow_InterfaceConvertTo2_WeightedLine
+	Proxy["WeightedLine"] = (*GijitShadow_Proxy_WeightedLine)(nil)
 	Pkg["WeightedLineAdder"] = GijitShadow_InterfaceConvertTo2_WeightedLineAdder
+	Proxy["WeightedLineAdder"] = (*GijitShadow_Proxy_WeightedLineAdder)(nil)
 	Pkg["WeightedMultigraph"] = GijitShadow_InterfaceConvertTo2_WeightedMultigraph
+	Proxy["WeightedMultigraph"] = (*GijitShadow_Proxy_WeightedMultigraph)(nil)
 	Pkg["WeightedMultigraphBuilder"] = GijitShadow_InterfaceConvertTo2_WeightedMultigraphBuilder
+	Proxy["WeightedMultigraphBuilder"] = (*GijitShadow_Proxy_WeightedMultigraphBuilder)(nil)
 	Pkg["WeightedUndirected"] = GijitShadow_InterfaceConvertTo2_WeightedUndirected
+	Proxy["WeightedUndirected"] = (*GijitShadow_Proxy_WeightedUndirected)(nil)
 	Pkg["WeightedUndirectedMultigraph"] = GijitShadow_InterfaceConvertTo2_WeightedUndirectedMultigraph
+	Proxy["WeightedUndirectedMultigraph"] = (*GijitShadow_Proxy_WeightedUndirectedMultigraph)(nil)
 
 }
 func GijitShadow_InterfaceConvertTo2_Builder(x interface{}) (y graph.Builder, b bool) {
@@ -55,6 +93,29 @@ func GijitShadow_InterfaceConvertTo1_Builder(x interface{}) graph.Builder {
 	return x.(graph.Builder)
 }
 
+type GijitShadow_Proxy_Builder struct {
+	Method_AddNode func(a0 graph.Node)
+	Method_NewEdge func(a0 graph.Node, a1 graph.Node) graph.Edge
+	Method_NewNode func() graph.Node
+	Method_SetEdge func(a0 graph.Edge)
+}
+
+func (p *GijitShadow_Proxy_Builder) AddNode(a0 graph.Node) {
+	p.Method_AddNode(a0)
+}
+
+func (p *GijitShadow_Proxy_Builder) NewEdge(a0 graph.Node, a1 graph.Node) graph.Edge {
+	return p.Method_NewEdge(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_Builder) NewNode() graph.Node {
+	return p.Method_NewNode()
+}
+
+func (p *GijitShadow_Proxy_Builder) SetEdge(a0 graph.Edge) {
+	p.Method_SetEdge(a0)
+}
+
 func GijitShadow_InterfaceConvertTo2_Directed(x interface{}) (y graph.Directed, b bool) {
 	y, b = x.(graph.Directed)
 	return
@@ -64,6 +125,44 @@ func GijitShadow_InterfaceConvertTo1_Directed(x interface{}) graph.Directed {
 	return x.(graph.Directed)
 }
 
+type GijitShadow_Proxy_Directed struct {
+	Method_Edge           func(a0 graph.Node, a1 graph.Node) graph.Edge
+	Method_From           func(a0 graph.Node) []graph.Node
+	Method_Has            func(a0 graph.Node) bool
+	Method_HasEdgeBetween func(a0 graph.Node, a1 graph.Node) bool
+	Method_HasEdgeFromTo  func(a0 graph.Node, a1 graph.Node) bool
+	Method_Nodes          func() []graph.Node
+	Method_To             func(a0 graph.Node) []graph.Node
+}
+
+func (p *GijitShadow_Proxy_Directed) Edge(a0 graph.Node, a1 graph.Node) graph.Edge {
+	return p.Method_Edge(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_Directed) From(a0 graph.Node) []graph.Node {
+	return p.Method_From(a0)
+}
+
+func (p *GijitShadow_Proxy_Directed) Has(a0 graph.Node) bool {
+	return p.Method_Has(a0)
+}
+
+func (p *GijitShadow_Proxy_Directed) HasEdgeBetween(a0 graph.Node, a1 graph.Node) bool {
+	return p.Method_HasEdgeBetween(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_Directed) HasEdgeFromTo(a0 graph.Node, a1 graph.Node) bool {
+	return p.Method_HasEdgeFromTo(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_Directed) Nodes() []graph.Node {
+	return p.Method_Nodes()
+}
+
+func (p *GijitShadow_Proxy_Directed) To(a0 graph.Node) []graph.Node {
+	return p.Method_To(a0)
+}
+
 func GijitShadow_InterfaceConvertTo2_DirectedBuilder(x interface{}) (y graph.DirectedBuilder, b bool) {
 	y, b = x.(graph.DirectedBuilder)
 	return
@@ -73,6 +172,64 @@ func GijitShadow_InterfaceConvertTo1_DirectedBuilder(x interface{}) graph.Direct
 	return x.(graph.DirectedBuilder)
 }
 
+type GijitShadow_Proxy_DirectedBuilder struct {
+	Method_AddNode        func(a0 graph.Node)
+	Method_Edge           func(a0 graph.Node, a1 graph.Node) graph.Edge
+	Method_From           func(a0 graph.Node) []graph.Node
+	Method_Has            func(a0 graph.Node) bool
+	Method_HasEdgeBetween func(a0 graph.Node, a1 graph.Node) bool
+	Method_HasEdgeFromTo  func(a0 graph.Node, a1 graph.Node) bool
+	Method_NewEdge        func(a0 graph.Node, a1 graph.Node) graph.Edge
+	Method_NewNode        func() graph.Node
+	Method_Nodes          func() []graph.Node
+	Method_SetEdge        func(a0 graph.Edge)
+	Method_To             func(a0 graph.Node) []graph.Node
+}
+
+func (p *GijitShadow_Proxy_DirectedBuilder) AddNode(a0 graph.Node) {
+	p.Method_AddNode(a0)
+}
+
+func (p *GijitShadow_Proxy_DirectedBuilder) Edge(a0 graph.Node, a1 graph.Node) graph.Edge {
+	return p.Method_Edge(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_DirectedBuilder) From(a0 graph.Node) []graph.Node {
+	return p.Method_From(a0)
+}
+
+func (p *GijitShadow_Proxy_DirectedBuilder) Has(a0 graph.Node) bool {
+	return p.Method_Has(a0)
+}
+
+func (p *GijitShadow_Proxy_DirectedBuilder) HasEdgeBetween(a0 graph.Node, a1 graph.Node) bool {
+	return p.Method_HasEdgeBetween(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_DirectedBuilder) HasEdgeFromTo(a0 graph.Node, a1 graph.Node) bool {
+	return p.Method_HasEdgeFromTo(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_DirectedBuilder) NewEdge(a0 graph.Node, a1 graph.Node) graph.Edge {
+	return p.Method_NewEdge(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_DirectedBuilder) NewNode() graph.Node {
+	return p.Method_NewNode()
+}
+
+func (p *GijitShadow_Proxy_DirectedBuilder) Nodes() []graph.Node {
+	return p.Method_Nodes()
+}
+
+func (p *GijitShadow_Proxy_DirectedBuilder) SetEdge(a0 graph.Edge) {
+	p.Method_SetEdge(a0)
+}
+
+func (p *GijitShadow_Proxy_DirectedBuilder) To(a0 graph.Node) []graph.Node {
+	return p.Method_To(a0)
+}
+
 func GijitShadow_InterfaceConvertTo2_DirectedMultigraph(x interface{}) (y graph.DirectedMultigraph, b bool) {
 	y, b = x.(graph.DirectedMultigraph)
 	return
@@ -82,6 +239,44 @@ func GijitShadow_InterfaceConvertTo1_DirectedMultigraph(x interface{}) graph.Dir
 	return x.(graph.DirectedMultigraph)
 }
 
+type GijitShadow_Proxy_DirectedMultigraph struct {
+	Method_From           func(a0 graph.Node) []graph.Node
+	Method_Has            func(a0 graph.Node) bool
+	Method_HasEdgeBetween func(a0 graph.Node, a1 graph.Node) bool
+	Method_HasEdgeFromTo  func(a0 graph.Node, a1 graph.Node) bool
+	Method_Lines          func(a0 graph.Node, a1 graph.Node) []graph.Line
+	Method_Nodes          func() []graph.Node
+	Method_To             func(a0 graph.Node) []graph.Node
+}
+
+func (p *GijitShadow_Proxy_DirectedMultigraph) From(a0 graph.Node) []graph.Node {
+	return p.Method_From(a0)
+}
+
+func (p *GijitShadow_Proxy_DirectedMultigraph) Has(a0 graph.Node) bool {
+	return p.Method_Has(a0)
+}
+
+func (p *GijitShadow_Proxy_DirectedMultigraph) HasEdgeBetween(a0 graph.Node, a1 graph.Node) bool {
+	return p.Method_HasEdgeBetween(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_DirectedMultigraph) HasEdgeFromTo(a0 graph.Node, a1 graph.Node) bool {
+	return p.Method_HasEdgeFromTo(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_DirectedMultigraph) Lines(a0 graph.Node, a1 graph.Node) []graph.Line {
+	return p.Method_Lines(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_DirectedMultigraph) Nodes() []graph.Node {
+	return p.Method_Nodes()
+}
+
+func (p *GijitShadow_Proxy_DirectedMultigraph) To(a0 graph.Node) []graph.Node {
+	return p.Method_To(a0)
+}
+
 func GijitShadow_InterfaceConvertTo2_DirectedMultigraphBuilder(x interface{}) (y graph.DirectedMultigraphBuilder, b bool) {
 	y, b = x.(graph.DirectedMultigraphBuilder)
 	return
@@ -91,6 +286,64 @@ func GijitShadow_InterfaceConvertTo1_DirectedMultigraphBuilder(x interface{}) gr
 	return x.(graph.DirectedMultigraphBuilder)
 }
 
+type GijitShadow_Proxy_DirectedMultigraphBuilder struct {
+	Method_AddNode        func(a0 graph.Node)
+	Method_From           func(a0 graph.Node) []graph.Node
+	Method_Has            func(a0 graph.Node) bool
+	Method_HasEdgeBetween func(a0 graph.Node, a1 graph.Node) bool
+	Method_HasEdgeFromTo  func(a0 graph.Node, a1 graph.Node) bool
+	Method_Lines          func(a0 graph.Node, a1 graph.Node) []graph.Line
+	Method_NewLine        func(a0 graph.Node, a1 graph.Node) graph.Line
+	Method_NewNode        func() graph.Node
+	Method_Nodes          func() []graph.Node
+	Method_SetLine        func(a0 graph.Line)
+	Method_To             func(a0 graph.Node) []graph.Node
+}
+
+func (p *GijitShadow_Proxy_DirectedMultigraphBuilder) AddNode(a0 graph.Node) {
+	p.Method_AddNode(a0)
+}
+
+func (p *GijitShadow_Proxy_DirectedMultigraphBuilder) From(a0 graph.Node) []graph.Node {
+	return p.Method_From(a0)
+}
+
+func (p *GijitShadow_Proxy_DirectedMultigraphBuilder) Has(a0 graph.Node) bool {
+	return p.Method_Has(a0)
+}
+
+func (p *GijitShadow_Proxy_DirectedMultigraphBuilder) HasEdgeBetween(a0 graph.Node, a1 graph.Node) bool {
+	return p.Method_HasEdgeBetween(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_DirectedMultigraphBuilder) HasEdgeFromTo(a0 graph.Node, a1 graph.Node) bool {
+	return p.Method_HasEdgeFromTo(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_DirectedMultigraphBuilder) Lines(a0 graph.Node, a1 graph.Node) []graph.Line {
+	return p.Method_Lines(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_DirectedMultigraphBuilder) NewLine(a0 graph.Node, a1 graph.Node) graph.Line {
+	return p.Method_NewLine(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_DirectedMultigraphBuilder) NewNode() graph.Node {
+	return p.Method_NewNode()
+}
+
+func (p *GijitShadow_Proxy_DirectedMultigraphBuilder) Nodes() []graph.Node {
+	return p.Method_Nodes()
+}
+
+func (p *GijitShadow_Proxy_DirectedMultigraphBuilder) SetLine(a0 graph.Line) {
+	p.Method_SetLine(a0)
+}
+
+func (p *GijitShadow_Proxy_DirectedMultigraphBuilder) To(a0 graph.Node) []graph.Node {
+	return p.Method_To(a0)
+}
+
 func GijitShadow_InterfaceConvertTo2_DirectedWeightedBuilder(x interface{}) (y graph.DirectedWeightedBuilder, b bool) {
 	y, b = x.(graph.DirectedWeightedBuilder)
 	return
@@ -100,6 +353,64 @@ func GijitShadow_InterfaceConvertTo1_DirectedWeightedBuilder(x interface{}) grap
 	return x.(graph.DirectedWeightedBuilder)
 }
 
+type GijitShadow_Proxy_DirectedWeightedBuilder struct {
+	Method_AddNode         func(a0 graph.Node)
+	Method_Edge            func(a0 graph.Node, a1 graph.Node) graph.Edge
+	Method_From            func(a0 graph.Node) []graph.Node
+	Method_Has             func(a0 graph.Node) bool
+	Method_HasEdgeBetween  func(a0 graph.Node, a1 graph.Node) bool
+	Method_HasEdgeFromTo   func(a0 graph.Node, a1 graph.Node) bool
+	Method_NewNode         func() graph.Node
+	Method_NewWeightedEdge func(a0 graph.Node, a1 graph.Node, a2 float64) graph.WeightedEdge
+	Method_Nodes           func() []graph.Node
+	Method_SetWeightedEdge func(a0 graph.WeightedEdge)
+	Method_To              func(a0 graph.Node) []graph.Node
+}
+
+func (p *GijitShadow_Proxy_DirectedWeightedBuilder) AddNode(a0 graph.Node) {
+	p.Method_AddNode(a0)
+}
+
+func (p *GijitShadow_Proxy_DirectedWeightedBuilder) Edge(a0 graph.Node, a1 graph.Node) graph.Edge {
+	return p.Method_Edge(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_DirectedWeightedBuilder) From(a0 graph.Node) []graph.Node {
+	return p.Method_From(a0)
+}
+
+func (p *GijitShadow_Proxy_DirectedWeightedBuilder) Has(a0 graph.Node) bool {
+	return p.Method_Has(a0)
+}
+
+func (p *GijitShadow_Proxy_DirectedWeightedBuilder) HasEdgeBetween(a0 graph.Node, a1 graph.Node) bool {
+	return p.Method_HasEdgeBetween(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_DirectedWeightedBuilder) HasEdgeFromTo(a0 graph.Node, a1 graph.Node) bool {
+	return p.Method_HasEdgeFromTo(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_DirectedWeightedBuilder) NewNode() graph.Node {
+	return p.Method_NewNode()
+}
+
+func (p *GijitShadow_Proxy_DirectedWeightedBuilder) NewWeightedEdge(a0 graph.Node, a1 graph.Node, a2 float64) graph.WeightedEdge {
+	return p.Method_NewWeightedEdge(a0, a1, a2)
+}
+
+func (p *GijitShadow_Proxy_DirectedWeightedBuilder) Nodes() []graph.Node {
+	return p.Method_Nodes()
+}
+
+func (p *GijitShadow_Proxy_DirectedWeightedBuilder) SetWeightedEdge(a0 graph.WeightedEdge) {
+	p.Method_SetWeightedEdge(a0)
+}
+
+func (p *GijitShadow_Proxy_DirectedWeightedBuilder) To(a0 graph.Node) []graph.Node {
+	return p.Method_To(a0)
+}
+
 func GijitShadow_InterfaceConvertTo2_DirectedWeightedMultigraphBuilder(x interface{}) (y graph.DirectedWeightedMultigraphBuilder, b bool) {
 	y, b = x.(graph.DirectedWeightedMultigraphBuilder)
 	return
@@ -109,6 +420,64 @@ func GijitShadow_InterfaceConvertTo1_DirectedWeightedMultigraphBuilder(x interfa
 	return x.(graph.DirectedWeightedMultigraphBuilder)
 }
 
+type GijitShadow_Proxy_DirectedWeightedMultigraphBuilder struct {
+	Method_AddNode         func(a0 graph.Node)
+	Method_From            func(a0 graph.Node) []graph.Node
+	Method_Has             func(a0 graph.Node) bool
+	Method_HasEdgeBetween  func(a0 graph.Node, a1 graph.Node) bool
+	Method_HasEdgeFromTo   func(a0 graph.Node, a1 graph.Node) bool
+	Method_Lines           func(a0 graph.Node, a1 graph.Node) []graph.Line
+	Method_NewNode         func() graph.Node
+	Method_NewWeightedLine func(a0 graph.Node, a1 graph.Node, a2 float64) graph.WeightedLine
+	Method_Nodes           func() []graph.Node
+	Method_SetWeightedLine func(a0 graph.WeightedLine)
+	Method_To              func(a0 graph.Node) []graph.Node
+}
+
+func (p *GijitShadow_Proxy_DirectedWeightedMultigraphBuilder) AddNode(a0 graph.Node) {
+	p.Method_AddNode(a0)
+}
+
+func (p *GijitShadow_Proxy_DirectedWeightedMultigraphBuilder) From(a0 graph.Node) []graph.Node {
+	return p.Method_From(a0)
+}
+
+func (p *GijitShadow_Proxy_DirectedWeightedMultigraphBuilder) Has(a0 graph.Node) bool {
+	return p.Method_Has(a0)
+}
+
+func (p *GijitShadow_Proxy_DirectedWeightedMultigraphBuilder) HasEdgeBetween(a0 graph.Node, a1 graph.Node) bool {
+	return p.Method_HasEdgeBetween(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_DirectedWeightedMultigraphBuilder) HasEdgeFromTo(a0 graph.Node, a1 graph.Node) bool {
+	return p.Method_HasEdgeFromTo(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_DirectedWeightedMultigraphBuilder) Lines(a0 graph.Node, a1 graph.Node) []graph.Line {
+	return p.Method_Lines(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_DirectedWeightedMultigraphBuilder) NewNode() graph.Node {
+	return p.Method_NewNode()
+}
+
+func (p *GijitShadow_Proxy_DirectedWeightedMultigraphBuilder) NewWeightedLine(a0 graph.Node, a1 graph.Node, a2 float64) graph.WeightedLine {
+	return p.Method_NewWeightedLine(a0, a1, a2)
+}
+
+func (p *GijitShadow_Proxy_DirectedWeightedMultigraphBuilder) Nodes() []graph.Node {
+	return p.Method_Nodes()
+}
+
+func (p *GijitShadow_Proxy_DirectedWeightedMultigraphBuilder) SetWeightedLine(a0 graph.WeightedLine) {
+	p.Method_SetWeightedLine(a0)
+}
+
+func (p *GijitShadow_Proxy_DirectedWeightedMultigraphBuilder) To(a0 graph.Node) []graph.Node {
+	return p.Method_To(a0)
+}
+
 func GijitShadow_InterfaceConvertTo2_Edge(x interface{}) (y graph.Edge, b bool) {
 	y, b = x.(graph.Edge)
 	return
@@ -118,6 +487,19 @@ func GijitShadow_InterfaceConvertTo1_Edge(x interface{}) graph.Edge {
 	return x.(graph.Edge)
 }
 
+type GijitShadow_Proxy_Edge struct {
+	Method_From func() graph.Node
+	Method_To   func() graph.Node
+}
+
+func (p *GijitShadow_Proxy_Edge) From() graph.Node {
+	return p.Method_From()
+}
+
+func (p *GijitShadow_Proxy_Edge) To() graph.Node {
+	return p.Method_To()
+}
+
 func GijitShadow_InterfaceConvertTo2_EdgeAdder(x interface{}) (y graph.EdgeAdder, b bool) {
 	y, b = x.(graph.EdgeAdder)
 	return
@@ -127,6 +509,19 @@ func GijitShadow_InterfaceConvertTo1_EdgeAdder(x interface{}) graph.EdgeAdder {
 	return x.(graph.EdgeAdder)
 }
 
+type GijitShadow_Proxy_EdgeAdder struct {
+	Method_NewEdge func(a0 graph.Node, a1 graph.Node) graph.Edge
+	Method_SetEdge func(a0 graph.Edge)
+}
+
+func (p *GijitShadow_Proxy_EdgeAdder) NewEdge(a0 graph.Node, a1 graph.Node) graph.Edge {
+	return p.Method_NewEdge(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_EdgeAdder) SetEdge(a0 graph.Edge) {
+	p.Method_SetEdge(a0)
+}
+
 func GijitShadow_InterfaceConvertTo2_EdgeRemover(x interface{}) (y graph.EdgeRemover, b bool) {
 	y, b = x.(graph.EdgeRemover)
 	return
@@ -136,6 +531,14 @@ func GijitShadow_InterfaceConvertTo1_EdgeRemover(x interface{}) graph.EdgeRemove
 	return x.(graph.EdgeRemover)
 }
 
+type GijitShadow_Proxy_EdgeRemover struct {
+	Method_RemoveEdge func(a0 graph.Edge)
+}
+
+func (p *GijitShadow_Proxy_EdgeRemover) RemoveEdge(a0 graph.Edge) {
+	p.Method_RemoveEdge(a0)
+}
+
 func GijitShadow_InterfaceConvertTo2_Graph(x interface{}) (y graph.Graph, b bool) {
 	y, b = x.(graph.Graph)
 	return
@@ -145,6 +548,34 @@ func GijitShadow_InterfaceConvertTo1_Graph(x interface{}) graph.Graph {
 	return x.(graph.Graph)
 }
 
+type GijitShadow_Proxy_Graph struct {
+	Method_Edge           func(a0 graph.Node, a1 graph.Node) graph.Edge
+	Method_From           func(a0 graph.Node) []graph.Node
+	Method_Has            func(a0 graph.Node) bool
+	Method_HasEdgeBetween func(a0 graph.Node, a1 graph.Node) bool
+	Method_Nodes          func() []graph.Node
+}
+
+func (p *GijitShadow_Proxy_Graph) Edge(a0 graph.Node, a1 graph.Node) graph.Edge {
+	return p.Method_Edge(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_Graph) From(a0 graph.Node) []graph.Node {
+	return p.Method_From(a0)
+}
+
+func (p *GijitShadow_Proxy_Graph) Has(a0 graph.Node) bool {
+	return p.Method_Has(a0)
+}
+
+func (p *GijitShadow_Proxy_Graph) HasEdgeBetween(a0 graph.Node, a1 graph.Node) bool {
+	return p.Method_HasEdgeBetween(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_Graph) Nodes() []graph.Node {
+	return p.Method_Nodes()
+}
+
 func GijitShadow_InterfaceConvertTo2_Line(x interface{}) (y graph.Line, b bool) {
 	y, b = x.(graph.Line)
 	return
@@ -154,6 +585,24 @@ func GijitShadow_InterfaceConvertTo1_Line(x interface{}) graph.Line {
 	return x.(graph.Line)
 }
 
+type GijitShadow_Proxy_Line struct {
+	Method_From func() graph.Node
+	Method_ID   func() int64
+	Method_To   func() graph.Node
+}
+
+func (p *GijitShadow_Proxy_Line) From() graph.Node {
+	return p.Method_From()
+}
+
+func (p *GijitShadow_Proxy_Line) ID() int64 {
+	return p.Method_ID()
+}
+
+func (p *GijitShadow_Proxy_Line) To() graph.Node {
+	return p.Method_To()
+}
+
 func GijitShadow_InterfaceConvertTo2_LineAdder(x interface{}) (y graph.LineAdder, b bool) {
 	y, b = x.(graph.LineAdder)
 	return
@@ -163,6 +612,19 @@ func GijitShadow_InterfaceConvertTo1_LineAdder(x interface{}) graph.LineAdder {
 	return x.(graph.LineAdder)
 }
 
+type GijitShadow_Proxy_LineAdder struct {
+	Method_NewLine func(a0 graph.Node, a1 graph.Node) graph.Line
+	Method_SetLine func(a0 graph.Line)
+}
+
+func (p *GijitShadow_Proxy_LineAdder) NewLine(a0 graph.Node, a1 graph.Node) graph.Line {
+	return p.Method_NewLine(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_LineAdder) SetLine(a0 graph.Line) {
+	p.Method_SetLine(a0)
+}
+
 func GijitShadow_InterfaceConvertTo2_LineRemover(x interface{}) (y graph.LineRemover, b bool) {
 	y, b = x.(graph.LineRemover)
 	return
@@ -172,6 +634,14 @@ func GijitShadow_InterfaceConvertTo1_LineRemover(x interface{}) graph.LineRemove
 	return x.(graph.LineRemover)
 }
 
+type GijitShadow_Proxy_LineRemover struct {
+	Method_RemoveLine func(a0 graph.Line)
+}
+
+func (p *GijitShadow_Proxy_LineRemover) RemoveLine(a0 graph.Line) {
+	p.Method_RemoveLine(a0)
+}
+
 func GijitShadow_InterfaceConvertTo2_Multigraph(x interface{}) (y graph.Multigraph, b bool) {
 	y, b = x.(graph.Multigraph)
 	return
@@ -181,6 +651,34 @@ func GijitShadow_InterfaceConvertTo1_Multigraph(x interface{}) graph.Multigraph
 	return x.(graph.Multigraph)
 }
 
+type GijitShadow_Proxy_Multigraph struct {
+	Method_From           func(a0 graph.Node) []graph.Node
+	Method_Has            func(a0 graph.Node) bool
+	Method_HasEdgeBetween func(a0 graph.Node, a1 graph.Node) bool
+	Method_Lines          func(a0 graph.Node, a1 graph.Node) []graph.Line
+	Method_Nodes          func() []graph.Node
+}
+
+func (p *GijitShadow_Proxy_Multigraph) From(a0 graph.Node) []graph.Node {
+	return p.Method_From(a0)
+}
+
+func (p *GijitShadow_Proxy_Multigraph) Has(a0 graph.Node) bool {
+	return p.Method_Has(a0)
+}
+
+func (p *GijitShadow_Proxy_Multigraph) HasEdgeBetween(a0 graph.Node, a1 graph.Node) bool {
+	return p.Method_HasEdgeBetween(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_Multigraph) Lines(a0 graph.Node, a1 graph.Node) []graph.Line {
+	return p.Method_Lines(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_Multigraph) Nodes() []graph.Node {
+	return p.Method_Nodes()
+}
+
 func GijitShadow_InterfaceConvertTo2_MultigraphBuilder(x interface{}) (y graph.MultigraphBuilder, b bool) {
 	y, b = x.(graph.MultigraphBuilder)
 	return
@@ -190,6 +688,29 @@ func GijitShadow_InterfaceConvertTo1_MultigraphBuilder(x interface{}) graph.Mult
 	return x.(graph.MultigraphBuilder)
 }
 
+type GijitShadow_Proxy_MultigraphBuilder struct {
+	Method_AddNode func(a0 graph.Node)
+	Method_NewLine func(a0 graph.Node, a1 graph.Node) graph.Line
+	Method_NewNode func() graph.Node
+	Method_SetLine func(a0 graph.Line)
+}
+
+func (p *GijitShadow_Proxy_MultigraphBuilder) AddNode(a0 graph.Node) {
+	p.Method_AddNode(a0)
+}
+
+func (p *GijitShadow_Proxy_MultigraphBuilder) NewLine(a0 graph.Node, a1 graph.Node) graph.Line {
+	return p.Method_NewLine(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_MultigraphBuilder) NewNode() graph.Node {
+	return p.Method_NewNode()
+}
+
+func (p *GijitShadow_Proxy_MultigraphBuilder) SetLine(a0 graph.Line) {
+	p.Method_SetLine(a0)
+}
+
 func GijitShadow_InterfaceConvertTo2_Node(x interface{}) (y graph.Node, b bool) {
 	y, b = x.(graph.Node)
 	return
@@ -199,6 +720,14 @@ func GijitShadow_InterfaceConvertTo1_Node(x interface{}) graph.Node {
 	return x.(graph.Node)
 }
 
+type GijitShadow_Proxy_Node struct {
+	Method_ID func() int64
+}
+
+func (p *GijitShadow_Proxy_Node) ID() int64 {
+	return p.Method_ID()
+}
+
 func GijitShadow_InterfaceConvertTo2_NodeAdder(x interface{}) (y graph.NodeAdder, b bool) {
 	y, b = x.(graph.NodeAdder)
 	return
@@ -208,6 +737,19 @@ func GijitShadow_InterfaceConvertTo1_NodeAdder(x interface{}) graph.NodeAdder {
 	return x.(graph.NodeAdder)
 }
 
+type GijitShadow_Proxy_NodeAdder struct {
+	Method_AddNode func(a0 graph.Node)
+	Method_NewNode func() graph.Node
+}
+
+func (p *GijitShadow_Proxy_NodeAdder) AddNode(a0 graph.Node) {
+	p.Method_AddNode(a0)
+}
+
+func (p *GijitShadow_Proxy_NodeAdder) NewNode() graph.Node {
+	return p.Method_NewNode()
+}
+
 func GijitShadow_InterfaceConvertTo2_NodeRemover(x interface{}) (y graph.NodeRemover, b bool) {
 	y, b = x.(graph.NodeRemover)
 	return
@@ -217,6 +759,14 @@ func GijitShadow_InterfaceConvertTo1_NodeRemover(x interface{}) graph.NodeRemove
 	return x.(graph.NodeRemover)
 }
 
+type GijitShadow_Proxy_NodeRemover struct {
+	Method_RemoveNode func(a0 graph.Node)
+}
+
+func (p *GijitShadow_Proxy_NodeRemover) RemoveNode(a0 graph.Node) {
+	p.Method_RemoveNode(a0)
+}
+
 func GijitShadow_NewStruct_Undirect() *graph.Undirect {
 	return &graph.Undirect{}
 }
@@ -234,6 +784,39 @@ func GijitShadow_InterfaceConvertTo1_Undirected(x interface{}) graph.Undirected
 	return x.(graph.Undirected)
 }
 
+type GijitShadow_Proxy_Undirected struct {
+	Method_Edge           func(a0 graph.Node, a1 graph.Node) graph.Edge
+	Method_EdgeBetween    func(a0 graph.Node, a1 graph.Node) graph.Edge
+	Method_From           func(a0 graph.Node) []graph.Node
+	Method_Has            func(a0 graph.Node) bool
+	Method_HasEdgeBetween func(a0 graph.Node, a1 graph.Node) bool
+	Method_Nodes          func() []graph.Node
+}
+
+func (p *GijitShadow_Proxy_Undirected) Edge(a0 graph.Node, a1 graph.Node) graph.Edge {
+	return p.Method_Edge(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_Undirected) EdgeBetween(a0 graph.Node, a1 graph.Node) graph.Edge {
+	return p.Method_EdgeBetween(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_Undirected) From(a0 graph.Node) []graph.Node {
+	return p.Method_From(a0)
+}
+
+func (p *GijitShadow_Proxy_Undirected) Has(a0 graph.Node) bool {
+	return p.Method_Has(a0)
+}
+
+func (p *GijitShadow_Proxy_Undirected) HasEdgeBetween(a0 graph.Node, a1 graph.Node) bool {
+	return p.Method_HasEdgeBetween(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_Undirected) Nodes() []graph.Node {
+	return p.Method_Nodes()
+}
+
 func GijitShadow_InterfaceConvertTo2_UndirectedBuilder(x interface{}) (y graph.UndirectedBuilder, b bool) {
 	y, b = x.(graph.UndirectedBuilder)
 	return
@@ -243,6 +826,59 @@ func GijitShadow_InterfaceConvertTo1_UndirectedBuilder(x interface{}) graph.Undi
 	return x.(graph.UndirectedBuilder)
 }
 
+type GijitShadow_Proxy_UndirectedBuilder struct {
+	Method_AddNode        func(a0 graph.Node)
+	Method_Edge           func(a0 graph.Node, a1 graph.Node) graph.Edge
+	Method_EdgeBetween    func(a0 graph.Node, a1 graph.Node) graph.Edge
+	Method_From           func(a0 graph.Node) []graph.Node
+	Method_Has            func(a0 graph.Node) bool
+	Method_HasEdgeBetween func(a0 graph.Node, a1 graph.Node) bool
+	Method_NewEdge        func(a0 graph.Node, a1 graph.Node) graph.Edge
+	Method_NewNode        func() graph.Node
+	Method_Nodes          func() []graph.Node
+	Method_SetEdge        func(a0 graph.Edge)
+}
+
+func (p *GijitShadow_Proxy_UndirectedBuilder) AddNode(a0 graph.Node) {
+	p.Method_AddNode(a0)
+}
+
+func (p *GijitShadow_Proxy_UndirectedBuilder) Edge(a0 graph.Node, a1 graph.Node) graph.Edge {
+	return p.Method_Edge(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_UndirectedBuilder) EdgeBetween(a0 graph.Node, a1 graph.Node) graph.Edge {
+	return p.Method_EdgeBetween(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_UndirectedBuilder) From(a0 graph.Node) []graph.Node {
+	return p.Method_From(a0)
+}
+
+func (p *GijitShadow_Proxy_UndirectedBuilder) Has(a0 graph.Node) bool {
+	return p.Method_Has(a0)
+}
+
+func (p *GijitShadow_Proxy_UndirectedBuilder) HasEdgeBetween(a0 graph.Node, a1 graph.Node) bool {
+	return p.Method_HasEdgeBetween(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_UndirectedBuilder) NewEdge(a0 graph.Node, a1 graph.Node) graph.Edge {
+	return p.Method_NewEdge(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_UndirectedBuilder) NewNode() graph.Node {
+	return p.Method_NewNode()
+}
+
+func (p *GijitShadow_Proxy_UndirectedBuilder) Nodes() []graph.Node {
+	return p.Method_Nodes()
+}
+
+func (p *GijitShadow_Proxy_UndirectedBuilder) SetEdge(a0 graph.Edge) {
+	p.Method_SetEdge(a0)
+}
+
 func GijitShadow_InterfaceConvertTo2_UndirectedMultigraph(x interface{}) (y graph.UndirectedMultigraph, b bool) {
 	y, b = x.(graph.UndirectedMultigraph)
 	return
@@ -252,6 +888,39 @@ func GijitShadow_InterfaceConvertTo1_UndirectedMultigraph(x interface{}) graph.U
 	return x.(graph.UndirectedMultigraph)
 }
 
+type GijitShadow_Proxy_UndirectedMultigraph struct {
+	Method_From           func(a0 graph.Node) []graph.Node
+	Method_Has            func(a0 graph.Node) bool
+	Method_HasEdgeBetween func(a0 graph.Node, a1 graph.Node) bool
+	Method_Lines          func(a0 graph.Node, a1 graph.Node) []graph.Line
+	Method_LinesBetween   func(a0 graph.Node, a1 graph.Node) []graph.Line
+	Method_Nodes          func() []graph.Node
+}
+
+func (p *GijitShadow_Proxy_UndirectedMultigraph) From(a0 graph.Node) []graph.Node {
+	return p.Method_From(a0)
+}
+
+func (p *GijitShadow_Proxy_UndirectedMultigraph) Has(a0 graph.Node) bool {
+	return p.Method_Has(a0)
+}
+
+func (p *GijitShadow_Proxy_UndirectedMultigraph) HasEdgeBetween(a0 graph.Node, a1 graph.Node) bool {
+	return p.Method_HasEdgeBetween(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_UndirectedMultigraph) Lines(a0 graph.Node, a1 graph.Node) []graph.Line {
+	return p.Method_Lines(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_UndirectedMultigraph) LinesBetween(a0 graph.Node, a1 graph.Node) []graph.Line {
+	return p.Method_LinesBetween(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_UndirectedMultigraph) Nodes() []graph.Node {
+	return p.Method_Nodes()
+}
+
 func GijitShadow_InterfaceConvertTo2_UndirectedMultigraphBuilder(x interface{}) (y graph.UndirectedMultigraphBuilder, b bool) {
 	y, b = x.(graph.UndirectedMultigraphBuilder)
 	return
@@ -261,6 +930,59 @@ func GijitShadow_InterfaceConvertTo1_UndirectedMultigraphBuilder(x interface{})
 	return x.(graph.UndirectedMultigraphBuilder)
 }
 
+type GijitShadow_Proxy_UndirectedMultigraphBuilder struct {
+	Method_AddNode        func(a0 graph.Node)
+	Method_From           func(a0 graph.Node) []graph.Node
+	Method_Has            func(a0 graph.Node) bool
+	Method_HasEdgeBetween func(a0 graph.Node, a1 graph.Node) bool
+	Method_Lines          func(a0 graph.Node, a1 graph.Node) []graph.Line
+	Method_LinesBetween   func(a0 graph.Node, a1 graph.Node) []graph.Line
+	Method_NewLine        func(a0 graph.Node, a1 graph.Node) graph.Line
+	Method_NewNode        func() graph.Node
+	Method_Nodes          func() []graph.Node
+	Method_SetLine        func(a0 graph.Line)
+}
+
+func (p *GijitShadow_Proxy_UndirectedMultigraphBuilder) AddNode(a0 graph.Node) {
+	p.Method_AddNode(a0)
+}
+
+func (p *GijitShadow_Proxy_UndirectedMultigraphBuilder) From(a0 graph.Node) []graph.Node {
+	return p.Method_From(a0)
+}
+
+func (p *GijitShadow_Proxy_UndirectedMultigraphBuilder) Has(a0 graph.Node) bool {
+	return p.Method_Has(a0)
+}
+
+func (p *GijitShadow_Proxy_UndirectedMultigraphBuilder) HasEdgeBetween(a0 graph.Node, a1 graph.Node) bool {
+	return p.Method_HasEdgeBetween(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_UndirectedMultigraphBuilder) Lines(a0 graph.Node, a1 graph.Node) []graph.Line {
+	return p.Method_Lines(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_UndirectedMultigraphBuilder) LinesBetween(a0 graph.Node, a1 graph.Node) []graph.Line {
+	return p.Method_LinesBetween(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_UndirectedMultigraphBuilder) NewLine(a0 graph.Node, a1 graph.Node) graph.Line {
+	return p.Method_NewLine(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_UndirectedMultigraphBuilder) NewNode() graph.Node {
+	return p.Method_NewNode()
+}
+
+func (p *GijitShadow_Proxy_UndirectedMultigraphBuilder) Nodes() []graph.Node {
+	return p.Method_Nodes()
+}
+
+func (p *GijitShadow_Proxy_UndirectedMultigraphBuilder) SetLine(a0 graph.Line) {
+	p.Method_SetLine(a0)
+}
+
 func GijitShadow_InterfaceConvertTo2_UndirectedWeightedBuilder(x interface{}) (y graph.UndirectedWeightedBuilder, b bool) {
 	y, b = x.(graph.UndirectedWeightedBuilder)
 	return
@@ -270,6 +992,59 @@ func GijitShadow_InterfaceConvertTo1_UndirectedWeightedBuilder(x interface{}) gr
 	return x.(graph.UndirectedWeightedBuilder)
 }
 
+type GijitShadow_Proxy_UndirectedWeightedBuilder struct {
+	Method_AddNode         func(a0 graph.Node)
+	Method_Edge            func(a0 graph.Node, a1 graph.Node) graph.Edge
+	Method_EdgeBetween     func(a0 graph.Node, a1 graph.Node) graph.Edge
+	Method_From            func(a0 graph.Node) []graph.Node
+	Method_Has             func(a0 graph.Node) bool
+	Method_HasEdgeBetween  func(a0 graph.Node, a1 graph.Node) bool
+	Method_NewNode         func() graph.Node
+	Method_NewWeightedEdge func(a0 graph.Node, a1 graph.Node, a2 float64) graph.WeightedEdge
+	Method_Nodes           func() []graph.Node
+	Method_SetWeightedEdge func(a0 graph.WeightedEdge)
+}
+
+func (p *GijitShadow_Proxy_UndirectedWeightedBuilder) AddNode(a0 graph.Node) {
+	p.Method_AddNode(a0)
+}
+
+func (p *GijitShadow_Proxy_UndirectedWeightedBuilder) Edge(a0 graph.Node, a1 graph.Node) graph.Edge {
+	return p.Method_Edge(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_UndirectedWeightedBuilder) EdgeBetween(a0 graph.Node, a1 graph.Node) graph.Edge {
+	return p.Method_EdgeBetween(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_UndirectedWeightedBuilder) From(a0 graph.Node) []graph.Node {
+	return p.Method_From(a0)
+}
+
+func (p *GijitShadow_Proxy_UndirectedWeightedBuilder) Has(a0 graph.Node) bool {
+	return p.Method_Has(a0)
+}
+
+func (p *GijitShadow_Proxy_UndirectedWeightedBuilder) HasEdgeBetween(a0 graph.Node, a1 graph.Node) bool {
+	return p.Method_HasEdgeBetween(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_UndirectedWeightedBuilder) NewNode() graph.Node {
+	return p.Method_NewNode()
+}
+
+func (p *GijitShadow_Proxy_UndirectedWeightedBuilder) NewWeightedEdge(a0 graph.Node, a1 graph.Node, a2 float64) graph.WeightedEdge {
+	return p.Method_NewWeightedEdge(a0, a1, a2)
+}
+
+func (p *GijitShadow_Proxy_UndirectedWeightedBuilder) Nodes() []graph.Node {
+	return p.Method_Nodes()
+}
+
+func (p *GijitShadow_Proxy_UndirectedWeightedBuilder) SetWeightedEdge(a0 graph.WeightedEdge) {
+	p.Method_SetWeightedEdge(a0)
+}
+
 func GijitShadow_InterfaceConvertTo2_UndirectedWeightedMultigraphBuilder(x interface{}) (y graph.UndirectedWeightedMultigraphBuilder, b bool) {
 	y, b = x.(graph.UndirectedWeightedMultigraphBuilder)
 	return
@@ -279,6 +1054,59 @@ func GijitShadow_InterfaceConvertTo1_UndirectedWeightedMultigraphBuilder(x inter
 	return x.(graph.UndirectedWeightedMultigraphBuilder)
 }
 
+type GijitShadow_Proxy_UndirectedWeightedMultigraphBuilder struct {
+	Method_AddNode         func(a0 graph.Node)
+	Method_From            func(a0 graph.Node) []graph.Node
+	Method_Has             func(a0 graph.Node) bool
+	Method_HasEdgeBetween  func(a0 graph.Node, a1 graph.Node) bool
+	Method_Lines           func(a0 graph.Node, a1 graph.Node) []graph.Line
+	Method_LinesBetween    func(a0 graph.Node, a1 graph.Node) []graph.Line
+	Method_NewNode         func() graph.Node
+	Method_NewWeightedLine func(a0 graph.Node, a1 graph.Node, a2 float64) graph.WeightedLine
+	Method_Nodes           func() []graph.Node
+	Method_SetWeightedLine func(a0 graph.WeightedLine)
+}
+
+func (p *GijitShadow_Proxy_UndirectedWeightedMultigraphBuilder) AddNode(a0 graph.Node) {
+	p.Method_AddNode(a0)
+}
+
+func (p *GijitShadow_Proxy_UndirectedWeightedMultigraphBuilder) From(a0 graph.Node) []graph.Node {
+	return p.Method_From(a0)
+}
+
+func (p *GijitShadow_Proxy_UndirectedWeightedMultigraphBuilder) Has(a0 graph.Node) bool {
+	return p.Method_Has(a0)
+}
+
+func (p *GijitShadow_Proxy_UndirectedWeightedMultigraphBuilder) HasEdgeBetween(a0 graph.Node, a1 graph.Node) bool {
+	return p.Method_HasEdgeBetween(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_UndirectedWeightedMultigraphBuilder) Lines(a0 graph.Node, a1 graph.Node) []graph.Line {
+	return p.Method_Lines(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_UndirectedWeightedMultigraphBuilder) LinesBetween(a0 graph.Node, a1 graph.Node) []graph.Line {
+	return p.Method_LinesBetween(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_UndirectedWeightedMultigraphBuilder) NewNode() graph.Node {
+	return p.Method_NewNode()
+}
+
+func (p *GijitShadow_Proxy_UndirectedWeightedMultigraphBuilder) NewWeightedLine(a0 graph.Node, a1 graph.Node, a2 float64) graph.WeightedLine {
+	return p.Method_NewWeightedLine(a0, a1, a2)
+}
+
+func (p *GijitShadow_Proxy_UndirectedWeightedMultigraphBuilder) Nodes() []graph.Node {
+	return p.Method_Nodes()
+}
+
+func (p *GijitShadow_Proxy_UndirectedWeightedMultigraphBuilder) SetWeightedLine(a0 graph.WeightedLine) {
+	p.Method_SetWeightedLine(a0)
+}
+
 func GijitShadow_InterfaceConvertTo2_Weighted(x interface{}) (y graph.Weighted, b bool) {
 	y, b = x.(graph.Weighted)
 	return
@@ -288,6 +1116,44 @@ func GijitShadow_InterfaceConvertTo1_Weighted(x interface{}) graph.Weighted {
 	return x.(graph.Weighted)
 }
 
+type GijitShadow_Proxy_Weighted struct {
+	Method_Edge           func(a0 graph.Node, a1 graph.Node) graph.Edge
+	Method_From           func(a0 graph.Node) []graph.Node
+	Method_Has            func(a0 graph.Node) bool
+	Method_HasEdgeBetween func(a0 graph.Node, a1 graph.Node) bool
+	Method_Nodes          func() []graph.Node
+	Method_Weight         func(a0 graph.Node, a1 graph.Node) (float64, bool)
+	Method_WeightedEdge   func(a0 graph.Node, a1 graph.Node) graph.WeightedEdge
+}
+
+func (p *GijitShadow_Proxy_Weighted) Edge(a0 graph.Node, a1 graph.Node) graph.Edge {
+	return p.Method_Edge(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_Weighted) From(a0 graph.Node) []graph.Node {
+	return p.Method_From(a0)
+}
+
+func (p *GijitShadow_Proxy_Weighted) Has(a0 graph.Node) bool {
+	return p.Method_Has(a0)
+}
+
+func (p *GijitShadow_Proxy_Weighted) HasEdgeBetween(a0 graph.Node, a1 graph.Node) bool {
+	return p.Method_HasEdgeBetween(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_Weighted) Nodes() []graph.Node {
+	return p.Method_Nodes()
+}
+
+func (p *GijitShadow_Proxy_Weighted) Weight(a0 graph.Node, a1 graph.Node) (float64, bool) {
+	return p.Method_Weight(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_Weighted) WeightedEdge(a0 graph.Node, a1 graph.Node) graph.WeightedEdge {
+	return p.Method_WeightedEdge(a0, a1)
+}
+
 func GijitShadow_InterfaceConvertTo2_WeightedBuilder(x interface{}) (y graph.WeightedBuilder, b bool) {
 	y, b = x.(graph.WeightedBuilder)
 	return
@@ -297,6 +1163,29 @@ func GijitShadow_InterfaceConvertTo1_WeightedBuilder(x interface{}) graph.Weight
 	return x.(graph.WeightedBuilder)
 }
 
+type GijitShadow_Proxy_WeightedBuilder struct {
+	Method_AddNode         func(a0 graph.Node)
+	Method_NewNode         func() graph.Node
+	Method_NewWeightedEdge func(a0 graph.Node, a1 graph.Node, a2 float64) graph.WeightedEdge
+	Method_SetWeightedEdge func(a0 graph.WeightedEdge)
+}
+
+func (p *GijitShadow_Proxy_WeightedBuilder) AddNode(a0 graph.Node) {
+	p.Method_AddNode(a0)
+}
+
+func (p *GijitShadow_Proxy_WeightedBuilder) NewNode() graph.Node {
+	return p.Method_NewNode()
+}
+
+func (p *GijitShadow_Proxy_WeightedBuilder) NewWeightedEdge(a0 graph.Node, a1 graph.Node, a2 float64) graph.WeightedEdge {
+	return p.Method_NewWeightedEdge(a0, a1, a2)
+}
+
+func (p *GijitShadow_Proxy_WeightedBuilder) SetWeightedEdge(a0 graph.WeightedEdge) {
+	p.Method_SetWeightedEdge(a0)
+}
+
 func GijitShadow_InterfaceConvertTo2_WeightedDirected(x interface{}) (y graph.WeightedDirected, b bool) {
 	y, b = x.(graph.WeightedDirected)
 	return
@@ -306,6 +1195,54 @@ func GijitShadow_InterfaceConvertTo1_WeightedDirected(x interface{}) graph.Weigh
 	return x.(graph.WeightedDirected)
 }
 
+type GijitShadow_Proxy_WeightedDirected struct {
+	Method_Edge           func(a0 graph.Node, a1 graph.Node) graph.Edge
+	Method_From           func(a0 graph.Node) []graph.Node
+	Method_Has            func(a0 graph.Node) bool
+	Method_HasEdgeBetween func(a0 graph.Node, a1 graph.Node) bool
+	Method_HasEdgeFromTo  func(a0 graph.Node, a1 graph.Node) bool
+	Method_Nodes          func() []graph.Node
+	Method_To             func(a0 graph.Node) []graph.Node
+	Method_Weight         func(a0 graph.Node, a1 graph.Node) (float64, bool)
+	Method_WeightedEdge   func(a0 graph.Node, a1 graph.Node) graph.WeightedEdge
+}
+
+func (p *GijitShadow_Proxy_WeightedDirected) Edge(a0 graph.Node, a1 graph.Node) graph.Edge {
+	return p.Method_Edge(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_WeightedDirected) From(a0 graph.Node) []graph.Node {
+	return p.Method_From(a0)
+}
+
+func (p *GijitShadow_Proxy_WeightedDirected) Has(a0 graph.Node) bool {
+	return p.Method_Has(a0)
+}
+
+func (p *GijitShadow_Proxy_WeightedDirected) HasEdgeBetween(a0 graph.Node, a1 graph.Node) bool {
+	return p.Method_HasEdgeBetween(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_WeightedDirected) HasEdgeFromTo(a0 graph.Node, a1 graph.Node) bool {
+	return p.Method_HasEdgeFromTo(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_WeightedDirected) Nodes() []graph.Node {
+	return p.Method_Nodes()
+}
+
+func (p *GijitShadow_Proxy_WeightedDirected) To(a0 graph.Node) []graph.Node {
+	return p.Method_To(a0)
+}
+
+func (p *GijitShadow_Proxy_WeightedDirected) Weight(a0 graph.Node, a1 graph.Node) (float64, bool) {
+	return p.Method_Weight(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_WeightedDirected) WeightedEdge(a0 graph.Node, a1 graph.Node) graph.WeightedEdge {
+	return p.Method_WeightedEdge(a0, a1)
+}
+
 func GijitShadow_InterfaceConvertTo2_WeightedDirectedMultigraph(x interface{}) (y graph.WeightedDirectedMultigraph, b bool) {
 	y, b = x.(graph.WeightedDirectedMultigraph)
 	return
@@ -315,6 +1252,49 @@ func GijitShadow_InterfaceConvertTo1_WeightedDirectedMultigraph(x interface{}) g
 	return x.(graph.WeightedDirectedMultigraph)
 }
 
+type GijitShadow_Proxy_WeightedDirectedMultigraph struct {
+	Method_From           func(a0 graph.Node) []graph.Node
+	Method_Has            func(a0 graph.Node) bool
+	Method_HasEdgeBetween func(a0 graph.Node, a1 graph.Node) bool
+	Method_HasEdgeFromTo  func(a0 graph.Node, a1 graph.Node) bool
+	Method_Lines          func(a0 graph.Node, a1 graph.Node) []graph.Line
+	Method_Nodes          func() []graph.Node
+	Method_To             func(a0 graph.Node) []graph.Node
+	Method_WeightedLines  func(a0 graph.Node, a1 graph.Node) []graph.WeightedLine
+}
+
+func (p *GijitShadow_Proxy_WeightedDirectedMultigraph) From(a0 graph.Node) []graph.Node {
+	return p.Method_From(a0)
+}
+
+func (p *GijitShadow_Proxy_WeightedDirectedMultigraph) Has(a0 graph.Node) bool {
+	return p.Method_Has(a0)
+}
+
+func (p *GijitShadow_Proxy_WeightedDirectedMultigraph) HasEdgeBetween(a0 graph.Node, a1 graph.Node) bool {
+	return p.Method_HasEdgeBetween(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_WeightedDirectedMultigraph) HasEdgeFromTo(a0 graph.Node, a1 graph.Node) bool {
+	return p.Method_HasEdgeFromTo(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_WeightedDirectedMultigraph) Lines(a0 graph.Node, a1 graph.Node) []graph.Line {
+	return p.Method_Lines(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_WeightedDirectedMultigraph) Nodes() []graph.Node {
+	return p.Method_Nodes()
+}
+
+func (p *GijitShadow_Proxy_WeightedDirectedMultigraph) To(a0 graph.Node) []graph.Node {
+	return p.Method_To(a0)
+}
+
+func (p *GijitShadow_Proxy_WeightedDirectedMultigraph) WeightedLines(a0 graph.Node, a1 graph.Node) []graph.WeightedLine {
+	return p.Method_WeightedLines(a0, a1)
+}
+
 func GijitShadow_InterfaceConvertTo2_WeightedEdge(x interface{}) (y graph.WeightedEdge, b bool) {
 	y, b = x.(graph.WeightedEdge)
 	return
@@ -324,6 +1304,24 @@ func GijitShadow_InterfaceConvertTo1_WeightedEdge(x interface{}) graph.WeightedE
 	return x.(graph.WeightedEdge)
 }
 
+type GijitShadow_Proxy_WeightedEdge struct {
+	Method_From   func() graph.Node
+	Method_To     func() graph.Node
+	Method_Weight func() float64
+}
+
+func (p *GijitShadow_Proxy_WeightedEdge) From() graph.Node {
+	return p.Method_From()
+}
+
+func (p *GijitShadow_Proxy_WeightedEdge) To() graph.Node {
+	return p.Method_To()
+}
+
+func (p *GijitShadow_Proxy_WeightedEdge) Weight() float64 {
+	return p.Method_Weight()
+}
+
 func GijitShadow_InterfaceConvertTo2_WeightedEdgeAdder(x interface{}) (y graph.WeightedEdgeAdder, b bool) {
 	y, b = x.(graph.WeightedEdgeAdder)
 	return
@@ -333,6 +1331,19 @@ func GijitShadow_InterfaceConvertTo1_WeightedEdgeAdder(x interface{}) graph.Weig
 	return x.(graph.WeightedEdgeAdder)
 }
 
+type GijitShadow_Proxy_WeightedEdgeAdder struct {
+	Method_NewWeightedEdge func(a0 graph.Node, a1 graph.Node, a2 float64) graph.WeightedEdge
+	Method_SetWeightedEdge func(a0 graph.WeightedEdge)
+}
+
+func (p *GijitShadow_Proxy_WeightedEdgeAdder) NewWeightedEdge(a0 graph.Node, a1 graph.Node, a2 float64) graph.WeightedEdge {
+	return p.Method_NewWeightedEdge(a0, a1, a2)
+}
+
+func (p *GijitShadow_Proxy_WeightedEdgeAdder) SetWeightedEdge(a0 graph.WeightedEdge) {
+	p.Method_SetWeightedEdge(a0)
+}
+
 func GijitShadow_NewStruct_WeightedEdgePair() *graph.WeightedEdgePair {
 	return &graph.WeightedEdgePair{}
 }
@@ -346,6 +1357,29 @@ func GijitShadow_InterfaceConvertTo1_WeightedLine(x interface{}) graph.WeightedL
 	return x.(graph.WeightedLine)
 }
 
+type GijitShadow_Proxy_WeightedLine struct {
+	Method_From   func() graph.Node
+	Method_ID     func() int64
+	Method_To     func() graph.Node
+	Method_Weight func() float64
+}
+
+func (p *GijitShadow_Proxy_WeightedLine) From() graph.Node {
+	return p.Method_From()
+}
+
+func (p *GijitShadow_Proxy_WeightedLine) ID() int64 {
+	return p.Method_ID()
+}
+
+func (p *GijitShadow_Proxy_WeightedLine) To() graph.Node {
+	return p.Method_To()
+}
+
+func (p *GijitShadow_Proxy_WeightedLine) Weight() float64 {
+	return p.Method_Weight()
+}
+
 func GijitShadow_InterfaceConvertTo2_WeightedLineAdder(x interface{}) (y graph.WeightedLineAdder, b bool) {
 	y, b = x.(graph.WeightedLineAdder)
 	return
@@ -355,6 +1389,19 @@ func GijitShadow_InterfaceConvertTo1_WeightedLineAdder(x interface{}) graph.Weig
 	return x.(graph.WeightedLineAdder)
 }
 
+type GijitShadow_Proxy_WeightedLineAdder struct {
+	Method_NewWeightedLine func(a0 graph.Node, a1 graph.Node, a2 float64) graph.WeightedLine
+	Method_SetWeightedLine func(a0 graph.WeightedLine)
+}
+
+func (p *GijitShadow_Proxy_WeightedLineAdder) NewWeightedLine(a0 graph.Node, a1 graph.Node, a2 float64) graph.WeightedLine {
+	return p.Method_NewWeightedLine(a0, a1, a2)
+}
+
+func (p *GijitShadow_Proxy_WeightedLineAdder) SetWeightedLine(a0 graph.WeightedLine) {
+	p.Method_SetWeightedLine(a0)
+}
+
 func GijitShadow_InterfaceConvertTo2_WeightedMultigraph(x interface{}) (y graph.WeightedMultigraph, b bool) {
 	y, b = x.(graph.WeightedMultigraph)
 	return
@@ -364,6 +1411,39 @@ func GijitShadow_InterfaceConvertTo1_WeightedMultigraph(x interface{}) graph.Wei
 	return x.(graph.WeightedMultigraph)
 }
 
+type GijitShadow_Proxy_WeightedMultigraph struct {
+	Method_From           func(a0 graph.Node) []graph.Node
+	Method_Has            func(a0 graph.Node) bool
+	Method_HasEdgeBetween func(a0 graph.Node, a1 graph.Node) bool
+	Method_Lines          func(a0 graph.Node, a1 graph.Node) []graph.Line
+	Method_Nodes          func() []graph.Node
+	Method_WeightedLines  func(a0 graph.Node, a1 graph.Node) []graph.WeightedLine
+}
+
+func (p *GijitShadow_Proxy_WeightedMultigraph) From(a0 graph.Node) []graph.Node {
+	return p.Method_From(a0)
+}
+
+func (p *GijitShadow_Proxy_WeightedMultigraph) Has(a0 graph.Node) bool {
+	return p.Method_Has(a0)
+}
+
+func (p *GijitShadow_Proxy_WeightedMultigraph) HasEdgeBetween(a0 graph.Node, a1 graph.Node) bool {
+	return p.Method_HasEdgeBetween(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_WeightedMultigraph) Lines(a0 graph.Node, a1 graph.Node) []graph.Line {
+	return p.Method_Lines(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_WeightedMultigraph) Nodes() []graph.Node {
+	return p.Method_Nodes()
+}
+
+func (p *GijitShadow_Proxy_WeightedMultigraph) WeightedLines(a0 graph.Node, a1 graph.Node) []graph.WeightedLine {
+	return p.Method_WeightedLines(a0, a1)
+}
+
 func GijitShadow_InterfaceConvertTo2_WeightedMultigraphBuilder(x interface{}) (y graph.WeightedMultigraphBuilder, b bool) {
 	y, b = x.(graph.WeightedMultigraphBuilder)
 	return
@@ -373,6 +1453,29 @@ func GijitShadow_InterfaceConvertTo1_WeightedMultigraphBuilder(x interface{}) gr
 	return x.(graph.WeightedMultigraphBuilder)
 }
 
+type GijitShadow_Proxy_WeightedMultigraphBuilder struct {
+	Method_AddNode         func(a0 graph.Node)
+	Method_NewNode         func() graph.Node
+	Method_NewWeightedLine func(a0 graph.Node, a1 graph.Node, a2 float64) graph.WeightedLine
+	Method_SetWeightedLine func(a0 graph.WeightedLine)
+}
+
+func (p *GijitShadow_Proxy_WeightedMultigraphBuilder) AddNode(a0 graph.Node) {
+	p.Method_AddNode(a0)
+}
+
+func (p *GijitShadow_Proxy_WeightedMultigraphBuilder) NewNode() graph.Node {
+	return p.Method_NewNode()
+}
+
+func (p *GijitShadow_Proxy_WeightedMultigraphBuilder) NewWeightedLine(a0 graph.Node, a1 graph.Node, a2 float64) graph.WeightedLine {
+	return p.Method_NewWeightedLine(a0, a1, a2)
+}
+
+func (p *GijitShadow_Proxy_WeightedMultigraphBuilder) SetWeightedLine(a0 graph.WeightedLine) {
+	p.Method_SetWeightedLine(a0)
+}
+
 func GijitShadow_InterfaceConvertTo2_WeightedUndirected(x interface{}) (y graph.WeightedUndirected, b bool) {
 	y, b = x.(graph.WeightedUndirected)
 	return
@@ -382,6 +1485,49 @@ func GijitShadow_InterfaceConvertTo1_WeightedUndirected(x interface{}) graph.Wei
 	return x.(graph.WeightedUndirected)
 }
 
+type GijitShadow_Proxy_WeightedUndirected struct {
+	Method_Edge                func(a0 graph.Node, a1 graph.Node) graph.Edge
+	Method_From                func(a0 graph.Node) []graph.Node
+	Method_Has                 func(a0 graph.Node) bool
+	Method_HasEdgeBetween      func(a0 graph.Node, a1 graph.Node) bool
+	Method_Nodes               func() []graph.Node
+	Method_Weight              func(a0 graph.Node, a1 graph.Node) (float64, bool)
+	Method_WeightedEdge        func(a0 graph.Node, a1 graph.Node) graph.WeightedEdge
+	Method_WeightedEdgeBetween func(a0 graph.Node, a1 graph.Node) graph.WeightedEdge
+}
+
+func (p *GijitShadow_Proxy_WeightedUndirected) Edge(a0 graph.Node, a1 graph.Node) graph.Edge {
+	return p.Method_Edge(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_WeightedUndirected) From(a0 graph.Node) []graph.Node {
+	return p.Method_From(a0)
+}
+
+func (p *GijitShadow_Proxy_WeightedUndirected) Has(a0 graph.Node) bool {
+	return p.Method_Has(a0)
+}
+
+func (p *GijitShadow_Proxy_WeightedUndirected) HasEdgeBetween(a0 graph.Node, a1 graph.Node) bool {
+	return p.Method_HasEdgeBetween(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_WeightedUndirected) Nodes() []graph.Node {
+	return p.Method_Nodes()
+}
+
+func (p *GijitShadow_Proxy_WeightedUndirected) Weight(a0 graph.Node, a1 graph.Node) (float64, bool) {
+	return p.Method_Weight(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_WeightedUndirected) WeightedEdge(a0 graph.Node, a1 graph.Node) graph.WeightedEdge {
+	return p.Method_WeightedEdge(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_WeightedUndirected) WeightedEdgeBetween(a0 graph.Node, a1 graph.Node) graph.WeightedEdge {
+	return p.Method_WeightedEdgeBetween(a0, a1)
+}
+
 func GijitShadow_InterfaceConvertTo2_WeightedUndirectedMultigraph(x interface{}) (y graph.WeightedUndirectedMultigraph, b bool) {
 	y, b = x.(graph.WeightedUndirectedMultigraph)
 	return
@@ -390,3 +1536,41 @@ func GijitShadow_InterfaceConvertTo2_WeightedUndirectedMultigraph(x interface{})
 func GijitShadow_InterfaceConvertTo1_WeightedUndirectedMultigraph(x interface{}) graph.WeightedUndirectedMultigraph {
 	return x.(graph.WeightedUndirectedMultigraph)
 }
+
+type GijitShadow_Proxy_WeightedUndirectedMultigraph struct {
+	Method_From                 func(a0 graph.Node) []graph.Node
+	Method_Has                  func(a0 graph.Node) bool
+	Method_HasEdgeBetween       func(a0 graph.Node, a1 graph.Node) bool
+	Method_Lines                func(a0 graph.Node, a1 graph.Node) []graph.Line
+	Method_Nodes                func() []graph.Node
+	Method_WeightedLines        func(a0 graph.Node, a1 graph.Node) []graph.WeightedLine
+	Method_WeightedLinesBetween func(a0 graph.Node, a1 graph.Node) []graph.WeightedLine
+}
+
+func (p *GijitShadow_Proxy_WeightedUndirectedMultigraph) From(a0 graph.Node) []graph.Node {
+	return p.Method_From(a0)
+}
+
+func (p *GijitShadow_Proxy_WeightedUndirectedMultigraph) Has(a0 graph.Node) bool {
+	return p.Method_Has(a0)
+}
+
+func (p *GijitShadow_Proxy_WeightedUndirectedMultigraph) HasEdgeBetween(a0 graph.Node, a1 graph.Node) bool {
+	return p.Method_HasEdgeBetween(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_WeightedUndirectedMultigraph) Lines(a0 graph.Node, a1 graph.Node) []graph.Line {
+	return p.Method_Lines(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_WeightedUndirectedMultigraph) Nodes() []graph.Node {
+	return p.Method_Nodes()
+}
+
+func (p *GijitShadow_Proxy_WeightedUndirectedMultigraph) WeightedLines(a0 graph.Node, a1 graph.Node) []graph.WeightedLine {
+	return p.Method_WeightedLines(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_WeightedUndirectedMultigraph) WeightedLinesBetween(a0 graph.Node, a1 graph.Node) []graph.WeightedLine {
+	return p.Method_WeightedLinesBetween(a0, a1)
+}
@@ -1,12 +1,17 @@
 package shadow_lapack
 
-import "gonum.org/v1/gonum/lapack"
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
 
 var Pkg = make(map[string]interface{})
+var Proxy = make(map[string]interface{})
 
 func init() {
 	Pkg["Complex128"] = GijitShadow_InterfaceConvertTo2_Complex128
 	Pkg["Float64"] = GijitShadow_InterfaceConvertTo2_Float64
+	Proxy["Float64"] = (*GijitShadow_Proxy_Float64)(nil)
 	Pkg["None"] = lapack.None
 
 }
@@ -27,3 +32,116 @@ func GijitShadow_InterfaceConvertTo2_Float64(x interface{}) (y lapack.Float64, b
 func GijitShadow_InterfaceConvertTo1_Float64(x interface{}) lapack.Float64 {
 	return x.(lapack.Float64)
 }
+
+type GijitShadow_Proxy_Float64 struct {
+	Method_Dgecon  func(a0 lapack.MatrixNorm, a1 int, a2 []float64, a3 int, a4 float64, a5 []float64, a6 []int) float64
+	Method_Dgeev   func(a0 lapack.LeftEVJob, a1 lapack.RightEVJob, a2 int, a3 []float64, a4 int, a5 []float64, a6 []float64, a7 []float64, a8 int, a9 []float64, a10 int, a11 []float64, a12 int) int
+	Method_Dgelqf  func(a0 int, a1 int, a2 []float64, a3 int, a4 []float64, a5 []float64, a6 int)
+	Method_Dgels   func(a0 blas.Transpose, a1 int, a2 int, a3 int, a4 []float64, a5 int, a6 []float64, a7 int, a8 []float64, a9 int) bool
+	Method_Dgeqrf  func(a0 int, a1 int, a2 []float64, a3 int, a4 []float64, a5 []float64, a6 int)
+	Method_Dgesvd  func(a0 lapack.SVDJob, a1 lapack.SVDJob, a2 int, a3 int, a4 []float64, a5 int, a6 []float64, a7 []float64, a8 int, a9 []float64, a10 int, a11 []float64, a12 int) bool
+	Method_Dgetrf  func(a0 int, a1 int, a2 []float64, a3 int, a4 []int) bool
+	Method_Dgetri  func(a0 int, a1 []float64, a2 int, a3 []int, a4 []float64, a5 int) bool
+	Method_Dgetrs  func(a0 blas.Transpose, a1 int, a2 int, a3 []float64, a4 int, a5 []int, a6 []float64, a7 int)
+	Method_Dggsvd3 func(a0 lapack.GSVDJob, a1 lapack.GSVDJob, a2 lapack.GSVDJob, a3 int, a4 int, a5 int, a6 []float64, a7 int, a8 []float64, a9 int, a10 []float64, a11 []float64, a12 []float64, a13 int, a14 []float64, a15 int, a16 []float64, a17 int, a18 []float64, a19 int, a20 []int) (int, int, bool)
+	Method_Dlange  func(a0 lapack.MatrixNorm, a1 int, a2 int, a3 []float64, a4 int, a5 []float64) float64
+	Method_Dlansy  func(a0 lapack.MatrixNorm, a1 blas.Uplo, a2 int, a3 []float64, a4 int, a5 []float64) float64
+	Method_Dlantr  func(a0 lapack.MatrixNorm, a1 blas.Uplo, a2 blas.Diag, a3 int, a4 int, a5 []float64, a6 int, a7 []float64) float64
+	Method_Dlapmt  func(a0 bool, a1 int, a2 int, a3 []float64, a4 int, a5 []int)
+	Method_Dormlq  func(a0 blas.Side, a1 blas.Transpose, a2 int, a3 int, a4 int, a5 []float64, a6 int, a7 []float64, a8 []float64, a9 int, a10 []float64, a11 int)
+	Method_Dormqr  func(a0 blas.Side, a1 blas.Transpose, a2 int, a3 int, a4 int, a5 []float64, a6 int, a7 []float64, a8 []float64, a9 int, a10 []float64, a11 int)
+	Method_Dpocon  func(a0 blas.Uplo, a1 int, a2 []float64, a3 int, a4 float64, a5 []float64, a6 []int) float64
+	Method_Dpotrf  func(a0 blas.Uplo, a1 int, a2 []float64, a3 int) bool
+	Method_Dsyev   func(a0 lapack.EVJob, a1 blas.Uplo, a2 int, a3 []float64, a4 int, a5 []float64, a6 []float64, a7 int) bool
+	Method_Dtrcon  func(a0 lapack.MatrixNorm, a1 blas.Uplo, a2 blas.Diag, a3 int, a4 []float64, a5 int, a6 []float64, a7 []int) float64
+	Method_Dtrtri  func(a0 blas.Uplo, a1 blas.Diag, a2 int, a3 []float64, a4 int) bool
+	Method_Dtrtrs  func(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 int, a5 []float64, a6 int, a7 []float64, a8 int) bool
+}
+
+func (p *GijitShadow_Proxy_Float64) Dgecon(a0 lapack.MatrixNorm, a1 int, a2 []float64, a3 int, a4 float64, a5 []float64, a6 []int) float64 {
+	return p.Method_Dgecon(a0, a1, a2, a3, a4, a5, a6)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dgeev(a0 lapack.LeftEVJob, a1 lapack.RightEVJob, a2 int, a3 []float64, a4 int, a5 []float64, a6 []float64, a7 []float64, a8 int, a9 []float64, a10 int, a11 []float64, a12 int) int {
+	return p.Method_Dgeev(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dgelqf(a0 int, a1 int, a2 []float64, a3 int, a4 []float64, a5 []float64, a6 int) {
+	p.Method_Dgelqf(a0, a1, a2, a3, a4, a5, a6)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dgels(a0 blas.Transpose, a1 int, a2 int, a3 int, a4 []float64, a5 int, a6 []float64, a7 int, a8 []float64, a9 int) bool {
+	return p.Method_Dgels(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dgeqrf(a0 int, a1 int, a2 []float64, a3 int, a4 []float64, a5 []float64, a6 int) {
+	p.Method_Dgeqrf(a0, a1, a2, a3, a4, a5, a6)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dgesvd(a0 lapack.SVDJob, a1 lapack.SVDJob, a2 int, a3 int, a4 []float64, a5 int, a6 []float64, a7 []float64, a8 int, a9 []float64, a10 int, a11 []float64, a12 int) bool {
+	return p.Method_Dgesvd(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dgetrf(a0 int, a1 int, a2 []float64, a3 int, a4 []int) bool {
+	return p.Method_Dgetrf(a0, a1, a2, a3, a4)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dgetri(a0 int, a1 []float64, a2 int, a3 []int, a4 []float64, a5 int) bool {
+	return p.Method_Dgetri(a0, a1, a2, a3, a4, a5)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dgetrs(a0 blas.Transpose, a1 int, a2 int, a3 []float64, a4 int, a5 []int, a6 []float64, a7 int) {
+	p.Method_Dgetrs(a0, a1, a2, a3, a4, a5, a6, a7)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dggsvd3(a0 lapack.GSVDJob, a1 lapack.GSVDJob, a2 lapack.GSVDJob, a3 int, a4 int, a5 int, a6 []float64, a7 int, a8 []float64, a9 int, a10 []float64, a11 []float64, a12 []float64, a13 int, a14 []float64, a15 int, a16 []float64, a17 int, a18 []float64, a19 int, a20 []int) (int, int, bool) {
+	return p.Method_Dggsvd3(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14, a15, a16, a17, a18, a19, a20)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dlange(a0 lapack.MatrixNorm, a1 int, a2 int, a3 []float64, a4 int, a5 []float64) float64 {
+	return p.Method_Dlange(a0, a1, a2, a3, a4, a5)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dlansy(a0 lapack.MatrixNorm, a1 blas.Uplo, a2 int, a3 []float64, a4 int, a5 []float64) float64 {
+	return p.Method_Dlansy(a0, a1, a2, a3, a4, a5)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dlantr(a0 lapack.MatrixNorm, a1 blas.Uplo, a2 blas.Diag, a3 int, a4 int, a5 []float64, a6 int, a7 []float64) float64 {
+	return p.Method_Dlantr(a0, a1, a2, a3, a4, a5, a6, a7)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dlapmt(a0 bool, a1 int, a2 int, a3 []float64, a4 int, a5 []int) {
+	p.Method_Dlapmt(a0, a1, a2, a3, a4, a5)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dormlq(a0 blas.Side, a1 blas.Transpose, a2 int, a3 int, a4 int, a5 []float64, a6 int, a7 []float64, a8 []float64, a9 int, a10 []float64, a11 int) {
+	p.Method_Dormlq(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dormqr(a0 blas.Side, a1 blas.Transpose, a2 int, a3 int, a4 int, a5 []float64, a6 int, a7 []float64, a8 []float64, a9 int, a10 []float64, a11 int) {
+	p.Method_Dormqr(a0, a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dpocon(a0 blas.Uplo, a1 int, a2 []float64, a3 int, a4 float64, a5 []float64, a6 []int) float64 {
+	return p.Method_Dpocon(a0, a1, a2, a3, a4, a5, a6)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dpotrf(a0 blas.Uplo, a1 int, a2 []float64, a3 int) bool {
+	return p.Method_Dpotrf(a0, a1, a2, a3)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dsyev(a0 lapack.EVJob, a1 blas.Uplo, a2 int, a3 []float64, a4 int, a5 []float64, a6 []float64, a7 int) bool {
+	return p.Method_Dsyev(a0, a1, a2, a3, a4, a5, a6, a7)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dtrcon(a0 lapack.MatrixNorm, a1 blas.Uplo, a2 blas.Diag, a3 int, a4 []float64, a5 int, a6 []float64, a7 []int) float64 {
+	return p.Method_Dtrcon(a0, a1, a2, a3, a4, a5, a6, a7)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dtrtri(a0 blas.Uplo, a1 blas.Diag, a2 int, a3 []float64, a4 int) bool {
+	return p.Method_Dtrtri(a0, a1, a2, a3, a4)
+}
+
+func (p *GijitShadow_Proxy_Float64) Dtrtrs(a0 blas.Uplo, a1 blas.Transpose, a2 blas.Diag, a3 int, a4 int, a5 []float64, a6 int, a7 []float64, a8 int) bool {
+	return p.Method_Dtrtrs(a0, a1, a2, a3, a4, a5, a6, a7, a8)
+}
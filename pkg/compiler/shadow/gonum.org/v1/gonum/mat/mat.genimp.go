@@ -1,20 +1,31 @@
 package shadow_mat
 
-import "gonum.org/v1/gonum/mat"
+import (
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/mat"
+)
 
 var Pkg = make(map[string]interface{})
+var Proxy = make(map[string]interface{})
 
 func init() {
 	Pkg["BandWidther"] = GijitShadow_InterfaceConvertTo2_BandWidther
+	Proxy["BandWidther"] = (*GijitShadow_Proxy_BandWidther)(nil)
 	Pkg["Banded"] = GijitShadow_InterfaceConvertTo2_Banded
+	Proxy["Banded"] = (*GijitShadow_Proxy_Banded)(nil)
 	Pkg["CMatrix"] = GijitShadow_InterfaceConvertTo2_CMatrix
+	Proxy["CMatrix"] = (*GijitShadow_Proxy_CMatrix)(nil)
 	Pkg["Cloner"] = GijitShadow_InterfaceConvertTo2_Cloner
+	Proxy["Cloner"] = (*GijitShadow_Proxy_Cloner)(nil)
 	Pkg["Col"] = mat.Col
 	Pkg["ColNonZeroDoer"] = GijitShadow_InterfaceConvertTo2_ColNonZeroDoer
+	Proxy["ColNonZeroDoer"] = (*GijitShadow_Proxy_ColNonZeroDoer)(nil)
 	Pkg["ColViewer"] = GijitShadow_InterfaceConvertTo2_ColViewer
+	Proxy["ColViewer"] = (*GijitShadow_Proxy_ColViewer)(nil)
 	Pkg["Cond"] = mat.Cond
 	Pkg["ConditionTolerance"] = mat.ConditionTolerance
 	Pkg["Copier"] = GijitShadow_InterfaceConvertTo2_Copier
+	Proxy["Copier"] = (*GijitShadow_Proxy_Copier)(nil)
 	Pkg["DenseCopyOf"] = mat.DenseCopyOf
 	Pkg["Det"] = mat.Det
 	Pkg["Dot"] = mat.Dot
@@ -43,19 +54,26 @@ func init() {
 	Pkg["Excerpt"] = mat.Excerpt
 	Pkg["Formatted"] = mat.Formatted
 	Pkg["Grower"] = GijitShadow_InterfaceConvertTo2_Grower
+	Proxy["Grower"] = (*GijitShadow_Proxy_Grower)(nil)
 	Pkg["Inner"] = mat.Inner
 	Pkg["LogDet"] = mat.LogDet
 	Pkg["Matrix"] = GijitShadow_InterfaceConvertTo2_Matrix
+	Proxy["Matrix"] = (*GijitShadow_Proxy_Matrix)(nil)
 	Pkg["Max"] = mat.Max
 	Pkg["Maybe"] = mat.Maybe
 	Pkg["MaybeComplex"] = mat.MaybeComplex
 	Pkg["MaybeFloat"] = mat.MaybeFloat
 	Pkg["Min"] = mat.Min
 	Pkg["Mutable"] = GijitShadow_InterfaceConvertTo2_Mutable
+	Proxy["Mutable"] = (*GijitShadow_Proxy_Mutable)(nil)
 	Pkg["MutableBanded"] = GijitShadow_InterfaceConvertTo2_MutableBanded
+	Proxy["MutableBanded"] = (*GijitShadow_Proxy_MutableBanded)(nil)
 	Pkg["MutableSymBanded"] = GijitShadow_InterfaceConvertTo2_MutableSymBanded
+	Proxy["MutableSymBanded"] = (*GijitShadow_Proxy_MutableSymBanded)(nil)
 	Pkg["MutableSymmetric"] = GijitShadow_InterfaceConvertTo2_MutableSymmetric
+	Proxy["MutableSymmetric"] = (*GijitShadow_Proxy_MutableSymmetric)(nil)
 	Pkg["MutableTriangular"] = GijitShadow_InterfaceConvertTo2_MutableTriangular
+	Proxy["MutableTriangular"] = (*GijitShadow_Proxy_MutableTriangular)(nil)
 	Pkg["NewBandDense"] = mat.NewBandDense
 	Pkg["NewDense"] = mat.NewDense
 	Pkg["NewDiagonal"] = mat.NewDiagonal
@@ -65,32 +83,52 @@ func init() {
 	Pkg["NewTriDense"] = mat.NewTriDense
 	Pkg["NewVecDense"] = mat.NewVecDense
 	Pkg["NonZeroDoer"] = GijitShadow_InterfaceConvertTo2_NonZeroDoer
+	Proxy["NonZeroDoer"] = (*GijitShadow_Proxy_NonZeroDoer)(nil)
 	Pkg["Norm"] = mat.Norm
 	Pkg["Prefix"] = mat.Prefix
 	Pkg["RawBander"] = GijitShadow_InterfaceConvertTo2_RawBander
+	Proxy["RawBander"] = (*GijitShadow_Proxy_RawBander)(nil)
 	Pkg["RawColViewer"] = GijitShadow_InterfaceConvertTo2_RawColViewer
+	Proxy["RawColViewer"] = (*GijitShadow_Proxy_RawColViewer)(nil)
 	Pkg["RawMatrixSetter"] = GijitShadow_InterfaceConvertTo2_RawMatrixSetter
+	Proxy["RawMatrixSetter"] = (*GijitShadow_Proxy_RawMatrixSetter)(nil)
 	Pkg["RawMatrixer"] = GijitShadow_InterfaceConvertTo2_RawMatrixer
+	Proxy["RawMatrixer"] = (*GijitShadow_Proxy_RawMatrixer)(nil)
 	Pkg["RawRowViewer"] = GijitShadow_InterfaceConvertTo2_RawRowViewer
+	Proxy["RawRowViewer"] = (*GijitShadow_Proxy_RawRowViewer)(nil)
 	Pkg["RawSymBander"] = GijitShadow_InterfaceConvertTo2_RawSymBander
+	Proxy["RawSymBander"] = (*GijitShadow_Proxy_RawSymBander)(nil)
 	Pkg["RawSymmetricer"] = GijitShadow_InterfaceConvertTo2_RawSymmetricer
+	Proxy["RawSymmetricer"] = (*GijitShadow_Proxy_RawSymmetricer)(nil)
 	Pkg["RawTriangular"] = GijitShadow_InterfaceConvertTo2_RawTriangular
+	Proxy["RawTriangular"] = (*GijitShadow_Proxy_RawTriangular)(nil)
 	Pkg["RawVectorer"] = GijitShadow_InterfaceConvertTo2_RawVectorer
+	Proxy["RawVectorer"] = (*GijitShadow_Proxy_RawVectorer)(nil)
 	Pkg["Reseter"] = GijitShadow_InterfaceConvertTo2_Reseter
+	Proxy["Reseter"] = (*GijitShadow_Proxy_Reseter)(nil)
 	Pkg["Row"] = mat.Row
 	Pkg["RowNonZeroDoer"] = GijitShadow_InterfaceConvertTo2_RowNonZeroDoer
+	Proxy["RowNonZeroDoer"] = (*GijitShadow_Proxy_RowNonZeroDoer)(nil)
 	Pkg["RowViewer"] = GijitShadow_InterfaceConvertTo2_RowViewer
+	Proxy["RowViewer"] = (*GijitShadow_Proxy_RowViewer)(nil)
 	Pkg["Squeeze"] = mat.Squeeze
 	Pkg["Sum"] = mat.Sum
 	Pkg["Symmetric"] = GijitShadow_InterfaceConvertTo2_Symmetric
+	Proxy["Symmetric"] = (*GijitShadow_Proxy_Symmetric)(nil)
 	Pkg["Trace"] = mat.Trace
 	Pkg["Triangular"] = GijitShadow_InterfaceConvertTo2_Triangular
+	Proxy["Triangular"] = (*GijitShadow_Proxy_Triangular)(nil)
 	Pkg["Unconjugator"] = GijitShadow_InterfaceConvertTo2_Unconjugator
+	Proxy["Unconjugator"] = (*GijitShadow_Proxy_Unconjugator)(nil)
 	Pkg["UntransposeBander"] = GijitShadow_InterfaceConvertTo2_UntransposeBander
+	Proxy["UntransposeBander"] = (*GijitShadow_Proxy_UntransposeBander)(nil)
 	Pkg["UntransposeTrier"] = GijitShadow_InterfaceConvertTo2_UntransposeTrier
+	Proxy["UntransposeTrier"] = (*GijitShadow_Proxy_UntransposeTrier)(nil)
 	Pkg["Untransposer"] = GijitShadow_InterfaceConvertTo2_Untransposer
+	Proxy["Untransposer"] = (*GijitShadow_Proxy_Untransposer)(nil)
 	Pkg["VecDenseCopyOf"] = mat.VecDenseCopyOf
 	Pkg["Vector"] = GijitShadow_InterfaceConvertTo2_Vector
+	Proxy["Vector"] = (*GijitShadow_Proxy_Vector)(nil)
 
 }
 func GijitShadow_NewStruct_BandDense() *mat.BandDense {
@@ -106,6 +144,14 @@ func GijitShadow_InterfaceConvertTo1_BandWidther(x interface{}) mat.BandWidther
 	return x.(mat.BandWidther)
 }
 
+type GijitShadow_Proxy_BandWidther struct {
+	Method_BandWidth func() (int, int)
+}
+
+func (p *GijitShadow_Proxy_BandWidther) BandWidth() (int, int) {
+	return p.Method_BandWidth()
+}
+
 func GijitShadow_InterfaceConvertTo2_Banded(x interface{}) (y mat.Banded, b bool) {
 	y, b = x.(mat.Banded)
 	return
@@ -115,6 +161,34 @@ func GijitShadow_InterfaceConvertTo1_Banded(x interface{}) mat.Banded {
 	return x.(mat.Banded)
 }
 
+type GijitShadow_Proxy_Banded struct {
+	Method_At        func(a0 int, a1 int) float64
+	Method_Bandwidth func() (int, int)
+	Method_Dims      func() (int, int)
+	Method_T         func() mat.Matrix
+	Method_TBand     func() mat.Banded
+}
+
+func (p *GijitShadow_Proxy_Banded) At(a0 int, a1 int) float64 {
+	return p.Method_At(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_Banded) Bandwidth() (int, int) {
+	return p.Method_Bandwidth()
+}
+
+func (p *GijitShadow_Proxy_Banded) Dims() (int, int) {
+	return p.Method_Dims()
+}
+
+func (p *GijitShadow_Proxy_Banded) T() mat.Matrix {
+	return p.Method_T()
+}
+
+func (p *GijitShadow_Proxy_Banded) TBand() mat.Banded {
+	return p.Method_TBand()
+}
+
 func GijitShadow_InterfaceConvertTo2_CMatrix(x interface{}) (y mat.CMatrix, b bool) {
 	y, b = x.(mat.CMatrix)
 	return
@@ -124,6 +198,24 @@ func GijitShadow_InterfaceConvertTo1_CMatrix(x interface{}) mat.CMatrix {
 	return x.(mat.CMatrix)
 }
 
+type GijitShadow_Proxy_CMatrix struct {
+	Method_At   func(a0 int, a1 int) complex128
+	Method_Dims func() (int, int)
+	Method_H    func() mat.CMatrix
+}
+
+func (p *GijitShadow_Proxy_CMatrix) At(a0 int, a1 int) complex128 {
+	return p.Method_At(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_CMatrix) Dims() (int, int) {
+	return p.Method_Dims()
+}
+
+func (p *GijitShadow_Proxy_CMatrix) H() mat.CMatrix {
+	return p.Method_H()
+}
+
 func GijitShadow_NewStruct_Cholesky() *mat.Cholesky {
 	return &mat.Cholesky{}
 }
@@ -137,6 +229,14 @@ func GijitShadow_InterfaceConvertTo1_Cloner(x interface{}) mat.Cloner {
 	return x.(mat.Cloner)
 }
 
+type GijitShadow_Proxy_Cloner struct {
+	Method_Clone func(a0 mat.Matrix)
+}
+
+func (p *GijitShadow_Proxy_Cloner) Clone(a0 mat.Matrix) {
+	p.Method_Clone(a0)
+}
+
 func GijitShadow_InterfaceConvertTo2_ColNonZeroDoer(x interface{}) (y mat.ColNonZeroDoer, b bool) {
 	y, b = x.(mat.ColNonZeroDoer)
 	return
@@ -146,6 +246,14 @@ func GijitShadow_InterfaceConvertTo1_ColNonZeroDoer(x interface{}) mat.ColNonZer
 	return x.(mat.ColNonZeroDoer)
 }
 
+type GijitShadow_Proxy_ColNonZeroDoer struct {
+	Method_DoColNonZero func(a0 int, a1 func(i int, j int, v float64))
+}
+
+func (p *GijitShadow_Proxy_ColNonZeroDoer) DoColNonZero(a0 int, a1 func(i int, j int, v float64)) {
+	p.Method_DoColNonZero(a0, a1)
+}
+
 func GijitShadow_InterfaceConvertTo2_ColViewer(x interface{}) (y mat.ColViewer, b bool) {
 	y, b = x.(mat.ColViewer)
 	return
@@ -155,6 +263,14 @@ func GijitShadow_InterfaceConvertTo1_ColViewer(x interface{}) mat.ColViewer {
 	return x.(mat.ColViewer)
 }
 
+type GijitShadow_Proxy_ColViewer struct {
+	Method_ColView func(a0 int) mat.Vector
+}
+
+func (p *GijitShadow_Proxy_ColViewer) ColView(a0 int) mat.Vector {
+	return p.Method_ColView(a0)
+}
+
 func GijitShadow_NewStruct_Conjugate() *mat.Conjugate {
 	return &mat.Conjugate{}
 }
@@ -168,6 +284,14 @@ func GijitShadow_InterfaceConvertTo1_Copier(x interface{}) mat.Copier {
 	return x.(mat.Copier)
 }
 
+type GijitShadow_Proxy_Copier struct {
+	Method_Copy func(a0 mat.Matrix) (int, int)
+}
+
+func (p *GijitShadow_Proxy_Copier) Copy(a0 mat.Matrix) (int, int) {
+	return p.Method_Copy(a0)
+}
+
 func GijitShadow_NewStruct_Dense() *mat.Dense {
 	return &mat.Dense{}
 }
@@ -201,6 +325,19 @@ func GijitShadow_InterfaceConvertTo1_Grower(x interface{}) mat.Grower {
 	return x.(mat.Grower)
 }
 
+type GijitShadow_Proxy_Grower struct {
+	Method_Caps func() (int, int)
+	Method_Grow func(a0 int, a1 int) mat.Matrix
+}
+
+func (p *GijitShadow_Proxy_Grower) Caps() (int, int) {
+	return p.Method_Caps()
+}
+
+func (p *GijitShadow_Proxy_Grower) Grow(a0 int, a1 int) mat.Matrix {
+	return p.Method_Grow(a0, a1)
+}
+
 func GijitShadow_NewStruct_HOGSVD() *mat.HOGSVD {
 	return &mat.HOGSVD{}
 }
@@ -222,6 +359,24 @@ func GijitShadow_InterfaceConvertTo1_Matrix(x interface{}) mat.Matrix {
 	return x.(mat.Matrix)
 }
 
+type GijitShadow_Proxy_Matrix struct {
+	Method_At   func(a0 int, a1 int) float64
+	Method_Dims func() (int, int)
+	Method_T    func() mat.Matrix
+}
+
+func (p *GijitShadow_Proxy_Matrix) At(a0 int, a1 int) float64 {
+	return p.Method_At(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_Matrix) Dims() (int, int) {
+	return p.Method_Dims()
+}
+
+func (p *GijitShadow_Proxy_Matrix) T() mat.Matrix {
+	return p.Method_T()
+}
+
 func GijitShadow_InterfaceConvertTo2_Mutable(x interface{}) (y mat.Mutable, b bool) {
 	y, b = x.(mat.Mutable)
 	return
@@ -231,6 +386,29 @@ func GijitShadow_InterfaceConvertTo1_Mutable(x interface{}) mat.Mutable {
 	return x.(mat.Mutable)
 }
 
+type GijitShadow_Proxy_Mutable struct {
+	Method_At   func(a0 int, a1 int) float64
+	Method_Dims func() (int, int)
+	Method_Set  func(a0 int, a1 int, a2 float64)
+	Method_T    func() mat.Matrix
+}
+
+func (p *GijitShadow_Proxy_Mutable) At(a0 int, a1 int) float64 {
+	return p.Method_At(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_Mutable) Dims() (int, int) {
+	return p.Method_Dims()
+}
+
+func (p *GijitShadow_Proxy_Mutable) Set(a0 int, a1 int, a2 float64) {
+	p.Method_Set(a0, a1, a2)
+}
+
+func (p *GijitShadow_Proxy_Mutable) T() mat.Matrix {
+	return p.Method_T()
+}
+
 func GijitShadow_InterfaceConvertTo2_MutableBanded(x interface{}) (y mat.MutableBanded, b bool) {
 	y, b = x.(mat.MutableBanded)
 	return
@@ -240,6 +418,39 @@ func GijitShadow_InterfaceConvertTo1_MutableBanded(x interface{}) mat.MutableBan
 	return x.(mat.MutableBanded)
 }
 
+type GijitShadow_Proxy_MutableBanded struct {
+	Method_At        func(a0 int, a1 int) float64
+	Method_Bandwidth func() (int, int)
+	Method_Dims      func() (int, int)
+	Method_SetBand   func(a0 int, a1 int, a2 float64)
+	Method_T         func() mat.Matrix
+	Method_TBand     func() mat.Banded
+}
+
+func (p *GijitShadow_Proxy_MutableBanded) At(a0 int, a1 int) float64 {
+	return p.Method_At(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_MutableBanded) Bandwidth() (int, int) {
+	return p.Method_Bandwidth()
+}
+
+func (p *GijitShadow_Proxy_MutableBanded) Dims() (int, int) {
+	return p.Method_Dims()
+}
+
+func (p *GijitShadow_Proxy_MutableBanded) SetBand(a0 int, a1 int, a2 float64) {
+	p.Method_SetBand(a0, a1, a2)
+}
+
+func (p *GijitShadow_Proxy_MutableBanded) T() mat.Matrix {
+	return p.Method_T()
+}
+
+func (p *GijitShadow_Proxy_MutableBanded) TBand() mat.Banded {
+	return p.Method_TBand()
+}
+
 func GijitShadow_InterfaceConvertTo2_MutableSymBanded(x interface{}) (y mat.MutableSymBanded, b bool) {
 	y, b = x.(mat.MutableSymBanded)
 	return
@@ -249,6 +460,39 @@ func GijitShadow_InterfaceConvertTo1_MutableSymBanded(x interface{}) mat.Mutable
 	return x.(mat.MutableSymBanded)
 }
 
+type GijitShadow_Proxy_MutableSymBanded struct {
+	Method_At         func(a0 int, a1 int) float64
+	Method_Bandwidth  func() (int, int)
+	Method_Dims       func() (int, int)
+	Method_SetSymBand func(a0 int, a1 int, a2 float64)
+	Method_Symmetric  func() int
+	Method_T          func() mat.Matrix
+}
+
+func (p *GijitShadow_Proxy_MutableSymBanded) At(a0 int, a1 int) float64 {
+	return p.Method_At(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_MutableSymBanded) Bandwidth() (int, int) {
+	return p.Method_Bandwidth()
+}
+
+func (p *GijitShadow_Proxy_MutableSymBanded) Dims() (int, int) {
+	return p.Method_Dims()
+}
+
+func (p *GijitShadow_Proxy_MutableSymBanded) SetSymBand(a0 int, a1 int, a2 float64) {
+	p.Method_SetSymBand(a0, a1, a2)
+}
+
+func (p *GijitShadow_Proxy_MutableSymBanded) Symmetric() int {
+	return p.Method_Symmetric()
+}
+
+func (p *GijitShadow_Proxy_MutableSymBanded) T() mat.Matrix {
+	return p.Method_T()
+}
+
 func GijitShadow_InterfaceConvertTo2_MutableSymmetric(x interface{}) (y mat.MutableSymmetric, b bool) {
 	y, b = x.(mat.MutableSymmetric)
 	return
@@ -258,6 +502,34 @@ func GijitShadow_InterfaceConvertTo1_MutableSymmetric(x interface{}) mat.Mutable
 	return x.(mat.MutableSymmetric)
 }
 
+type GijitShadow_Proxy_MutableSymmetric struct {
+	Method_At        func(a0 int, a1 int) float64
+	Method_Dims      func() (int, int)
+	Method_SetSym    func(a0 int, a1 int, a2 float64)
+	Method_Symmetric func() int
+	Method_T         func() mat.Matrix
+}
+
+func (p *GijitShadow_Proxy_MutableSymmetric) At(a0 int, a1 int) float64 {
+	return p.Method_At(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_MutableSymmetric) Dims() (int, int) {
+	return p.Method_Dims()
+}
+
+func (p *GijitShadow_Proxy_MutableSymmetric) SetSym(a0 int, a1 int, a2 float64) {
+	p.Method_SetSym(a0, a1, a2)
+}
+
+func (p *GijitShadow_Proxy_MutableSymmetric) Symmetric() int {
+	return p.Method_Symmetric()
+}
+
+func (p *GijitShadow_Proxy_MutableSymmetric) T() mat.Matrix {
+	return p.Method_T()
+}
+
 func GijitShadow_InterfaceConvertTo2_MutableTriangular(x interface{}) (y mat.MutableTriangular, b bool) {
 	y, b = x.(mat.MutableTriangular)
 	return
@@ -267,6 +539,39 @@ func GijitShadow_InterfaceConvertTo1_MutableTriangular(x interface{}) mat.Mutabl
 	return x.(mat.MutableTriangular)
 }
 
+type GijitShadow_Proxy_MutableTriangular struct {
+	Method_At       func(a0 int, a1 int) float64
+	Method_Dims     func() (int, int)
+	Method_SetTri   func(a0 int, a1 int, a2 float64)
+	Method_T        func() mat.Matrix
+	Method_TTri     func() mat.Triangular
+	Method_Triangle func() (int, mat.TriKind)
+}
+
+func (p *GijitShadow_Proxy_MutableTriangular) At(a0 int, a1 int) float64 {
+	return p.Method_At(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_MutableTriangular) Dims() (int, int) {
+	return p.Method_Dims()
+}
+
+func (p *GijitShadow_Proxy_MutableTriangular) SetTri(a0 int, a1 int, a2 float64) {
+	p.Method_SetTri(a0, a1, a2)
+}
+
+func (p *GijitShadow_Proxy_MutableTriangular) T() mat.Matrix {
+	return p.Method_T()
+}
+
+func (p *GijitShadow_Proxy_MutableTriangular) TTri() mat.Triangular {
+	return p.Method_TTri()
+}
+
+func (p *GijitShadow_Proxy_MutableTriangular) Triangle() (int, mat.TriKind) {
+	return p.Method_Triangle()
+}
+
 func GijitShadow_InterfaceConvertTo2_NonZeroDoer(x interface{}) (y mat.NonZeroDoer, b bool) {
 	y, b = x.(mat.NonZeroDoer)
 	return
@@ -276,6 +581,14 @@ func GijitShadow_InterfaceConvertTo1_NonZeroDoer(x interface{}) mat.NonZeroDoer
 	return x.(mat.NonZeroDoer)
 }
 
+type GijitShadow_Proxy_NonZeroDoer struct {
+	Method_DoNonZero func(a0 func(i int, j int, v float64))
+}
+
+func (p *GijitShadow_Proxy_NonZeroDoer) DoNonZero(a0 func(i int, j int, v float64)) {
+	p.Method_DoNonZero(a0)
+}
+
 func GijitShadow_NewStruct_QR() *mat.QR {
 	return &mat.QR{}
 }
@@ -289,6 +602,14 @@ func GijitShadow_InterfaceConvertTo1_RawBander(x interface{}) mat.RawBander {
 	return x.(mat.RawBander)
 }
 
+type GijitShadow_Proxy_RawBander struct {
+	Method_RawBand func() blas64.Band
+}
+
+func (p *GijitShadow_Proxy_RawBander) RawBand() blas64.Band {
+	return p.Method_RawBand()
+}
+
 func GijitShadow_InterfaceConvertTo2_RawColViewer(x interface{}) (y mat.RawColViewer, b bool) {
 	y, b = x.(mat.RawColViewer)
 	return
@@ -298,6 +619,14 @@ func GijitShadow_InterfaceConvertTo1_RawColViewer(x interface{}) mat.RawColViewe
 	return x.(mat.RawColViewer)
 }
 
+type GijitShadow_Proxy_RawColViewer struct {
+	Method_RawColView func(a0 int) []float64
+}
+
+func (p *GijitShadow_Proxy_RawColViewer) RawColView(a0 int) []float64 {
+	return p.Method_RawColView(a0)
+}
+
 func GijitShadow_InterfaceConvertTo2_RawMatrixSetter(x interface{}) (y mat.RawMatrixSetter, b bool) {
 	y, b = x.(mat.RawMatrixSetter)
 	return
@@ -307,6 +636,14 @@ func GijitShadow_InterfaceConvertTo1_RawMatrixSetter(x interface{}) mat.RawMatri
 	return x.(mat.RawMatrixSetter)
 }
 
+type GijitShadow_Proxy_RawMatrixSetter struct {
+	Method_SetRawMatrix func(a0 blas64.General)
+}
+
+func (p *GijitShadow_Proxy_RawMatrixSetter) SetRawMatrix(a0 blas64.General) {
+	p.Method_SetRawMatrix(a0)
+}
+
 func GijitShadow_InterfaceConvertTo2_RawMatrixer(x interface{}) (y mat.RawMatrixer, b bool) {
 	y, b = x.(mat.RawMatrixer)
 	return
@@ -316,6 +653,14 @@ func GijitShadow_InterfaceConvertTo1_RawMatrixer(x interface{}) mat.RawMatrixer
 	return x.(mat.RawMatrixer)
 }
 
+type GijitShadow_Proxy_RawMatrixer struct {
+	Method_RawMatrix func() blas64.General
+}
+
+func (p *GijitShadow_Proxy_RawMatrixer) RawMatrix() blas64.General {
+	return p.Method_RawMatrix()
+}
+
 func GijitShadow_InterfaceConvertTo2_RawRowViewer(x interface{}) (y mat.RawRowViewer, b bool) {
 	y, b = x.(mat.RawRowViewer)
 	return
@@ -325,6 +670,14 @@ func GijitShadow_InterfaceConvertTo1_RawRowViewer(x interface{}) mat.RawRowViewe
 	return x.(mat.RawRowViewer)
 }
 
+type GijitShadow_Proxy_RawRowViewer struct {
+	Method_RawRowView func(a0 int) []float64
+}
+
+func (p *GijitShadow_Proxy_RawRowViewer) RawRowView(a0 int) []float64 {
+	return p.Method_RawRowView(a0)
+}
+
 func GijitShadow_InterfaceConvertTo2_RawSymBander(x interface{}) (y mat.RawSymBander, b bool) {
 	y, b = x.(mat.RawSymBander)
 	return
@@ -334,6 +687,14 @@ func GijitShadow_InterfaceConvertTo1_RawSymBander(x interface{}) mat.RawSymBande
 	return x.(mat.RawSymBander)
 }
 
+type GijitShadow_Proxy_RawSymBander struct {
+	Method_RawSymBand func() blas64.SymmetricBand
+}
+
+func (p *GijitShadow_Proxy_RawSymBander) RawSymBand() blas64.SymmetricBand {
+	return p.Method_RawSymBand()
+}
+
 func GijitShadow_InterfaceConvertTo2_RawSymmetricer(x interface{}) (y mat.RawSymmetricer, b bool) {
 	y, b = x.(mat.RawSymmetricer)
 	return
@@ -343,6 +704,14 @@ func GijitShadow_InterfaceConvertTo1_RawSymmetricer(x interface{}) mat.RawSymmet
 	return x.(mat.RawSymmetricer)
 }
 
+type GijitShadow_Proxy_RawSymmetricer struct {
+	Method_RawSymmetric func() blas64.Symmetric
+}
+
+func (p *GijitShadow_Proxy_RawSymmetricer) RawSymmetric() blas64.Symmetric {
+	return p.Method_RawSymmetric()
+}
+
 func GijitShadow_InterfaceConvertTo2_RawTriangular(x interface{}) (y mat.RawTriangular, b bool) {
 	y, b = x.(mat.RawTriangular)
 	return
@@ -352,6 +721,14 @@ func GijitShadow_InterfaceConvertTo1_RawTriangular(x interface{}) mat.RawTriangu
 	return x.(mat.RawTriangular)
 }
 
+type GijitShadow_Proxy_RawTriangular struct {
+	Method_RawTriangular func() blas64.Triangular
+}
+
+func (p *GijitShadow_Proxy_RawTriangular) RawTriangular() blas64.Triangular {
+	return p.Method_RawTriangular()
+}
+
 func GijitShadow_InterfaceConvertTo2_RawVectorer(x interface{}) (y mat.RawVectorer, b bool) {
 	y, b = x.(mat.RawVectorer)
 	return
@@ -361,6 +738,14 @@ func GijitShadow_InterfaceConvertTo1_RawVectorer(x interface{}) mat.RawVectorer
 	return x.(mat.RawVectorer)
 }
 
+type GijitShadow_Proxy_RawVectorer struct {
+	Method_RawVector func() blas64.Vector
+}
+
+func (p *GijitShadow_Proxy_RawVectorer) RawVector() blas64.Vector {
+	return p.Method_RawVector()
+}
+
 func GijitShadow_InterfaceConvertTo2_Reseter(x interface{}) (y mat.Reseter, b bool) {
 	y, b = x.(mat.Reseter)
 	return
@@ -370,6 +755,14 @@ func GijitShadow_InterfaceConvertTo1_Reseter(x interface{}) mat.Reseter {
 	return x.(mat.Reseter)
 }
 
+type GijitShadow_Proxy_Reseter struct {
+	Method_Reset func()
+}
+
+func (p *GijitShadow_Proxy_Reseter) Reset() {
+	p.Method_Reset()
+}
+
 func GijitShadow_InterfaceConvertTo2_RowNonZeroDoer(x interface{}) (y mat.RowNonZeroDoer, b bool) {
 	y, b = x.(mat.RowNonZeroDoer)
 	return
@@ -379,6 +772,14 @@ func GijitShadow_InterfaceConvertTo1_RowNonZeroDoer(x interface{}) mat.RowNonZer
 	return x.(mat.RowNonZeroDoer)
 }
 
+type GijitShadow_Proxy_RowNonZeroDoer struct {
+	Method_DoRowNonZero func(a0 int, a1 func(i int, j int, v float64))
+}
+
+func (p *GijitShadow_Proxy_RowNonZeroDoer) DoRowNonZero(a0 int, a1 func(i int, j int, v float64)) {
+	p.Method_DoRowNonZero(a0, a1)
+}
+
 func GijitShadow_InterfaceConvertTo2_RowViewer(x interface{}) (y mat.RowViewer, b bool) {
 	y, b = x.(mat.RowViewer)
 	return
@@ -388,6 +789,14 @@ func GijitShadow_InterfaceConvertTo1_RowViewer(x interface{}) mat.RowViewer {
 	return x.(mat.RowViewer)
 }
 
+type GijitShadow_Proxy_RowViewer struct {
+	Method_RowView func(a0 int) mat.Vector
+}
+
+func (p *GijitShadow_Proxy_RowViewer) RowView(a0 int) mat.Vector {
+	return p.Method_RowView(a0)
+}
+
 func GijitShadow_NewStruct_SVD() *mat.SVD {
 	return &mat.SVD{}
 }
@@ -409,6 +818,29 @@ func GijitShadow_InterfaceConvertTo1_Symmetric(x interface{}) mat.Symmetric {
 	return x.(mat.Symmetric)
 }
 
+type GijitShadow_Proxy_Symmetric struct {
+	Method_At        func(a0 int, a1 int) float64
+	Method_Dims      func() (int, int)
+	Method_Symmetric func() int
+	Method_T         func() mat.Matrix
+}
+
+func (p *GijitShadow_Proxy_Symmetric) At(a0 int, a1 int) float64 {
+	return p.Method_At(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_Symmetric) Dims() (int, int) {
+	return p.Method_Dims()
+}
+
+func (p *GijitShadow_Proxy_Symmetric) Symmetric() int {
+	return p.Method_Symmetric()
+}
+
+func (p *GijitShadow_Proxy_Symmetric) T() mat.Matrix {
+	return p.Method_T()
+}
+
 func GijitShadow_NewStruct_Transpose() *mat.Transpose {
 	return &mat.Transpose{}
 }
@@ -438,6 +870,34 @@ func GijitShadow_InterfaceConvertTo1_Triangular(x interface{}) mat.Triangular {
 	return x.(mat.Triangular)
 }
 
+type GijitShadow_Proxy_Triangular struct {
+	Method_At       func(a0 int, a1 int) float64
+	Method_Dims     func() (int, int)
+	Method_T        func() mat.Matrix
+	Method_TTri     func() mat.Triangular
+	Method_Triangle func() (int, mat.TriKind)
+}
+
+func (p *GijitShadow_Proxy_Triangular) At(a0 int, a1 int) float64 {
+	return p.Method_At(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_Triangular) Dims() (int, int) {
+	return p.Method_Dims()
+}
+
+func (p *GijitShadow_Proxy_Triangular) T() mat.Matrix {
+	return p.Method_T()
+}
+
+func (p *GijitShadow_Proxy_Triangular) TTri() mat.Triangular {
+	return p.Method_TTri()
+}
+
+func (p *GijitShadow_Proxy_Triangular) Triangle() (int, mat.TriKind) {
+	return p.Method_Triangle()
+}
+
 func GijitShadow_InterfaceConvertTo2_Unconjugator(x interface{}) (y mat.Unconjugator, b bool) {
 	y, b = x.(mat.Unconjugator)
 	return
@@ -447,6 +907,14 @@ func GijitShadow_InterfaceConvertTo1_Unconjugator(x interface{}) mat.Unconjugato
 	return x.(mat.Unconjugator)
 }
 
+type GijitShadow_Proxy_Unconjugator struct {
+	Method_Unconjugate func() mat.CMatrix
+}
+
+func (p *GijitShadow_Proxy_Unconjugator) Unconjugate() mat.CMatrix {
+	return p.Method_Unconjugate()
+}
+
 func GijitShadow_InterfaceConvertTo2_UntransposeBander(x interface{}) (y mat.UntransposeBander, b bool) {
 	y, b = x.(mat.UntransposeBander)
 	return
@@ -456,6 +924,14 @@ func GijitShadow_InterfaceConvertTo1_UntransposeBander(x interface{}) mat.Untran
 	return x.(mat.UntransposeBander)
 }
 
+type GijitShadow_Proxy_UntransposeBander struct {
+	Method_UntransposeBand func() mat.Banded
+}
+
+func (p *GijitShadow_Proxy_UntransposeBander) UntransposeBand() mat.Banded {
+	return p.Method_UntransposeBand()
+}
+
 func GijitShadow_InterfaceConvertTo2_UntransposeTrier(x interface{}) (y mat.UntransposeTrier, b bool) {
 	y, b = x.(mat.UntransposeTrier)
 	return
@@ -465,6 +941,14 @@ func GijitShadow_InterfaceConvertTo1_UntransposeTrier(x interface{}) mat.Untrans
 	return x.(mat.UntransposeTrier)
 }
 
+type GijitShadow_Proxy_UntransposeTrier struct {
+	Method_UntransposeTri func() mat.Triangular
+}
+
+func (p *GijitShadow_Proxy_UntransposeTrier) UntransposeTri() mat.Triangular {
+	return p.Method_UntransposeTri()
+}
+
 func GijitShadow_InterfaceConvertTo2_Untransposer(x interface{}) (y mat.Untransposer, b bool) {
 	y, b = x.(mat.Untransposer)
 	return
@@ -474,6 +958,14 @@ func GijitShadow_InterfaceConvertTo1_Untransposer(x interface{}) mat.Untranspose
 	return x.(mat.Untransposer)
 }
 
+type GijitShadow_Proxy_Untransposer struct {
+	Method_Untranspose func() mat.Matrix
+}
+
+func (p *GijitShadow_Proxy_Untransposer) Untranspose() mat.Matrix {
+	return p.Method_Untranspose()
+}
+
 func GijitShadow_NewStruct_VecDense() *mat.VecDense {
 	return &mat.VecDense{}
 }
@@ -486,3 +978,31 @@ func GijitShadow_InterfaceConvertTo2_Vector(x interface{}) (y mat.Vector, b bool
 func GijitShadow_InterfaceConvertTo1_Vector(x interface{}) mat.Vector {
 	return x.(mat.Vector)
 }
+
+type GijitShadow_Proxy_Vector struct {
+	Method_At    func(a0 int, a1 int) float64
+	Method_AtVec func(a0 int) float64
+	Method_Dims  func() (int, int)
+	Method_Len   func() int
+	Method_T     func() mat.Matrix
+}
+
+func (p *GijitShadow_Proxy_Vector) At(a0 int, a1 int) float64 {
+	return p.Method_At(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_Vector) AtVec(a0 int) float64 {
+	return p.Method_AtVec(a0)
+}
+
+func (p *GijitShadow_Proxy_Vector) Dims() (int, int) {
+	return p.Method_Dims()
+}
+
+func (p *GijitShadow_Proxy_Vector) Len() int {
+	return p.Method_Len()
+}
+
+func (p *GijitShadow_Proxy_Vector) T() mat.Matrix {
+	return p.Method_T()
+}
@@ -3,10 +3,12 @@ package shadow_optimize
 import "gonum.org/v1/gonum/optimize"
 
 var Pkg = make(map[string]interface{})
+var Proxy = make(map[string]interface{})
 
 func init() {
 	Pkg["ArmijoConditionMet"] = optimize.ArmijoConditionMet
 	Pkg["CGVariant"] = GijitShadow_InterfaceConvertTo2_CGVariant
+	Proxy["CGVariant"] = (*GijitShadow_Proxy_CGVariant)(nil)
 	Pkg["DefaultSettings"] = optimize.DefaultSettings
 	Pkg["DefaultSettingsGlobal"] = optimize.DefaultSettingsGlobal
 	Pkg["ErrLinesearcherBound"] = optimize.ErrLinesearcherBound
@@ -16,16 +18,24 @@ func init() {
 	Pkg["ErrZeroDimensional"] = optimize.ErrZeroDimensional
 	Pkg["Global"] = optimize.Global
 	Pkg["GlobalMethod"] = GijitShadow_InterfaceConvertTo2_GlobalMethod
+	Proxy["GlobalMethod"] = (*GijitShadow_Proxy_GlobalMethod)(nil)
 	Pkg["Linesearcher"] = GijitShadow_InterfaceConvertTo2_Linesearcher
+	Proxy["Linesearcher"] = (*GijitShadow_Proxy_Linesearcher)(nil)
 	Pkg["Local"] = optimize.Local
 	Pkg["Method"] = GijitShadow_InterfaceConvertTo2_Method
+	Proxy["Method"] = (*GijitShadow_Proxy_Method)(nil)
 	Pkg["Needser"] = GijitShadow_InterfaceConvertTo2_Needser
+	Proxy["Needser"] = (*GijitShadow_Proxy_Needser)(nil)
 	Pkg["NewPrinter"] = optimize.NewPrinter
 	Pkg["NewStatus"] = optimize.NewStatus
 	Pkg["NextDirectioner"] = GijitShadow_InterfaceConvertTo2_NextDirectioner
+	Proxy["NextDirectioner"] = (*GijitShadow_Proxy_NextDirectioner)(nil)
 	Pkg["Recorder"] = GijitShadow_InterfaceConvertTo2_Recorder
+	Proxy["Recorder"] = (*GijitShadow_Proxy_Recorder)(nil)
 	Pkg["Statuser"] = GijitShadow_InterfaceConvertTo2_Statuser
+	Proxy["Statuser"] = (*GijitShadow_Proxy_Statuser)(nil)
 	Pkg["StepSizer"] = GijitShadow_InterfaceConvertTo2_StepSizer
+	Proxy["StepSizer"] = (*GijitShadow_Proxy_StepSizer)(nil)
 	Pkg["StrongWolfeConditionsMet"] = optimize.StrongWolfeConditionsMet
 	Pkg["WeakWolfeConditionsMet"] = optimize.WeakWolfeConditionsMet
 
@@ -55,6 +65,19 @@ func GijitShadow_InterfaceConvertTo1_CGVariant(x interface{}) optimize.CGVariant
 	return x.(optimize.CGVariant)
 }
 
+type GijitShadow_Proxy_CGVariant struct {
+	Method_Beta func(a0 []float64, a1 []float64, a2 []float64) float64
+	Method_Init func(a0 *optimize.Location)
+}
+
+func (p *GijitShadow_Proxy_CGVariant) Beta(a0 []float64, a1 []float64, a2 []float64) float64 {
+	return p.Method_Beta(a0, a1, a2)
+}
+
+func (p *GijitShadow_Proxy_CGVariant) Init(a0 *optimize.Location) {
+	p.Method_Init(a0)
+}
+
 func GijitShadow_NewStruct_CmaEsChol() *optimize.CmaEsChol {
 	return &optimize.CmaEsChol{}
 }
@@ -92,6 +115,30 @@ func GijitShadow_InterfaceConvertTo1_GlobalMethod(x interface{}) optimize.Global
 	return x.(optimize.GlobalMethod)
 }
 
+type GijitShadow_Proxy_GlobalMethod struct {
+	Method_InitGlobal func(a0 int, a1 int) int
+	Method_Needs      func() struct {
+		Gradient bool
+		Hessian  bool
+	}
+	Method_RunGlobal func(a0 chan<- optimize.GlobalTask, a1 <-chan optimize.GlobalTask, a2 []optimize.GlobalTask)
+}
+
+func (p *GijitShadow_Proxy_GlobalMethod) InitGlobal(a0 int, a1 int) int {
+	return p.Method_InitGlobal(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_GlobalMethod) Needs() struct {
+	Gradient bool
+	Hessian  bool
+} {
+	return p.Method_Needs()
+}
+
+func (p *GijitShadow_Proxy_GlobalMethod) RunGlobal(a0 chan<- optimize.GlobalTask, a1 <-chan optimize.GlobalTask, a2 []optimize.GlobalTask) {
+	p.Method_RunGlobal(a0, a1, a2)
+}
+
 func GijitShadow_NewStruct_GlobalTask() *optimize.GlobalTask {
 	return &optimize.GlobalTask{}
 }
@@ -129,6 +176,19 @@ func GijitShadow_InterfaceConvertTo1_Linesearcher(x interface{}) optimize.Linese
 	return x.(optimize.Linesearcher)
 }
 
+type GijitShadow_Proxy_Linesearcher struct {
+	Method_Init    func(a0 float64, a1 float64, a2 float64) optimize.Operation
+	Method_Iterate func(a0 float64, a1 float64) (optimize.Operation, float64, error)
+}
+
+func (p *GijitShadow_Proxy_Linesearcher) Init(a0 float64, a1 float64, a2 float64) optimize.Operation {
+	return p.Method_Init(a0, a1, a2)
+}
+
+func (p *GijitShadow_Proxy_Linesearcher) Iterate(a0 float64, a1 float64) (optimize.Operation, float64, error) {
+	return p.Method_Iterate(a0, a1)
+}
+
 func GijitShadow_NewStruct_Location() *optimize.Location {
 	return &optimize.Location{}
 }
@@ -142,6 +202,30 @@ func GijitShadow_InterfaceConvertTo1_Method(x interface{}) optimize.Method {
 	return x.(optimize.Method)
 }
 
+type GijitShadow_Proxy_Method struct {
+	Method_Init    func(a0 *optimize.Location) (optimize.Operation, error)
+	Method_Iterate func(a0 *optimize.Location) (optimize.Operation, error)
+	Method_Needs   func() struct {
+		Gradient bool
+		Hessian  bool
+	}
+}
+
+func (p *GijitShadow_Proxy_Method) Init(a0 *optimize.Location) (optimize.Operation, error) {
+	return p.Method_Init(a0)
+}
+
+func (p *GijitShadow_Proxy_Method) Iterate(a0 *optimize.Location) (optimize.Operation, error) {
+	return p.Method_Iterate(a0)
+}
+
+func (p *GijitShadow_Proxy_Method) Needs() struct {
+	Gradient bool
+	Hessian  bool
+} {
+	return p.Method_Needs()
+}
+
 func GijitShadow_NewStruct_MoreThuente() *optimize.MoreThuente {
 	return &optimize.MoreThuente{}
 }
@@ -155,6 +239,20 @@ func GijitShadow_InterfaceConvertTo1_Needser(x interface{}) optimize.Needser {
 	return x.(optimize.Needser)
 }
 
+type GijitShadow_Proxy_Needser struct {
+	Method_Needs func() struct {
+		Gradient bool
+		Hessian  bool
+	}
+}
+
+func (p *GijitShadow_Proxy_Needser) Needs() struct {
+	Gradient bool
+	Hessian  bool
+} {
+	return p.Method_Needs()
+}
+
 func GijitShadow_NewStruct_NelderMead() *optimize.NelderMead {
 	return &optimize.NelderMead{}
 }
@@ -172,6 +270,19 @@ func GijitShadow_InterfaceConvertTo1_NextDirectioner(x interface{}) optimize.Nex
 	return x.(optimize.NextDirectioner)
 }
 
+type GijitShadow_Proxy_NextDirectioner struct {
+	Method_InitDirection func(a0 *optimize.Location, a1 []float64) float64
+	Method_NextDirection func(a0 *optimize.Location, a1 []float64) float64
+}
+
+func (p *GijitShadow_Proxy_NextDirectioner) InitDirection(a0 *optimize.Location, a1 []float64) float64 {
+	return p.Method_InitDirection(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_NextDirectioner) NextDirection(a0 *optimize.Location, a1 []float64) float64 {
+	return p.Method_NextDirection(a0, a1)
+}
+
 func GijitShadow_NewStruct_PolakRibierePolyak() *optimize.PolakRibierePolyak {
 	return &optimize.PolakRibierePolyak{}
 }
@@ -197,6 +308,19 @@ func GijitShadow_InterfaceConvertTo1_Recorder(x interface{}) optimize.Recorder {
 	return x.(optimize.Recorder)
 }
 
+type GijitShadow_Proxy_Recorder struct {
+	Method_Init   func() error
+	Method_Record func(a0 *optimize.Location, a1 optimize.Operation, a2 *optimize.Stats) error
+}
+
+func (p *GijitShadow_Proxy_Recorder) Init() error {
+	return p.Method_Init()
+}
+
+func (p *GijitShadow_Proxy_Recorder) Record(a0 *optimize.Location, a1 optimize.Operation, a2 *optimize.Stats) error {
+	return p.Method_Record(a0, a1, a2)
+}
+
 func GijitShadow_NewStruct_Result() *optimize.Result {
 	return &optimize.Result{}
 }
@@ -218,6 +342,14 @@ func GijitShadow_InterfaceConvertTo1_Statuser(x interface{}) optimize.Statuser {
 	return x.(optimize.Statuser)
 }
 
+type GijitShadow_Proxy_Statuser struct {
+	Method_Status func() (optimize.Status, error)
+}
+
+func (p *GijitShadow_Proxy_Statuser) Status() (optimize.Status, error) {
+	return p.Method_Status()
+}
+
 func GijitShadow_InterfaceConvertTo2_StepSizer(x interface{}) (y optimize.StepSizer, b bool) {
 	y, b = x.(optimize.StepSizer)
 	return
@@ -226,3 +358,16 @@ func GijitShadow_InterfaceConvertTo2_StepSizer(x interface{}) (y optimize.StepSi
 func GijitShadow_InterfaceConvertTo1_StepSizer(x interface{}) optimize.StepSizer {
 	return x.(optimize.StepSizer)
 }
+
+type GijitShadow_Proxy_StepSizer struct {
+	Method_Init     func(a0 *optimize.Location, a1 []float64) float64
+	Method_StepSize func(a0 *optimize.Location, a1 []float64) float64
+}
+
+func (p *GijitShadow_Proxy_StepSizer) Init(a0 *optimize.Location, a1 []float64) float64 {
+	return p.Method_Init(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_StepSizer) StepSize(a0 *optimize.Location, a1 []float64) float64 {
+	return p.Method_StepSize(a0, a1)
+}
@@ -3,6 +3,7 @@ package shadow_unit
 import "gonum.org/v1/gonum/unit"
 
 var Pkg = make(map[string]interface{})
+var Proxy = make(map[string]interface{})
 
 func init() {
 	Pkg["Atto"] = unit.Atto
@@ -26,6 +27,7 @@ func init() {
 	Pkg["SymbolExists"] = unit.SymbolExists
 	Pkg["Tera"] = unit.Tera
 	Pkg["Uniter"] = GijitShadow_InterfaceConvertTo2_Uniter
+	Proxy["Uniter"] = (*GijitShadow_Proxy_Uniter)(nil)
 	Pkg["Yocto"] = unit.Yocto
 	Pkg["Yotta"] = unit.Yotta
 	Pkg["Zepto"] = unit.Zepto
@@ -44,3 +46,11 @@ func GijitShadow_InterfaceConvertTo2_Uniter(x interface{}) (y unit.Uniter, b boo
 func GijitShadow_InterfaceConvertTo1_Uniter(x interface{}) unit.Uniter {
 	return x.(unit.Uniter)
 }
+
+type GijitShadow_Proxy_Uniter struct {
+	Method_Unit func() *unit.Unit
+}
+
+func (p *GijitShadow_Proxy_Uniter) Unit() *unit.Unit {
+	return p.Method_Unit()
+}
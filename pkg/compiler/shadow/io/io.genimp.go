@@ -4,12 +4,17 @@ import "io"
 
 var Pkg = make(map[string]interface{})
 var Ctor = make(map[string]interface{})
+var Proxy = make(map[string]interface{})
 
 func init() {
     Pkg["ByteReader"] = GijitShadow_InterfaceConvertTo2_ByteReader
+    Proxy["ByteReader"] = (*GijitShadow_Proxy_ByteReader)(nil)
     Pkg["ByteScanner"] = GijitShadow_InterfaceConvertTo2_ByteScanner
+    Proxy["ByteScanner"] = (*GijitShadow_Proxy_ByteScanner)(nil)
     Pkg["ByteWriter"] = GijitShadow_InterfaceConvertTo2_ByteWriter
+    Proxy["ByteWriter"] = (*GijitShadow_Proxy_ByteWriter)(nil)
     Pkg["Closer"] = GijitShadow_InterfaceConvertTo2_Closer
+    Proxy["Closer"] = (*GijitShadow_Proxy_Closer)(nil)
     Pkg["Copy"] = io.Copy
     Pkg["CopyBuffer"] = io.CopyBuffer
     Pkg["CopyN"] = io.CopyN
@@ -29,28 +34,44 @@ func init() {
     Ctor["PipeWriter"] = GijitShadow_NewStruct_PipeWriter
     Pkg["ReadAtLeast"] = io.ReadAtLeast
     Pkg["ReadCloser"] = GijitShadow_InterfaceConvertTo2_ReadCloser
+    Proxy["ReadCloser"] = (*GijitShadow_Proxy_ReadCloser)(nil)
     Pkg["ReadFull"] = io.ReadFull
     Pkg["ReadSeeker"] = GijitShadow_InterfaceConvertTo2_ReadSeeker
+    Proxy["ReadSeeker"] = (*GijitShadow_Proxy_ReadSeeker)(nil)
     Pkg["ReadWriteCloser"] = GijitShadow_InterfaceConvertTo2_ReadWriteCloser
+    Proxy["ReadWriteCloser"] = (*GijitShadow_Proxy_ReadWriteCloser)(nil)
     Pkg["ReadWriteSeeker"] = GijitShadow_InterfaceConvertTo2_ReadWriteSeeker
+    Proxy["ReadWriteSeeker"] = (*GijitShadow_Proxy_ReadWriteSeeker)(nil)
     Pkg["ReadWriter"] = GijitShadow_InterfaceConvertTo2_ReadWriter
+    Proxy["ReadWriter"] = (*GijitShadow_Proxy_ReadWriter)(nil)
     Pkg["Reader"] = GijitShadow_InterfaceConvertTo2_Reader
+    Proxy["Reader"] = (*GijitShadow_Proxy_Reader)(nil)
     Pkg["ReaderAt"] = GijitShadow_InterfaceConvertTo2_ReaderAt
+    Proxy["ReaderAt"] = (*GijitShadow_Proxy_ReaderAt)(nil)
     Pkg["ReaderFrom"] = GijitShadow_InterfaceConvertTo2_ReaderFrom
+    Proxy["ReaderFrom"] = (*GijitShadow_Proxy_ReaderFrom)(nil)
     Pkg["RuneReader"] = GijitShadow_InterfaceConvertTo2_RuneReader
+    Proxy["RuneReader"] = (*GijitShadow_Proxy_RuneReader)(nil)
     Pkg["RuneScanner"] = GijitShadow_InterfaceConvertTo2_RuneScanner
+    Proxy["RuneScanner"] = (*GijitShadow_Proxy_RuneScanner)(nil)
     Ctor["SectionReader"] = GijitShadow_NewStruct_SectionReader
     Pkg["SeekCurrent"] = io.SeekCurrent
     Pkg["SeekEnd"] = io.SeekEnd
     Pkg["SeekStart"] = io.SeekStart
     Pkg["Seeker"] = GijitShadow_InterfaceConvertTo2_Seeker
+    Proxy["Seeker"] = (*GijitShadow_Proxy_Seeker)(nil)
     Pkg["TeeReader"] = io.TeeReader
     Pkg["WriteCloser"] = GijitShadow_InterfaceConvertTo2_WriteCloser
+    Proxy["WriteCloser"] = (*GijitShadow_Proxy_WriteCloser)(nil)
     Pkg["WriteSeeker"] = GijitShadow_InterfaceConvertTo2_WriteSeeker
+    Proxy["WriteSeeker"] = (*GijitShadow_Proxy_WriteSeeker)(nil)
     Pkg["WriteString"] = io.WriteString
     Pkg["Writer"] = GijitShadow_InterfaceConvertTo2_Writer
+    Proxy["Writer"] = (*GijitShadow_Proxy_Writer)(nil)
     Pkg["WriterAt"] = GijitShadow_InterfaceConvertTo2_WriterAt
+    Proxy["WriterAt"] = (*GijitShadow_Proxy_WriterAt)(nil)
     Pkg["WriterTo"] = GijitShadow_InterfaceConvertTo2_WriterTo
+    Proxy["WriterTo"] = (*GijitShadow_Proxy_WriterTo)(nil)
 
 }
 func GijitShadow_InterfaceConvertTo2_ByteReader(x interface{}) (y io.ByteReader, b bool) {
@@ -62,6 +83,14 @@ func GijitShadow_InterfaceConvertTo1_ByteReader(x interface{}) io.ByteReader {
 	return x.(io.ByteReader)
 }
 
+type GijitShadow_Proxy_ByteReader struct {
+	Method_ReadByte func() (byte, error)
+}
+
+func (p *GijitShadow_Proxy_ByteReader) ReadByte() (byte, error) {
+	return p.Method_ReadByte()
+}
+
 
 func GijitShadow_InterfaceConvertTo2_ByteScanner(x interface{}) (y io.ByteScanner, b bool) {
 	y, b = x.(io.ByteScanner)
@@ -72,6 +101,19 @@ func GijitShadow_InterfaceConvertTo1_ByteScanner(x interface{}) io.ByteScanner {
 	return x.(io.ByteScanner)
 }
 
+type GijitShadow_Proxy_ByteScanner struct {
+	Method_ReadByte func() (byte, error)
+	Method_UnreadByte func() error
+}
+
+func (p *GijitShadow_Proxy_ByteScanner) ReadByte() (byte, error) {
+	return p.Method_ReadByte()
+}
+
+func (p *GijitShadow_Proxy_ByteScanner) UnreadByte() error {
+	return p.Method_UnreadByte()
+}
+
 
 func GijitShadow_InterfaceConvertTo2_ByteWriter(x interface{}) (y io.ByteWriter, b bool) {
 	y, b = x.(io.ByteWriter)
@@ -82,6 +124,14 @@ func GijitShadow_InterfaceConvertTo1_ByteWriter(x interface{}) io.ByteWriter {
 	return x.(io.ByteWriter)
 }
 
+type GijitShadow_Proxy_ByteWriter struct {
+	Method_WriteByte func(a0 byte) error
+}
+
+func (p *GijitShadow_Proxy_ByteWriter) WriteByte(a0 byte) error {
+	return p.Method_WriteByte(a0)
+}
+
 
 func GijitShadow_InterfaceConvertTo2_Closer(x interface{}) (y io.Closer, b bool) {
 	y, b = x.(io.Closer)
@@ -92,6 +142,14 @@ func GijitShadow_InterfaceConvertTo1_Closer(x interface{}) io.Closer {
 	return x.(io.Closer)
 }
 
+type GijitShadow_Proxy_Closer struct {
+	Method_Close func() error
+}
+
+func (p *GijitShadow_Proxy_Closer) Close() error {
+	return p.Method_Close()
+}
+
 
 func GijitShadow_NewStruct_LimitedReader(src *io.LimitedReader) *io.LimitedReader {
     if src == nil {
@@ -129,6 +187,19 @@ func GijitShadow_InterfaceConvertTo1_ReadCloser(x interface{}) io.ReadCloser {
 	return x.(io.ReadCloser)
 }
 
+type GijitShadow_Proxy_ReadCloser struct {
+	Method_Close func() error
+	Method_Read func(a0 []byte) (int, error)
+}
+
+func (p *GijitShadow_Proxy_ReadCloser) Close() error {
+	return p.Method_Close()
+}
+
+func (p *GijitShadow_Proxy_ReadCloser) Read(a0 []byte) (int, error) {
+	return p.Method_Read(a0)
+}
+
 
 func GijitShadow_InterfaceConvertTo2_ReadSeeker(x interface{}) (y io.ReadSeeker, b bool) {
 	y, b = x.(io.ReadSeeker)
@@ -139,6 +210,19 @@ func GijitShadow_InterfaceConvertTo1_ReadSeeker(x interface{}) io.ReadSeeker {
 	return x.(io.ReadSeeker)
 }
 
+type GijitShadow_Proxy_ReadSeeker struct {
+	Method_Read func(a0 []byte) (int, error)
+	Method_Seek func(a0 int64, a1 int) (int64, error)
+}
+
+func (p *GijitShadow_Proxy_ReadSeeker) Read(a0 []byte) (int, error) {
+	return p.Method_Read(a0)
+}
+
+func (p *GijitShadow_Proxy_ReadSeeker) Seek(a0 int64, a1 int) (int64, error) {
+	return p.Method_Seek(a0, a1)
+}
+
 
 func GijitShadow_InterfaceConvertTo2_ReadWriteCloser(x interface{}) (y io.ReadWriteCloser, b bool) {
 	y, b = x.(io.ReadWriteCloser)
@@ -149,6 +233,24 @@ func GijitShadow_InterfaceConvertTo1_ReadWriteCloser(x interface{}) io.ReadWrite
 	return x.(io.ReadWriteCloser)
 }
 
+type GijitShadow_Proxy_ReadWriteCloser struct {
+	Method_Close func() error
+	Method_Read func(a0 []byte) (int, error)
+	Method_Write func(a0 []byte) (int, error)
+}
+
+func (p *GijitShadow_Proxy_ReadWriteCloser) Close() error {
+	return p.Method_Close()
+}
+
+func (p *GijitShadow_Proxy_ReadWriteCloser) Read(a0 []byte) (int, error) {
+	return p.Method_Read(a0)
+}
+
+func (p *GijitShadow_Proxy_ReadWriteCloser) Write(a0 []byte) (int, error) {
+	return p.Method_Write(a0)
+}
+
 
 func GijitShadow_InterfaceConvertTo2_ReadWriteSeeker(x interface{}) (y io.ReadWriteSeeker, b bool) {
 	y, b = x.(io.ReadWriteSeeker)
@@ -159,6 +261,24 @@ func GijitShadow_InterfaceConvertTo1_ReadWriteSeeker(x interface{}) io.ReadWrite
 	return x.(io.ReadWriteSeeker)
 }
 
+type GijitShadow_Proxy_ReadWriteSeeker struct {
+	Method_Read func(a0 []byte) (int, error)
+	Method_Seek func(a0 int64, a1 int) (int64, error)
+	Method_Write func(a0 []byte) (int, error)
+}
+
+func (p *GijitShadow_Proxy_ReadWriteSeeker) Read(a0 []byte) (int, error) {
+	return p.Method_Read(a0)
+}
+
+func (p *GijitShadow_Proxy_ReadWriteSeeker) Seek(a0 int64, a1 int) (int64, error) {
+	return p.Method_Seek(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_ReadWriteSeeker) Write(a0 []byte) (int, error) {
+	return p.Method_Write(a0)
+}
+
 
 func GijitShadow_InterfaceConvertTo2_ReadWriter(x interface{}) (y io.ReadWriter, b bool) {
 	y, b = x.(io.ReadWriter)
@@ -169,6 +289,19 @@ func GijitShadow_InterfaceConvertTo1_ReadWriter(x interface{}) io.ReadWriter {
 	return x.(io.ReadWriter)
 }
 
+type GijitShadow_Proxy_ReadWriter struct {
+	Method_Read func(a0 []byte) (int, error)
+	Method_Write func(a0 []byte) (int, error)
+}
+
+func (p *GijitShadow_Proxy_ReadWriter) Read(a0 []byte) (int, error) {
+	return p.Method_Read(a0)
+}
+
+func (p *GijitShadow_Proxy_ReadWriter) Write(a0 []byte) (int, error) {
+	return p.Method_Write(a0)
+}
+
 
 func GijitShadow_InterfaceConvertTo2_Reader(x interface{}) (y io.Reader, b bool) {
 	y, b = x.(io.Reader)
@@ -179,6 +312,14 @@ func GijitShadow_InterfaceConvertTo1_Reader(x interface{}) io.Reader {
 	return x.(io.Reader)
 }
 
+type GijitShadow_Proxy_Reader struct {
+	Method_Read func(a0 []byte) (int, error)
+}
+
+func (p *GijitShadow_Proxy_Reader) Read(a0 []byte) (int, error) {
+	return p.Method_Read(a0)
+}
+
 
 func GijitShadow_InterfaceConvertTo2_ReaderAt(x interface{}) (y io.ReaderAt, b bool) {
 	y, b = x.(io.ReaderAt)
@@ -189,6 +330,14 @@ func GijitShadow_InterfaceConvertTo1_ReaderAt(x interface{}) io.ReaderAt {
 	return x.(io.ReaderAt)
 }
 
+type GijitShadow_Proxy_ReaderAt struct {
+	Method_ReadAt func(a0 []byte, a1 int64) (int, error)
+}
+
+func (p *GijitShadow_Proxy_ReaderAt) ReadAt(a0 []byte, a1 int64) (int, error) {
+	return p.Method_ReadAt(a0, a1)
+}
+
 
 func GijitShadow_InterfaceConvertTo2_ReaderFrom(x interface{}) (y io.ReaderFrom, b bool) {
 	y, b = x.(io.ReaderFrom)
@@ -199,6 +348,14 @@ func GijitShadow_InterfaceConvertTo1_ReaderFrom(x interface{}) io.ReaderFrom {
 	return x.(io.ReaderFrom)
 }
 
+type GijitShadow_Proxy_ReaderFrom struct {
+	Method_ReadFrom func(a0 io.Reader) (int64, error)
+}
+
+func (p *GijitShadow_Proxy_ReaderFrom) ReadFrom(a0 io.Reader) (int64, error) {
+	return p.Method_ReadFrom(a0)
+}
+
 
 func GijitShadow_InterfaceConvertTo2_RuneReader(x interface{}) (y io.RuneReader, b bool) {
 	y, b = x.(io.RuneReader)
@@ -209,6 +366,14 @@ func GijitShadow_InterfaceConvertTo1_RuneReader(x interface{}) io.RuneReader {
 	return x.(io.RuneReader)
 }
 
+type GijitShadow_Proxy_RuneReader struct {
+	Method_ReadRune func() (rune, int, error)
+}
+
+func (p *GijitShadow_Proxy_RuneReader) ReadRune() (rune, int, error) {
+	return p.Method_ReadRune()
+}
+
 
 func GijitShadow_InterfaceConvertTo2_RuneScanner(x interface{}) (y io.RuneScanner, b bool) {
 	y, b = x.(io.RuneScanner)
@@ -219,6 +384,19 @@ func GijitShadow_InterfaceConvertTo1_RuneScanner(x interface{}) io.RuneScanner {
 	return x.(io.RuneScanner)
 }
 
+type GijitShadow_Proxy_RuneScanner struct {
+	Method_ReadRune func() (rune, int, error)
+	Method_UnreadRune func() error
+}
+
+func (p *GijitShadow_Proxy_RuneScanner) ReadRune() (rune, int, error) {
+	return p.Method_ReadRune()
+}
+
+func (p *GijitShadow_Proxy_RuneScanner) UnreadRune() error {
+	return p.Method_UnreadRune()
+}
+
 
 func GijitShadow_NewStruct_SectionReader(src *io.SectionReader) *io.SectionReader {
     if src == nil {
@@ -238,6 +416,14 @@ func GijitShadow_InterfaceConvertTo1_Seeker(x interface{}) io.Seeker {
 	return x.(io.Seeker)
 }
 
+type GijitShadow_Proxy_Seeker struct {
+	Method_Seek func(a0 int64, a1 int) (int64, error)
+}
+
+func (p *GijitShadow_Proxy_Seeker) Seek(a0 int64, a1 int) (int64, error) {
+	return p.Method_Seek(a0, a1)
+}
+
 
 func GijitShadow_InterfaceConvertTo2_WriteCloser(x interface{}) (y io.WriteCloser, b bool) {
 	y, b = x.(io.WriteCloser)
@@ -248,6 +434,19 @@ func GijitShadow_InterfaceConvertTo1_WriteCloser(x interface{}) io.WriteCloser {
 	return x.(io.WriteCloser)
 }
 
+type GijitShadow_Proxy_WriteCloser struct {
+	Method_Close func() error
+	Method_Write func(a0 []byte) (int, error)
+}
+
+func (p *GijitShadow_Proxy_WriteCloser) Close() error {
+	return p.Method_Close()
+}
+
+func (p *GijitShadow_Proxy_WriteCloser) Write(a0 []byte) (int, error) {
+	return p.Method_Write(a0)
+}
+
 
 func GijitShadow_InterfaceConvertTo2_WriteSeeker(x interface{}) (y io.WriteSeeker, b bool) {
 	y, b = x.(io.WriteSeeker)
@@ -258,6 +457,19 @@ func GijitShadow_InterfaceConvertTo1_WriteSeeker(x interface{}) io.WriteSeeker {
 	return x.(io.WriteSeeker)
 }
 
+type GijitShadow_Proxy_WriteSeeker struct {
+	Method_Seek func(a0 int64, a1 int) (int64, error)
+	Method_Write func(a0 []byte) (int, error)
+}
+
+func (p *GijitShadow_Proxy_WriteSeeker) Seek(a0 int64, a1 int) (int64, error) {
+	return p.Method_Seek(a0, a1)
+}
+
+func (p *GijitShadow_Proxy_WriteSeeker) Write(a0 []byte) (int, error) {
+	return p.Method_Write(a0)
+}
+
 
 func GijitShadow_InterfaceConvertTo2_Writer(x interface{}) (y io.Writer, b bool) {
 	y, b = x.(io.Writer)
@@ -268,6 +480,14 @@ func GijitShadow_InterfaceConvertTo1_Writer(x interface{}) io.Writer {
 	return x.(io.Writer)
 }
 
+type GijitShadow_Proxy_Writer struct {
+	Method_Write func(a0 []byte) (int, error)
+}
+
+func (p *GijitShadow_Proxy_Writer) Write(a0 []byte) (int, error) {
+	return p.Method_Write(a0)
+}
+
 
 func GijitShadow_InterfaceConvertTo2_WriterAt(x interface{}) (y io.WriterAt, b bool) {
 	y, b = x.(io.WriterAt)
@@ -278,6 +498,14 @@ func GijitShadow_InterfaceConvertTo1_WriterAt(x interface{}) io.WriterAt {
 	return x.(io.WriterAt)
 }
 
+type GijitShadow_Proxy_WriterAt struct {
+	Method_WriteAt func(a0 []byte, a1 int64) (int, error)
+}
+
+func (p *GijitShadow_Proxy_WriterAt) WriteAt(a0 []byte, a1 int64) (int, error) {
+	return p.Method_WriteAt(a0, a1)
+}
+
 
 func GijitShadow_InterfaceConvertTo2_WriterTo(x interface{}) (y io.WriterTo, b bool) {
 	y, b = x.(io.WriterTo)
@@ -288,6 +516,14 @@ func GijitShadow_InterfaceConvertTo1_WriterTo(x interface{}) io.WriterTo {
 	return x.(io.WriterTo)
 }
 
+type GijitShadow_Proxy_WriterTo struct {
+	Method_WriteTo func(a0 io.Writer) (int64, error)
+}
+
+func (p *GijitShadow_Proxy_WriterTo) WriteTo(a0 io.Writer) (int64, error) {
+	return p.Method_WriteTo(a0)
+}
+
 
 
  func InitLua() string {
@@ -4,6 +4,7 @@ import "math/rand"
 
 var Pkg = make(map[string]interface{})
 var Ctor = make(map[string]interface{})
+var Proxy = make(map[string]interface{})
 
 func init() {
     Pkg["ExpFloat64"] = rand.ExpFloat64
@@ -25,7 +26,9 @@ func init() {
     Pkg["Seed"] = rand.Seed
     Pkg["Shuffle"] = rand.Shuffle
     Pkg["Source"] = GijitShadow_InterfaceConvertTo2_Source
+    Proxy["Source"] = (*GijitShadow_Proxy_Source)(nil)
     Pkg["Source64"] = GijitShadow_InterfaceConvertTo2_Source64
+    Proxy["Source64"] = (*GijitShadow_Proxy_Source64)(nil)
     Pkg["Uint32"] = rand.Uint32
     Pkg["Uint64"] = rand.Uint64
     Ctor["Zipf"] = GijitShadow_NewStruct_Zipf
@@ -49,6 +52,19 @@ func GijitShadow_InterfaceConvertTo1_Source(x interface{}) rand.Source {
 	return x.(rand.Source)
 }
 
+type GijitShadow_Proxy_Source struct {
+	Method_Int63 func() int64
+	Method_Seed func(a0 int64) 
+}
+
+func (p *GijitShadow_Proxy_Source) Int63() int64 {
+	return p.Method_Int63()
+}
+
+func (p *GijitShadow_Proxy_Source) Seed(a0 int64)  {
+	p.Method_Seed(a0)
+}
+
 
 func GijitShadow_InterfaceConvertTo2_Source64(x interface{}) (y rand.Source64, b bool) {
 	y, b = x.(rand.Source64)
@@ -59,6 +75,24 @@ func GijitShadow_InterfaceConvertTo1_Source64(x interface{}) rand.Source64 {
 	return x.(rand.Source64)
 }
 
+type GijitShadow_Proxy_Source64 struct {
+	Method_Int63 func() int64
+	Method_Seed func(a0 int64) 
+	Method_Uint64 func() uint64
+}
+
+func (p *GijitShadow_Proxy_Source64) Int63() int64 {
+	return p.Method_Int63()
+}
+
+func (p *GijitShadow_Proxy_Source64) Seed(a0 int64)  {
+	p.Method_Seed(a0)
+}
+
+func (p *GijitShadow_Proxy_Source64) Uint64() uint64 {
+	return p.Method_Uint64()
+}
+
 
 func GijitShadow_NewStruct_Zipf(src *rand.Zipf) *rand.Zipf {
     if src == nil {
@@ -1,9 +1,13 @@
 package shadow_os
 
-import "os"
+import (
+	"os"
+	"time"
+)
 
 var Pkg = make(map[string]interface{})
 var Ctor = make(map[string]interface{})
+var Proxy = make(map[string]interface{})
 
 func init() {
     Pkg["Args"] = os.Args
@@ -27,6 +31,7 @@ func init() {
     Pkg["ExpandEnv"] = os.ExpandEnv
     Ctor["File"] = GijitShadow_NewStruct_File
     Pkg["FileInfo"] = GijitShadow_InterfaceConvertTo2_FileInfo
+    Proxy["FileInfo"] = (*GijitShadow_Proxy_FileInfo)(nil)
     Pkg["FindProcess"] = os.FindProcess
     Pkg["Getegid"] = os.Getegid
     Pkg["Getenv"] = os.Getenv
@@ -82,6 +87,7 @@ func init() {
     Pkg["SameFile"] = os.SameFile
     Pkg["Setenv"] = os.Setenv
     Pkg["Signal"] = GijitShadow_InterfaceConvertTo2_Signal
+    Proxy["Signal"] = (*GijitShadow_Proxy_Signal)(nil)
     Pkg["StartProcess"] = os.StartProcess
     Pkg["Stat"] = os.Stat
     Pkg["Stderr"] = os.Stderr
@@ -112,6 +118,39 @@ func GijitShadow_InterfaceConvertTo1_FileInfo(x interface{}) os.FileInfo {
 	return x.(os.FileInfo)
 }
 
+type GijitShadow_Proxy_FileInfo struct {
+	Method_IsDir func() bool
+	Method_ModTime func() time.Time
+	Method_Mode func() os.FileMode
+	Method_Name func() string
+	Method_Size func() int64
+	Method_Sys func() interface{}
+}
+
+func (p *GijitShadow_Proxy_FileInfo) IsDir() bool {
+	return p.Method_IsDir()
+}
+
+func (p *GijitShadow_Proxy_FileInfo) ModTime() time.Time {
+	return p.Method_ModTime()
+}
+
+func (p *GijitShadow_Proxy_FileInfo) Mode() os.FileMode {
+	return p.Method_Mode()
+}
+
+func (p *GijitShadow_Proxy_FileInfo) Name() string {
+	return p.Method_Name()
+}
+
+func (p *GijitShadow_Proxy_FileInfo) Size() int64 {
+	return p.Method_Size()
+}
+
+func (p *GijitShadow_Proxy_FileInfo) Sys() interface{} {
+	return p.Method_Sys()
+}
+
 
 func GijitShadow_NewStruct_LinkError(src *os.LinkError) *os.LinkError {
     if src == nil {
@@ -167,6 +206,19 @@ func GijitShadow_InterfaceConvertTo1_Signal(x interface{}) os.Signal {
 	return x.(os.Signal)
 }
 
+type GijitShadow_Proxy_Signal struct {
+	Method_Signal func() 
+	Method_String func() string
+}
+
+func (p *GijitShadow_Proxy_Signal) Signal()  {
+	p.Method_Signal()
+}
+
+func (p *GijitShadow_Proxy_Signal) String() string {
+	return p.Method_String()
+}
+
 
 func GijitShadow_NewStruct_SyscallError(src *os.SyscallError) *os.SyscallError {
     if src == nil {
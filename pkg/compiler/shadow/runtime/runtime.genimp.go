@@ -4,6 +4,7 @@ import "runtime"
 
 var Pkg = make(map[string]interface{})
 var Ctor = make(map[string]interface{})
+var Proxy = make(map[string]interface{})
 
 func init() {
     Pkg["BlockProfile"] = runtime.BlockProfile
@@ -15,6 +16,7 @@ func init() {
     Pkg["CallersFrames"] = runtime.CallersFrames
     Pkg["Compiler"] = runtime.Compiler
     Pkg["Error"] = GijitShadow_InterfaceConvertTo2_Error
+    Proxy["Error"] = (*GijitShadow_Proxy_Error)(nil)
     Ctor["Frame"] = GijitShadow_NewStruct_Frame
     Ctor["Frames"] = GijitShadow_NewStruct_Frames
     Ctor["Func"] = GijitShadow_NewStruct_Func
@@ -72,6 +74,19 @@ func GijitShadow_InterfaceConvertTo1_Error(x interface{}) runtime.Error {
 	return x.(runtime.Error)
 }
 
+type GijitShadow_Proxy_Error struct {
+	Method_Error func() string
+	Method_RuntimeError func() 
+}
+
+func (p *GijitShadow_Proxy_Error) Error() string {
+	return p.Method_Error()
+}
+
+func (p *GijitShadow_Proxy_Error) RuntimeError()  {
+	p.Method_RuntimeError()
+}
+
 
 func GijitShadow_NewStruct_Frame(src *runtime.Frame) *runtime.Frame {
     if src == nil {
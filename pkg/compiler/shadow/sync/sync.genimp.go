@@ -4,10 +4,12 @@ import "sync"
 
 var Pkg = make(map[string]interface{})
 var Ctor = make(map[string]interface{})
+var Proxy = make(map[string]interface{})
 
 func init() {
     Ctor["Cond"] = GijitShadow_NewStruct_Cond
     Pkg["Locker"] = GijitShadow_InterfaceConvertTo2_Locker
+    Proxy["Locker"] = (*GijitShadow_Proxy_Locker)(nil)
     Ctor["Map"] = GijitShadow_NewStruct_Map
     Ctor["Mutex"] = GijitShadow_NewStruct_Mutex
     Pkg["NewCond"] = sync.NewCond
@@ -35,6 +37,19 @@ func GijitShadow_InterfaceConvertTo1_Locker(x interface{}) sync.Locker {
 	return x.(sync.Locker)
 }
 
+type GijitShadow_Proxy_Locker struct {
+	Method_Lock func() 
+	Method_Unlock func() 
+}
+
+func (p *GijitShadow_Proxy_Locker) Lock()  {
+	p.Method_Lock()
+}
+
+func (p *GijitShadow_Proxy_Locker) Unlock()  {
+	p.Method_Unlock()
+}
+
 
 func GijitShadow_NewStruct_Map(src *sync.Map) *sync.Map {
     if src == nil {
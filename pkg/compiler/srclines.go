@@ -0,0 +1,101 @@
+package compiler
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/gijit/gi/pkg/token"
+)
+
+// maxSrcChunks bounds how many translations
+// srcLineMap remembers; the oldest are forgotten
+// first.
+const maxSrcChunks = 1000
+
+// srcLines maps the lines of one translation back
+// to the lines of the Go it came from, for the
+// goroutine dump in prelude/chan.lua.
+type srcLines struct {
+	label string
+
+	// goLine[i] is the Go line that Lua line i+1
+	// started, or 0 if none did.
+	goLine []int
+}
+
+// srcLineMap holds the srcLines of recent
+// translations, keyed by the Lua text itself: that
+// is what debug.getinfo reports as the source of a
+// chunk run by __eval.
+type srcLineMap struct {
+	mut    sync.Mutex
+	chunks map[string]*srcLines
+	order  []string
+	count  int
+}
+
+func newSrcLineMap() *srcLineMap {
+	return &srcLineMap{
+		chunks: make(map[string]*srcLines),
+	}
+}
+
+// recorder returns a srcLines to fill in, as a
+// SourceMapFilter's MappingCallback, while the
+// translation is written.
+func (m *srcLineMap) recorder() (*srcLines, func(generatedLine, generatedColumn int, originalPos token.Position)) {
+	m.mut.Lock()
+	m.count++
+	sl := &srcLines{label: fmt.Sprintf("input %d", m.count)}
+	m.mut.Unlock()
+
+	return sl, func(generatedLine, generatedColumn int, originalPos token.Position) {
+		if originalPos.Line <= 0 {
+			return
+		}
+		for len(sl.goLine) < generatedLine {
+			sl.goLine = append(sl.goLine, 0)
+		}
+		if sl.goLine[generatedLine-1] == 0 {
+			sl.goLine[generatedLine-1] = originalPos.Line
+		}
+	}
+}
+
+func (m *srcLineMap) add(lua string, sl *srcLines) {
+	if len(sl.goLine) == 0 {
+		return
+	}
+	m.mut.Lock()
+	defer m.mut.Unlock()
+	if _, already := m.chunks[lua]; !already {
+		m.order = append(m.order, lua)
+	}
+	m.chunks[lua] = sl
+	if len(m.order) > maxSrcChunks {
+		delete(m.chunks, m.order[0])
+		m.order = m.order[1:]
+	}
+}
+
+// goLine gives the Go position of line in the
+// translation lua, falling back to the nearest
+// mapped line above it. label is "" if lua is not
+// a translation we know.
+func (m *srcLineMap) goLine(lua string, line int) (label string, goLine int) {
+	m.mut.Lock()
+	sl, ok := m.chunks[lua]
+	m.mut.Unlock()
+	if !ok {
+		return "", 0
+	}
+	if line > len(sl.goLine) {
+		line = len(sl.goLine)
+	}
+	for i := line - 1; i >= 0; i-- {
+		if sl.goLine[i] > 0 {
+			return sl.label, sl.goLine[i]
+		}
+	}
+	return sl.label, 0
+}
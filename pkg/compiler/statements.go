@@ -24,7 +24,11 @@ func (c *funcContext) translateStmtList(stmts []ast.Stmt) {
 func (c *funcContext) translateStmt(stmt ast.Stmt, label *types.Label) {
 	pp("translateStmt top stmt='%#v'", stmt)
 
-	c.SetPos(stmt.Pos())
+	// statements made up during translation have no
+	// position; they keep that of the one they stand for.
+	if stmt.Pos().IsValid() {
+		c.SetPos(stmt.Pos())
+	}
 
 	stmt = filter.IncDecStmt(stmt, c.p.Info.Info)
 	stmt = filter.Assign(stmt, c.p.Info.Info, c.p.Info.Pkg)
@@ -578,7 +582,7 @@ __defer_func(%s)
 				channels = append(channels, "{}")
 				hasDefault = true
 			case *ast.ExprStmt:
-				channels = append(channels, c.formatExpr("{%e}", astutil.RemoveParens(comm.X).(*ast.UnaryExpr).X).String())
+				channels = append(channels, c.formatExpr("{c=%e, op=__task.RECV}", astutil.RemoveParens(comm.X).(*ast.UnaryExpr).X).String())
 			case *ast.AssignStmt:
 				// receive
 				channels = append(channels, c.formatExpr("{c=%e, op=__task.RECV}", astutil.RemoveParens(comm.Rhs[0]).(*ast.UnaryExpr).X).String())
@@ -597,7 +601,8 @@ __defer_func(%s)
 			if assign, ok := clause.Comm.(*ast.AssignStmt); ok {
 				switch rhsType := c.p.TypeOf(assign.Rhs[0]).(type) {
 				case *types.Tuple:
-					bodyPrefix = []ast.Stmt{&ast.AssignStmt{Lhs: assign.Lhs, Rhs: []ast.Expr{c.newIdent(selectionVar+"[2]", rhsType)}, Tok: assign.Tok}}
+					// v, ok := <-c: both, not the pair.
+					bodyPrefix = []ast.Stmt{&ast.AssignStmt{Lhs: assign.Lhs, Rhs: []ast.Expr{c.newIdent("unpack("+selectionVar+"[2])", rhsType)}, Tok: assign.Tok}}
 				default:
 					bodyPrefix = []ast.Stmt{&ast.AssignStmt{Lhs: assign.Lhs, Rhs: []ast.Expr{c.newIdent(selectionVar+"[2][1]", rhsType)}, Tok: assign.Tok}}
 				}
@@ -1051,7 +1056,7 @@ func (c *funcContext) translateAssign(lhs, rhs ast.Expr, define bool) string {
 			// binary shadow struct values need special handling.
 			tn := c.typeName(lhsType, nil)
 			shortPkg, typ := extractBasePackageName(tn)
-			isShad, shortType := isShadowStruct(tn)
+			isShad, shortType := c.p.isShadowStruct(tn)
 			pp("here, writing __copy for type = '%s'; shortPkg=%v, typ=%v, isShad=%v, shortType=%v", tn, shortPkg, typ, isShad, shortType)
 			if isShad {
 				// special handling for shadow binary struct value
@@ -0,0 +1,164 @@
+package compiler
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+type guardedCount struct {
+	Mu sync.Mutex
+	N  int
+}
+
+func Test1622SyncParksOnlyTheWaitingGoroutine(t *testing.T) {
+
+	cv.Convey("sync's Mutex, RWMutex, WaitGroup, Once and Cond, and sync/atomic, wait on the scheduler rather than the thread", t, func() {
+		in, err := NewInterpreter(nil)
+		panicOn(err)
+		defer in.Close()
+		ctx := context.Background()
+
+		// the types are named through the members
+		// that mention them.
+		panicOn(in.RegisterPackage("sync", map[string]interface{}{
+			"NewCond":      sync.NewCond,
+			"MutexPtr":     (*sync.Mutex)(nil),
+			"RWMutexPtr":   (*sync.RWMutex)(nil),
+			"WaitGroupPtr": (*sync.WaitGroup)(nil),
+			"OncePtr":      (*sync.Once)(nil),
+		}))
+		panicOn(in.RegisterPackage("sync/atomic", map[string]interface{}{
+			"AddInt32":            atomic.AddInt32,
+			"AddInt64":            atomic.AddInt64,
+			"LoadInt64":           atomic.LoadInt64,
+			"CompareAndSwapInt32": atomic.CompareAndSwapInt32,
+			"ValuePtr":            (*atomic.Value)(nil),
+		}))
+		g := &guardedCount{}
+		panicOn(in.Register("g", g))
+		_, err = in.Eval(ctx, `import "sync"`)
+		panicOn(err)
+		_, err = in.Eval(ctx, `import "sync/atomic"`)
+		panicOn(err)
+
+		get := func(expr string) interface{} {
+			res, err := in.Eval(ctx, expr)
+			panicOn(err)
+			return res[0]
+		}
+
+		// the fan-out: each worker holds the lock across a
+		// send, so a contended Lock has to park.
+		_, err = in.Eval(ctx, `
+var wg sync.WaitGroup
+var mu sync.Mutex
+var hits int64
+inside, most, sum := 0, 0, 0
+ch := make(chan int)
+go func() {
+	for v := range ch {
+		sum += v
+	}
+}()
+for i := 1; i <= 10; i++ {
+	wg.Add(1)
+	go func(i int) {
+		defer wg.Done()
+		mu.Lock()
+		inside++
+		if inside > most {
+			most = inside
+		}
+		ch <- i
+		inside--
+		mu.Unlock()
+		atomic.AddInt64(&hits, 1)
+	}(i)
+}
+wg.Wait()
+close(ch)`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(get(`sum`), cv.ShouldEqual, 55)
+		cv.So(get(`most`), cv.ShouldEqual, 1)
+		cv.So(get(`atomic.LoadInt64(&hits)`), cv.ShouldEqual, 10)
+
+		// Once runs f once; Cond wakes its waiter; a
+		// writer waits out the readers.
+		_, err = in.Eval(ctx, `
+var once sync.Once
+calls := 0
+for i := 0; i < 3; i++ {
+	once.Do(func() { calls++ })
+}
+
+c := sync.NewCond(&mu)
+ready := false
+go func() {
+	mu.Lock()
+	ready = true
+	c.Broadcast()
+	mu.Unlock()
+}()
+mu.Lock()
+for !ready {
+	c.Wait()
+}
+mu.Unlock()
+
+type table struct {
+	sync.RWMutex
+	log string
+}
+tb := &table{}
+tb.RLock()
+done := make(chan bool)
+go func() {
+	tb.Lock()
+	tb.log += "w"
+	tb.Unlock()
+	done <- true
+}()
+go func() {
+	tb.log += "r"
+	tb.RUnlock()
+}()
+<-done`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(get(`calls`), cv.ShouldEqual, 1)
+		cv.So(get(`ready`), cv.ShouldEqual, true)
+		cv.So(get(`tb.log`), cv.ShouldEqual, "rw")
+
+		// atomic works on interpreted pointers, at the
+		// width of the type.
+		_, err = in.Eval(ctx, `
+var n32 int32 = 2147483647
+atomic.AddInt32(&n32, 1)
+swapped := atomic.CompareAndSwapInt32(&n32, -2147483648, 5)
+var v atomic.Value
+v.Store("x")`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(get(`n32`), cv.ShouldEqual, 5)
+		cv.So(get(`swapped`), cv.ShouldEqual, true)
+		cv.So(get(`v.Load()`), cv.ShouldEqual, "x")
+
+		// misuse panics as in Go, and a wait nothing can
+		// end is a deadlock that says what it waits on.
+		_, err = in.Eval(ctx, `var w2 sync.WaitGroup; w2.Done()`)
+		cv.So(err.Error(), cv.ShouldContainSubstring, "sync: negative WaitGroup counter")
+		_, err = in.Eval(ctx, `var w3 sync.WaitGroup; w3.Add(1); w3.Wait()`)
+		cv.So(err.Error(), cv.ShouldContainSubstring, "goroutine 1 [sync.WaitGroup.Wait]:")
+
+		// a Mutex in a native struct is the native one.
+		_, err = in.Eval(ctx, `g.Mu.Lock(); g.N++`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(g.Mu.TryLock(), cv.ShouldBeFalse)
+		_, err = in.Eval(ctx, `g.Mu.Unlock()`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(g.N, cv.ShouldEqual, 1)
+		cv.So(g.Mu.TryLock(), cv.ShouldBeTrue)
+	})
+}
@@ -0,0 +1,149 @@
+package compiler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test1619TimersParkOnlyTheirGoroutine(t *testing.T) {
+
+	cv.Convey("time.Sleep, time.After, timers, tickers and context deadlines are fired by the scheduler, so a select can time out", t, func() {
+		in, err := NewInterpreter(nil)
+		panicOn(err)
+		defer in.Close()
+		ctx := context.Background()
+
+		panicOn(in.RegisterPackage("time", map[string]interface{}{
+			"Sleep":     time.Sleep,
+			"After":     time.After,
+			"AfterFunc": time.AfterFunc,
+			"NewTimer":  time.NewTimer,
+			"NewTicker": time.NewTicker,
+			"Tick":      time.Tick,
+		}))
+		panicOn(in.RegisterPackage("context", map[string]interface{}{
+			"Background":       context.Background,
+			"WithCancel":       context.WithCancel,
+			"WithTimeout":      context.WithTimeout,
+			"Canceled":         context.Canceled,
+			"DeadlineExceeded": context.DeadlineExceeded,
+		}))
+		_, err = in.Eval(ctx, `import "time"`)
+		panicOn(err)
+		_, err = in.Eval(ctx, `import "context"`)
+		panicOn(err)
+		_, err = in.Eval(ctx, `const ms = time.Duration(1000000)`)
+		panicOn(err)
+
+		// while one goroutine sleeps, the other runs.
+		_, err = in.Eval(ctx, `
+order := ""
+done := make(chan bool)
+go func() { time.Sleep(30*ms); order += "slow"; done <- true }()
+go func() { order += "fast,"; done <- true }()
+<-done
+<-done`)
+		cv.So(err, cv.ShouldBeNil)
+		res, err := in.Eval(ctx, `order`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(res, cv.ShouldResemble, []interface{}{"fast,slow"})
+
+		// a select with nothing else ready times out...
+		_, err = in.Eval(ctx, `
+never := make(chan int)
+got := ""
+select {
+case <-never:
+	got = "never"
+case <-time.After(10*ms):
+	got = "timeout"
+}`)
+		cv.So(err, cv.ShouldBeNil)
+		res, err = in.Eval(ctx, `got`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(res, cv.ShouldResemble, []interface{}{"timeout"})
+
+		// ...unless a goroutine gets there first.
+		_, err = in.Eval(ctx, `
+soon := make(chan int)
+go func() { time.Sleep(5*ms); soon <- 7 }()
+select {
+case v := <-soon:
+	got = "soon"
+	_ = v
+case <-time.After(2000*ms):
+	got = "timeout"
+}`)
+		cv.So(err, cv.ShouldBeNil)
+		res, err = in.Eval(ctx, `got`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(res, cv.ShouldResemble, []interface{}{"soon"})
+
+		// a stopped timer never fires; AfterFunc runs f
+		// on its own goroutine.
+		_, err = in.Eval(ctx, `
+tm := time.NewTimer(5*ms)
+stopped := tm.Stop()
+fired := false
+time.AfterFunc(5*ms, func() { fired = true })
+time.Sleep(20*ms)
+got = ""
+select {
+case <-tm.C:
+	got = "fired"
+default:
+	got = "quiet"
+}`)
+		cv.So(err, cv.ShouldBeNil)
+		res, err = in.Eval(ctx, `got`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(res, cv.ShouldResemble, []interface{}{"quiet"})
+		res, err = in.Eval(ctx, `stopped`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(res, cv.ShouldResemble, []interface{}{true})
+		res, err = in.Eval(ctx, `fired`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(res, cv.ShouldResemble, []interface{}{true})
+
+		// tickers tick until stopped.
+		_, err = in.Eval(ctx, `
+tk := time.NewTicker(2*ms)
+ticks := 0
+for range tk.C {
+	ticks++
+	if ticks == 3 {
+		break
+	}
+}
+tk.Stop()`)
+		cv.So(err, cv.ShouldBeNil)
+		res, err = in.Eval(ctx, `ticks`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(res, cv.ShouldResemble, []interface{}{3})
+
+		// context deadlines and cancellation close Done.
+		_, err = in.Eval(ctx, `
+cx, cancel := context.WithTimeout(context.Background(), 10*ms)
+<-cx.Done()
+timedOut := cx.Err() == context.DeadlineExceeded
+cancel()
+cy, cancel2 := context.WithCancel(context.Background())
+cz, _ := context.WithTimeout(cy, 2000*ms)
+cancel2()
+_, ok := <-cz.Done()
+canceled := cz.Err() == context.Canceled`)
+		cv.So(err, cv.ShouldBeNil)
+		res, err = in.Eval(ctx, `timedOut`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(res, cv.ShouldResemble, []interface{}{true})
+		res, err = in.Eval(ctx, `canceled`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(res, cv.ShouldResemble, []interface{}{true})
+		res, err = in.Eval(ctx, `ok`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(res, cv.ShouldResemble, []interface{}{false})
+	})
+}
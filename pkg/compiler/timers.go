@@ -0,0 +1,51 @@
+package compiler
+
+import (
+	"fmt"
+	"time"
+)
+
+// schedOverrides are the packages whose blocking
+// members, once imported, are swapped for ones the
+// coroutine scheduler fires or wakes; see
+// prelude/timer.lua and prelude/sync.lua. The
+// sync/atomic functions are swapped too, since the
+// native ones can't take interpreted pointers; and
+// gi.Go, which is the Lua of prelude/parallel.lua.
+var schedOverrides = map[string]string{
+	"time":        "__gijit_schedTime",
+	"context":     "__gijit_schedContext",
+	"sync":        "__gijit_schedSync",
+	"sync/atomic": "__gijit_schedAtomic",
+	"gi":          "__gijit_schedGi",
+}
+
+// schedOverride is the Lua to run after importing
+// path as the global base, if any.
+func schedOverride(path, base string) []byte {
+	fn, ok := schedOverrides[path]
+	if !ok {
+		return nil
+	}
+	return []byte(fmt.Sprintf("\n%s(%q);\n", fn, base))
+}
+
+// setupTimers gives prelude/timer.lua the few pieces
+// of the host's clock it needs, to put time.Time
+// values on timer channels and to place deadlines.
+func (lvm *LuaVm) setupTimers() error {
+	tk := lvm.goro.newTicket("", false)
+	tk.regmap["__gijit_timeNow"] = time.Now
+	tk.regmap["__gijit_timeUntil"] = time.Until
+	tk.regmap["__gijit_timeAfterNow"] = func(d time.Duration) time.Time {
+		return time.Now().Add(d)
+	}
+	tk.regmap["__gijit_timeZero"] = func() time.Time {
+		return time.Time{}
+	}
+	err := tk.Do()
+	if err != nil {
+		return fmt.Errorf("could not set up timers: '%v'", err)
+	}
+	return nil
+}
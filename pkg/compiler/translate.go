@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/gijit/gi/pkg/ast"
 	"github.com/gijit/gi/pkg/gostd/build"
+	"github.com/gijit/gi/pkg/muse"
 	"github.com/gijit/gi/pkg/parser"
 	"github.com/gijit/gi/pkg/token"
 	"github.com/gijit/gi/pkg/types"
@@ -46,6 +47,31 @@ type IncrState struct {
 	Session *Session
 
 	zlisp *zygo.Zlisp
+
+	// host packages and types, from
+	// Interpreter.Register and RegisterPackage.
+	hostPkgs  map[string]*hostPackage
+	muse      *muse.Muse
+	hostTypes map[*types.Named]bool
+
+	// the Go lines behind recent translations, for
+	// the goroutine dump on deadlock.
+	srcLines *srcLineMap
+
+	// every NewDeclText so far, in order.
+	decls [][]byte
+
+	// what each func and method declared refers to,
+	// by funcKey, for vetGiGo.
+	funcRefs map[string]*funcRefs
+
+	// ansTypes are the checked types of the values of
+	// the last expression translated, if it was one.
+	ansTypes []types.Type
+
+	// args, when set, is os.Args as the interpreted
+	// program sees it; a script's own, say.
+	args []string
 }
 
 func NewIncrState(lvm *LuaVm, cfg *GIConfig) *IncrState {
@@ -59,9 +85,10 @@ func NewIncrState(lvm *LuaVm, cfg *GIConfig) *IncrState {
 		lvm.cfg = cfg
 	}
 	ic := &IncrState{
-		goro:   lvm.goro,
-		pkgMap: make(map[string]*IncrPkg),
-		cfg:    cfg,
+		goro:     lvm.goro,
+		pkgMap:   make(map[string]*IncrPkg),
+		cfg:      cfg,
+		srcLines: newSrcLineMap(),
 	}
 	ic.Session = NewSession(&Options{}, ic)
 
@@ -74,6 +101,9 @@ func NewIncrState(lvm *LuaVm, cfg *GIConfig) *IncrState {
 	importContext := &ImportContext{
 		Packages: make(map[string]*types.Package),
 		Import:   ic.CompileTimeGiImportFunc,
+
+		BinaryPackages: make(map[string]bool),
+		Sandbox:        cfg.Sandbox,
 	}
 
 	key := "main"
@@ -159,6 +189,10 @@ func (tr *IncrState) TrWithPrepend(src []byte, prependOk bool) (by []byte, err e
 		r := recover()
 		if r != nil {
 			pp("Tr sees panic of: '%v'", r)
+			if e, ok := r.(*giGoError); ok {
+				err = e
+				return
+			}
 			err = fmt.Errorf("%v", r)
 		}
 	}()
@@ -227,8 +261,13 @@ func (tr *IncrState) TrWithPrepend(src []byte, prependOk bool) (by []byte, err e
 		return nil, fmt.Errorf(msg)
 	}
 	depth := 0
+	tr.ansTypes = nil
 	tr.CurPkg.Arch, err = IncrementallyCompile(tr.CurPkg.Arch, tr.CurPkg.pack.ImportPath, files, tr.CurPkg.fileSet, tr.CurPkg.importContext, tr.minify, depth)
 	panicOn(err)
+	tr.vetGiGo(file)
+	if didPrepend {
+		tr.ansTypes = ansTypes(file, tr.CurPkg.Arch.TypesInfo)
+	}
 	//pp("archive = '%#v'", tr.CurPkg.Arch)
 	//pp("len(tr.CurPkg.Arch.Declarations)= '%v'", len(tr.CurPkg.Arch.Declarations))
 	//pp("len(tr.CurPkg.Arch.NewCode)= '%v'", len(tr.CurPkg.Arch.NewCodeText))
@@ -236,11 +275,25 @@ func (tr *IncrState) TrWithPrepend(src []byte, prependOk bool) (by []byte, err e
 	pp("got past config.Check")
 
 	var res bytes.Buffer
+	lines, callback := tr.srcLines.recorder()
+	w := &SourceMapFilter{
+		Writer:          &res,
+		MappingCallback: callback,
+		fileSet:         tr.CurPkg.fileSet,
+	}
 	for i, d := range tr.CurPkg.Arch.NewCodeText {
 		pp("writing tr.CurPkg.Arch.NewCode[i=%v].Code = '%v'", i, string(tr.CurPkg.Arch.NewCodeText[i]))
-		res.Write(d)
+		w.Write(d)
 	}
 	tr.CurPkg.Arch.NewCodeText = nil
+	for _, d := range tr.CurPkg.Arch.NewDeclText {
+		// without the source map's positions.
+		var decl bytes.Buffer
+		(&SourceMapFilter{Writer: &decl}).Write(d)
+		tr.decls = append(tr.decls, decl.Bytes())
+	}
+	tr.CurPkg.Arch.NewDeclText = nil
+	tr.srcLines.add(res.String(), lines)
 
 	return res.Bytes(), nil
 }
@@ -271,6 +324,35 @@ func (tr *IncrState) prependAns(src []byte) (ret []byte, didPrepend bool) {
 	return src, false
 }
 
+// ansTypes finds, in file, the values that prependAns
+// gathered into __gijit_ans, and returns their types.
+func ansTypes(file *ast.File, info *types.Info) (tys []types.Type) {
+	ast.Inspect(file, func(n ast.Node) bool {
+		as, ok := n.(*ast.AssignStmt)
+		if !ok || len(as.Lhs) != 1 || len(as.Rhs) != 1 {
+			return tys == nil
+		}
+		id, ok := as.Lhs[0].(*ast.Ident)
+		lit, isLit := as.Rhs[0].(*ast.CompositeLit)
+		if !ok || id.Name != "__gijit_ans" || !isLit {
+			return true
+		}
+		tys = []types.Type{}
+		for _, elt := range lit.Elts {
+			t := info.TypeOf(elt)
+			if tup, ok := t.(*types.Tuple); ok {
+				for i := 0; i < tup.Len(); i++ {
+					tys = append(tys, tup.At(i).Type())
+				}
+				continue
+			}
+			tys = append(tys, t)
+		}
+		return false
+	})
+	return
+}
+
 // full package
 
 // FullPackage: translate a full package from go to Lua.
@@ -50,8 +50,6 @@ func (c *funcContext) SetPos(pos token.Pos) {
 }
 
 func (c *funcContext) writePos() {
-	// jea debug: turn off writePos() for now
-	return
 	if c.posAvailable {
 		c.posAvailable = false
 		c.Write([]byte{'\b'})
@@ -248,7 +246,7 @@ func (c *funcContext) zeroValue(ty types.Type) ast.Expr {
 	case *types.Struct:
 		// shadow (binary Go) structs just need to be
 		// instantiated--we skip the Lua type system.
-		isShadow, shortTyp := isShadowStruct(ty.String())
+		isShadow, shortTyp := c.p.isShadowStruct(ty.String())
 		if isShadow {
 			//vv("found shadow '%s'; create call to type ctor, e.g. a __type__.time.Time() call.", shortTyp)
 			return &ast.CallExpr{
@@ -554,7 +552,7 @@ func (c *funcContext) typeNameWithAnonInfo(
 
 	pkgName := c.getPkgName()
 	var shortTyp string
-	isShadow, shortTyp = isShadowStruct(ty.String())
+	isShadow, shortTyp = c.p.isShadowStruct(ty.String())
 	_ = shortTyp
 	//vv("ty.String() = '%s' -> %v isShadow: shortTyp='%s'", ty.String(), isShadow, shortTyp)
 
@@ -1125,9 +1123,13 @@ func extractBasePackageName(pkg string) (shortPkg, typ string) {
 	return
 }
 
-func isShadowStruct(pkgName string) (is bool, typeName string) {
+func (p *pkgContext) isShadowStruct(pkgName string) (is bool, typeName string) {
 	base, typ := extractBasePackageName(pkgName)
-	is = strings.Contains(pkgName, "/pkg/compiler/shadow/") || binaryPackage[base]
+	is = strings.Contains(pkgName, "/pkg/compiler/shadow/")
+	if dot := strings.LastIndex(pkgName, "."); !is && dot > 0 {
+		// by the whole path, so internal/sync is not sync.
+		is = p.binaryPackage[pkgName[:dot]]
+	}
 	typeName = base + "." + typ
 	return
 }
@@ -243,15 +243,11 @@ func (r *reader) filterDecl(decl ast.Decl) bool {
 func (r *reader) fileExports(src *ast.File) {
 	j := 0
 	for _, d := range src.Nodes {
-		switch de := d.(type) {
-		case ast.Decl:
-			if !r.filterDecl(de) {
-				continue
-			}
-		default:
-			src.Nodes[j] = de
-			j++
+		if de, ok := d.(ast.Decl); ok && !r.filterDecl(de) {
+			continue
 		}
+		src.Nodes[j] = d
+		j++
 	}
 	src.Nodes = src.Nodes[0:j]
 }
@@ -0,0 +1,74 @@
+// Package jupyter implements the kernel side of the
+// Jupyter messaging protocol (version 5.3), so that
+// `gi -kernel <connection-file>` can sit behind a
+// notebook front end.
+//
+// The wire transport is pluggable: see the Transport
+// interface. NewZmtpTransport speaks ZMTP 3.0 over tcp
+// to real Jupyter clients; NewLoopbackTransport
+// connects a stand-in client in the same process,
+// which is what the tests use.
+package jupyter
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+)
+
+// ConnectionInfo is the content of the connection file
+// that the Jupyter front end writes and then passes to
+// the kernel on its command line.
+type ConnectionInfo struct {
+	Transport       string `json:"transport"`
+	IP              string `json:"ip"`
+	ShellPort       int    `json:"shell_port"`
+	IOPubPort       int    `json:"iopub_port"`
+	StdinPort       int    `json:"stdin_port"`
+	ControlPort     int    `json:"control_port"`
+	HBPort          int    `json:"hb_port"`
+	Key             string `json:"key"`
+	SignatureScheme string `json:"signature_scheme"`
+	KernelName      string `json:"kernel_name"`
+}
+
+// LoadConnectionFile reads and validates a Jupyter connection file.
+func LoadConnectionFile(path string) (*ConnectionInfo, error) {
+	by, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	info := &ConnectionInfo{}
+	err = json.Unmarshal(by, info)
+	if err != nil {
+		return nil, fmt.Errorf("bad connection file '%s': %v", path, err)
+	}
+	if info.Transport == "" {
+		info.Transport = "tcp"
+	}
+	if info.Transport != "tcp" {
+		return nil, fmt.Errorf("connection file '%s': unsupported transport '%s', only tcp is available", path, info.Transport)
+	}
+	if info.SignatureScheme == "" {
+		info.SignatureScheme = "hmac-sha256"
+	}
+	return info, nil
+}
+
+// addr returns the host:port to listen on for one channel.
+func (c *ConnectionInfo) addr(ch Channel) string {
+	var port int
+	switch ch {
+	case ShellChannel:
+		port = c.ShellPort
+	case ControlChannel:
+		port = c.ControlPort
+	case StdinChannel:
+		port = c.StdinPort
+	case IOPubChannel:
+		port = c.IOPubPort
+	case HeartbeatChannel:
+		port = c.HBPort
+	}
+	return fmt.Sprintf("%s:%d", c.IP, port)
+}
@@ -0,0 +1,420 @@
+package jupyter
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Handler is the language side of a kernel. The Kernel
+// calls its methods one at a time, never concurrently.
+type Handler interface {
+	// Info describes the implementation and language
+	// for kernel_info_reply.
+	Info() KernelInfo
+
+	// Execute runs code. Anything written to stdout and
+	// stderr during the call is streamed to the front end
+	// as it arrives. A non-empty result is published as
+	// the cell's execute_result.
+	Execute(code string, stdout, stderr io.Writer) (result string, err error)
+
+	// Complete offers completions for the code at byte
+	// offset cursor. The replaced text is code[start:end].
+	Complete(code string, cursor int) (matches []string, start, end int)
+
+	// Inspect describes the object at byte offset cursor.
+	Inspect(code string, cursor, detailLevel int) (text string, found bool)
+
+	// IsComplete reports "complete", "incomplete",
+	// "invalid" or "unknown", and for incomplete code
+	// the indent to suggest for the next line.
+	IsComplete(code string) (status, indent string)
+}
+
+// KernelInfo feeds kernel_info_reply.
+type KernelInfo struct {
+	Implementation        string       `json:"implementation"`
+	ImplementationVersion string       `json:"implementation_version"`
+	Banner                string       `json:"banner"`
+	LanguageInfo          LanguageInfo `json:"language_info"`
+}
+
+type LanguageInfo struct {
+	Name              string `json:"name"`
+	Version           string `json:"version"`
+	Mimetype          string `json:"mimetype"`
+	FileExtension     string `json:"file_extension"`
+	PygmentsLexer     string `json:"pygments_lexer,omitempty"`
+	CodemirrorMode    string `json:"codemirror_mode,omitempty"`
+	NbconvertExporter string `json:"nbconvert_exporter,omitempty"`
+}
+
+// Kernel serves the Jupyter messaging protocol over a
+// Transport, handing the work to a Handler.
+type Kernel struct {
+	transport Transport
+	signer    *Signer
+	handler   Handler
+	session   string
+
+	shell   Socket
+	control Socket
+	iopub   Socket
+	hb      Socket
+
+	// handlerMut serializes calls into handler; pubMut
+	// serializes sends on iopub.
+	handlerMut sync.Mutex
+	pubMut     sync.Mutex
+
+	execCount int
+
+	done     chan struct{}
+	doneOnce sync.Once
+}
+
+// NewKernel prepares a kernel. Call Run to serve.
+func NewKernel(t Transport, signer *Signer, h Handler) (*Kernel, error) {
+	k := &Kernel{
+		transport: t,
+		signer:    signer,
+		handler:   h,
+		session:   newID(),
+		done:      make(chan struct{}),
+	}
+	var err error
+	if k.shell, err = t.Socket(ShellChannel); err != nil {
+		return nil, err
+	}
+	if k.control, err = t.Socket(ControlChannel); err != nil {
+		return nil, err
+	}
+	if k.iopub, err = t.Socket(IOPubChannel); err != nil {
+		return nil, err
+	}
+	if k.hb, err = t.Socket(HeartbeatChannel); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// Run serves requests until a shutdown_request arrives
+// or the transport is closed.
+func (k *Kernel) Run() error {
+	go k.heartbeat()
+	go k.serve(k.control)
+
+	k.publish("status", nil, map[string]interface{}{"execution_state": "starting"})
+	err := k.serve(k.shell)
+	k.transport.Close()
+	return err
+}
+
+// Stop makes Run return.
+func (k *Kernel) Stop() {
+	k.doneOnce.Do(func() { close(k.done) })
+	k.transport.Close()
+}
+
+func (k *Kernel) heartbeat() {
+	for {
+		frames, err := k.hb.Recv()
+		if err != nil {
+			return
+		}
+		k.hb.Send(frames)
+	}
+}
+
+func (k *Kernel) serve(sock Socket) error {
+	for {
+		frames, err := sock.Recv()
+		if err != nil {
+			select {
+			case <-k.done:
+				return nil
+			default:
+			}
+			if err == ErrClosed {
+				return nil
+			}
+			return err
+		}
+		msg, err := k.signer.Decode(frames)
+		if err != nil {
+			// bad signature or garbage: drop it, as ipykernel does.
+			continue
+		}
+		k.dispatch(sock, msg)
+		select {
+		case <-k.done:
+			return nil
+		default:
+		}
+	}
+}
+
+func (k *Kernel) dispatch(sock Socket, req *Message) {
+	k.publish("status", req, map[string]interface{}{"execution_state": "busy"})
+	defer k.publish("status", req, map[string]interface{}{"execution_state": "idle"})
+
+	switch req.Header.MsgType {
+	case "kernel_info_request":
+		info := k.handler.Info()
+		k.reply(sock, req, "kernel_info_reply", map[string]interface{}{
+			"status":                 "ok",
+			"protocol_version":       ProtocolVersion,
+			"implementation":         info.Implementation,
+			"implementation_version": info.ImplementationVersion,
+			"banner":                 info.Banner,
+			"language_info":          info.LanguageInfo,
+			"help_links":             []interface{}{},
+		})
+	case "execute_request":
+		k.execute(sock, req)
+	case "complete_request":
+		var c struct {
+			Code      string `json:"code"`
+			CursorPos int    `json:"cursor_pos"`
+		}
+		req.DecodeContent(&c)
+		k.handlerMut.Lock()
+		matches, start, end := k.handler.Complete(c.Code, RuneToByteOffset(c.Code, c.CursorPos))
+		k.handlerMut.Unlock()
+		if matches == nil {
+			matches = []string{}
+		}
+		k.reply(sock, req, "complete_reply", map[string]interface{}{
+			"status":       "ok",
+			"matches":      matches,
+			"cursor_start": ByteToRuneOffset(c.Code, start),
+			"cursor_end":   ByteToRuneOffset(c.Code, end),
+			"metadata":     map[string]interface{}{},
+		})
+	case "inspect_request":
+		var c struct {
+			Code        string `json:"code"`
+			CursorPos   int    `json:"cursor_pos"`
+			DetailLevel int    `json:"detail_level"`
+		}
+		req.DecodeContent(&c)
+		k.handlerMut.Lock()
+		text, found := k.handler.Inspect(c.Code, RuneToByteOffset(c.Code, c.CursorPos), c.DetailLevel)
+		k.handlerMut.Unlock()
+		data := map[string]interface{}{}
+		if found {
+			data["text/plain"] = text
+		}
+		k.reply(sock, req, "inspect_reply", map[string]interface{}{
+			"status":   "ok",
+			"found":    found,
+			"data":     data,
+			"metadata": map[string]interface{}{},
+		})
+	case "is_complete_request":
+		var c struct {
+			Code string `json:"code"`
+		}
+		req.DecodeContent(&c)
+		k.handlerMut.Lock()
+		status, indent := k.handler.IsComplete(c.Code)
+		k.handlerMut.Unlock()
+		content := map[string]interface{}{"status": status}
+		if status == "incomplete" {
+			content["indent"] = indent
+		}
+		k.reply(sock, req, "is_complete_reply", content)
+	case "history_request":
+		k.reply(sock, req, "history_reply", map[string]interface{}{
+			"status":  "ok",
+			"history": []interface{}{},
+		})
+	case "comm_info_request":
+		k.reply(sock, req, "comm_info_reply", map[string]interface{}{
+			"status": "ok",
+			"comms":  map[string]interface{}{},
+		})
+	case "shutdown_request":
+		var c struct {
+			Restart bool `json:"restart"`
+		}
+		req.DecodeContent(&c)
+		k.reply(sock, req, "shutdown_reply", map[string]interface{}{
+			"status":  "ok",
+			"restart": c.Restart,
+		})
+		k.doneOnce.Do(func() { close(k.done) })
+		// unblock whichever of shell/control isn't us.
+		k.shell.Close()
+		k.control.Close()
+	default:
+		// comm_open, comm_msg etc: we have no comms.
+	}
+}
+
+func (k *Kernel) execute(sock Socket, req *Message) {
+	var c struct {
+		Code         string `json:"code"`
+		Silent       bool   `json:"silent"`
+		StoreHistory bool   `json:"store_history"`
+	}
+	req.DecodeContent(&c)
+
+	k.handlerMut.Lock()
+	if !c.Silent {
+		k.execCount++
+	}
+	count := k.execCount
+	k.handlerMut.Unlock()
+
+	if !c.Silent {
+		k.publish("execute_input", req, map[string]interface{}{
+			"code":            c.Code,
+			"execution_count": count,
+		})
+	}
+	stdout := &streamWriter{k: k, parent: req, name: "stdout", silent: c.Silent}
+	stderr := &streamWriter{k: k, parent: req, name: "stderr", silent: c.Silent}
+
+	k.handlerMut.Lock()
+	result, err := k.handler.Execute(c.Code, stdout, stderr)
+	k.handlerMut.Unlock()
+
+	if err != nil {
+		evalue := err.Error()
+		traceback := strings.Split(evalue, "\n")
+		if !c.Silent {
+			k.publish("error", req, map[string]interface{}{
+				"ename":     "Error",
+				"evalue":    evalue,
+				"traceback": traceback,
+			})
+		}
+		k.reply(sock, req, "execute_reply", map[string]interface{}{
+			"status":          "error",
+			"execution_count": count,
+			"ename":           "Error",
+			"evalue":          evalue,
+			"traceback":       traceback,
+		})
+		return
+	}
+	if result != "" && !c.Silent {
+		k.publish("execute_result", req, map[string]interface{}{
+			"execution_count": count,
+			"data":            map[string]interface{}{"text/plain": result},
+			"metadata":        map[string]interface{}{},
+		})
+	}
+	k.reply(sock, req, "execute_reply", map[string]interface{}{
+		"status":           "ok",
+		"execution_count":  count,
+		"payload":          []interface{}{},
+		"user_expressions": map[string]interface{}{},
+	})
+}
+
+func (k *Kernel) reply(sock Socket, req *Message, msgType string, content interface{}) {
+	m, err := NewMessage(k.session, msgType, req, content)
+	if err != nil {
+		return
+	}
+	frames, err := k.signer.Encode(m)
+	if err != nil {
+		return
+	}
+	sock.Send(frames)
+}
+
+// publish sends on iopub. The topic frame is the message
+// type, which is what ipykernel uses for most messages.
+func (k *Kernel) publish(msgType string, parent *Message, content interface{}) {
+	m, err := NewMessage(k.session, msgType, parent, content)
+	if err != nil {
+		return
+	}
+	m.Identities = [][]byte{[]byte(msgType)}
+	frames, err := k.signer.Encode(m)
+	if err != nil {
+		return
+	}
+	k.pubMut.Lock()
+	k.iopub.Send(frames)
+	k.pubMut.Unlock()
+}
+
+// streamWriter turns each Write into a "stream" message.
+type streamWriter struct {
+	k      *Kernel
+	parent *Message
+	name   string
+	silent bool
+}
+
+func (w *streamWriter) Write(p []byte) (int, error) {
+	if !w.silent && len(p) > 0 {
+		w.k.publish("stream", w.parent, map[string]interface{}{
+			"name": w.name,
+			"text": string(p),
+		})
+	}
+	return len(p), nil
+}
+
+// RuneToByteOffset converts a cursor position counted in
+// unicode code points, as Jupyter sends them, to a byte
+// offset into s.
+func RuneToByteOffset(s string, runes int) int {
+	if runes <= 0 {
+		return 0
+	}
+	i := 0
+	for b := range s {
+		if i == runes {
+			return b
+		}
+		i++
+	}
+	return len(s)
+}
+
+// ByteToRuneOffset is the inverse of RuneToByteOffset.
+func ByteToRuneOffset(s string, b int) int {
+	if b > len(s) {
+		b = len(s)
+	}
+	if b < 0 {
+		b = 0
+	}
+	return utf8.RuneCountInString(s[:b])
+}
+
+// Serve loads the connection file, listens with ZMTP, and
+// runs a Kernel for h until shutdown.
+func Serve(connectionFile string, h Handler) error {
+	info, err := LoadConnectionFile(connectionFile)
+	if err != nil {
+		return err
+	}
+	signer, err := NewSigner(info.Key, info.SignatureScheme)
+	if err != nil {
+		return err
+	}
+	t, err := NewZmtpTransport(info)
+	if err != nil {
+		return err
+	}
+	k, err := NewKernel(t, signer, h)
+	if err != nil {
+		t.Close()
+		return err
+	}
+	err = k.Run()
+	if err != nil {
+		return fmt.Errorf("jupyter kernel: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,227 @@
+package jupyter
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+// echoHandler is a stand-in language: it prints
+// the code to stdout and returns it upper-cased.
+type echoHandler struct{}
+
+func (h *echoHandler) Info() KernelInfo {
+	return KernelInfo{
+		Implementation: "echo",
+		LanguageInfo:   LanguageInfo{Name: "echo"},
+	}
+}
+
+func (h *echoHandler) Execute(code string, stdout, stderr io.Writer) (string, error) {
+	if code == "fail" {
+		return "", fmt.Errorf("failed as asked")
+	}
+	fmt.Fprintf(stdout, "saw: %s", code)
+	return strings.ToUpper(code), nil
+}
+
+func (h *echoHandler) Complete(code string, cursor int) ([]string, int, int) {
+	return []string{"Println", "Printf"}, cursor - 2, cursor
+}
+
+func (h *echoHandler) Inspect(code string, cursor, detail int) (string, bool) {
+	return "doc for " + code, true
+}
+
+func (h *echoHandler) IsComplete(code string) (string, string) {
+	if strings.HasSuffix(code, "{") {
+		return "incomplete", "\t"
+	}
+	return "complete", ""
+}
+
+type testClient struct {
+	t      *LoopbackTransport
+	signer *Signer
+}
+
+func (c *testClient) request(msgType string, content interface{}) *Message {
+	m, err := NewMessage("client-session", msgType, nil, content)
+	panicOn(err)
+	frames, err := c.signer.Encode(m)
+	panicOn(err)
+	panicOn(c.t.Client(ShellChannel).Send(frames))
+	return m
+}
+
+func (c *testClient) recv(ch Channel) *Message {
+	type got struct {
+		frames [][]byte
+		err    error
+	}
+	gotc := make(chan got, 1)
+	go func() {
+		f, err := c.t.Client(ch).Recv()
+		gotc <- got{f, err}
+	}()
+	select {
+	case g := <-gotc:
+		panicOn(g.err)
+		m, err := c.signer.Decode(g.frames)
+		panicOn(err)
+		return m
+	case <-time.After(10 * time.Second):
+		panic("timeout waiting on " + ch.String())
+	}
+}
+
+// iopubUntilIdle collects everything published up to
+// the idle status for req.
+func (c *testClient) iopubUntilIdle(req *Message) (msgs []*Message) {
+	for {
+		m := c.recv(IOPubChannel)
+		if m.ParentHeader.MsgID != req.Header.MsgID {
+			continue
+		}
+		msgs = append(msgs, m)
+		if m.Header.MsgType == "status" {
+			var st struct {
+				State string `json:"execution_state"`
+			}
+			m.DecodeContent(&st)
+			if st.State == "idle" {
+				return
+			}
+		}
+	}
+}
+
+func startTestKernel() (*testClient, *Kernel) {
+	signer, err := NewSigner("secret-key", "hmac-sha256")
+	panicOn(err)
+	lt := NewLoopbackTransport()
+	k, err := NewKernel(lt, signer, &echoHandler{})
+	panicOn(err)
+	go k.Run()
+	return &testClient{t: lt, signer: signer}, k
+}
+
+func Test001KernelInfoAndExecute(t *testing.T) {
+	cv.Convey("a kernel on a loopback transport answers kernel_info and execute requests, streaming output and the result on iopub", t, func() {
+		c, k := startTestKernel()
+		defer k.Stop()
+
+		req := c.request("kernel_info_request", map[string]interface{}{})
+		rep := c.recv(ShellChannel)
+		cv.So(rep.Header.MsgType, cv.ShouldEqual, "kernel_info_reply")
+		cv.So(rep.ParentHeader.MsgID, cv.ShouldEqual, req.Header.MsgID)
+		var info struct {
+			Protocol string       `json:"protocol_version"`
+			Lang     LanguageInfo `json:"language_info"`
+		}
+		panicOn(rep.DecodeContent(&info))
+		cv.So(info.Protocol, cv.ShouldEqual, ProtocolVersion)
+		cv.So(info.Lang.Name, cv.ShouldEqual, "echo")
+
+		req = c.request("execute_request", map[string]interface{}{"code": "hello", "silent": false})
+		rep = c.recv(ShellChannel)
+		cv.So(rep.Header.MsgType, cv.ShouldEqual, "execute_reply")
+		var er struct {
+			Status string `json:"status"`
+			Count  int    `json:"execution_count"`
+		}
+		panicOn(rep.DecodeContent(&er))
+		cv.So(er.Status, cv.ShouldEqual, "ok")
+		cv.So(er.Count, cv.ShouldEqual, 1)
+
+		var types []string
+		var stream, result string
+		for _, m := range c.iopubUntilIdle(req) {
+			types = append(types, m.Header.MsgType)
+			var x struct {
+				Text string            `json:"text"`
+				Data map[string]string `json:"data"`
+			}
+			m.DecodeContent(&x)
+			switch m.Header.MsgType {
+			case "stream":
+				stream += x.Text
+			case "execute_result":
+				result = x.Data["text/plain"]
+			}
+		}
+		cv.So(types, cv.ShouldResemble, []string{"status", "execute_input", "stream", "execute_result", "status"})
+		cv.So(stream, cv.ShouldEqual, "saw: hello")
+		cv.So(result, cv.ShouldEqual, "HELLO")
+
+		req = c.request("execute_request", map[string]interface{}{"code": "fail"})
+		rep = c.recv(ShellChannel)
+		panicOn(rep.DecodeContent(&er))
+		cv.So(er.Status, cv.ShouldEqual, "error")
+		cv.So(er.Count, cv.ShouldEqual, 2)
+	})
+}
+
+func Test002CompleteInspectIsComplete(t *testing.T) {
+	cv.Convey("complete, inspect and is_complete requests reach the handler, and cursor positions are counted in runes", t, func() {
+		c, k := startTestKernel()
+		defer k.Stop()
+
+		// 'é' is two bytes but one rune.
+		c.request("complete_request", map[string]interface{}{"code": "é fmt.Pr", "cursor_pos": 8})
+		rep := c.recv(ShellChannel)
+		var cr struct {
+			Matches []string `json:"matches"`
+			Start   int      `json:"cursor_start"`
+			End     int      `json:"cursor_end"`
+		}
+		panicOn(rep.DecodeContent(&cr))
+		cv.So(cr.Matches, cv.ShouldResemble, []string{"Println", "Printf"})
+		cv.So(cr.Start, cv.ShouldEqual, 6)
+		cv.So(cr.End, cv.ShouldEqual, 8)
+
+		c.request("inspect_request", map[string]interface{}{"code": "x", "cursor_pos": 1})
+		rep = c.recv(ShellChannel)
+		var ir struct {
+			Found bool              `json:"found"`
+			Data  map[string]string `json:"data"`
+		}
+		panicOn(rep.DecodeContent(&ir))
+		cv.So(ir.Found, cv.ShouldBeTrue)
+		cv.So(ir.Data["text/plain"], cv.ShouldEqual, "doc for x")
+
+		c.request("is_complete_request", map[string]interface{}{"code": "func f() {"})
+		rep = c.recv(ShellChannel)
+		var ic struct {
+			Status string `json:"status"`
+			Indent string `json:"indent"`
+		}
+		panicOn(rep.DecodeContent(&ic))
+		cv.So(ic.Status, cv.ShouldEqual, "incomplete")
+		cv.So(ic.Indent, cv.ShouldEqual, "\t")
+	})
+}
+
+func Test003BadSignatureIsDropped(t *testing.T) {
+	cv.Convey("messages signed with the wrong key are ignored", t, func() {
+		c, k := startTestKernel()
+		defer k.Stop()
+
+		wrong, err := NewSigner("not-the-key", "hmac-sha256")
+		panicOn(err)
+		m, err := NewMessage("s", "kernel_info_request", nil, map[string]interface{}{})
+		panicOn(err)
+		frames, err := wrong.Encode(m)
+		panicOn(err)
+		panicOn(c.t.Client(ShellChannel).Send(frames))
+
+		// a good request afterwards is the first to be answered.
+		req := c.request("kernel_info_request", map[string]interface{}{})
+		rep := c.recv(ShellChannel)
+		cv.So(rep.ParentHeader.MsgID, cv.ShouldEqual, req.Header.MsgID)
+	})
+}
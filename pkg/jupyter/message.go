@@ -0,0 +1,200 @@
+package jupyter
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"time"
+)
+
+// ProtocolVersion is the version of the Jupyter
+// messaging protocol that we implement.
+const ProtocolVersion = "5.3"
+
+// delimiter separates the routing identities
+// from the rest of a message on the wire.
+var delimiter = []byte("<IDS|MSG>")
+
+// Header is the header (and parent_header) of every message.
+type Header struct {
+	MsgID    string `json:"msg_id"`
+	Username string `json:"username"`
+	Session  string `json:"session"`
+	Date     string `json:"date"`
+	MsgType  string `json:"msg_type"`
+	Version  string `json:"version"`
+}
+
+// Message is one decoded Jupyter message.
+type Message struct {
+	// Identities are the routing prefixes that preceded
+	// the delimiter. Replies echo them back.
+	Identities [][]byte
+
+	Header       Header
+	ParentHeader Header
+	Metadata     map[string]interface{}
+	Content      json.RawMessage
+	Buffers      [][]byte
+}
+
+// DecodeContent unmarshals the content into v.
+func (m *Message) DecodeContent(v interface{}) error {
+	if len(m.Content) == 0 {
+		return nil
+	}
+	return json.Unmarshal(m.Content, v)
+}
+
+// NewMessage makes a fresh message of type msgType in the
+// given session. If parent is non-nil, the new message is
+// marked as a response to it and is routed back to the
+// parent's identities.
+func NewMessage(session, msgType string, parent *Message, content interface{}) (*Message, error) {
+	by, err := json.Marshal(content)
+	if err != nil {
+		return nil, err
+	}
+	m := &Message{
+		Header: Header{
+			MsgID:    newID(),
+			Username: "gijit",
+			Session:  session,
+			Date:     time.Now().UTC().Format(time.RFC3339Nano),
+			MsgType:  msgType,
+			Version:  ProtocolVersion,
+		},
+		Metadata: map[string]interface{}{},
+		Content:  by,
+	}
+	if parent != nil {
+		m.ParentHeader = parent.Header
+		m.Identities = parent.Identities
+	}
+	return m, nil
+}
+
+// newID returns a random uuid4-style string.
+func newID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(err)
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// Signer computes and checks the HMAC signature
+// carried by every message. A Signer made from an
+// empty key signs with the empty string, per the spec.
+type Signer struct {
+	mac func() hash.Hash
+}
+
+// NewSigner returns a Signer for the connection file's
+// key and signature_scheme.
+func NewSigner(key, scheme string) (*Signer, error) {
+	if key == "" {
+		return &Signer{}, nil
+	}
+	if scheme != "" && scheme != "hmac-sha256" {
+		return nil, fmt.Errorf("unsupported signature scheme '%s'", scheme)
+	}
+	k := []byte(key)
+	return &Signer{mac: func() hash.Hash { return hmac.New(sha256.New, k) }}, nil
+}
+
+func (s *Signer) sign(parts [][]byte) []byte {
+	if s == nil || s.mac == nil {
+		return nil
+	}
+	h := s.mac()
+	for _, p := range parts {
+		h.Write(p)
+	}
+	sum := h.Sum(nil)
+	out := make([]byte, hex.EncodedLen(len(sum)))
+	hex.Encode(out, sum)
+	return out
+}
+
+// Encode serializes and signs m into wire frames.
+func (s *Signer) Encode(m *Message) ([][]byte, error) {
+	header, err := json.Marshal(m.Header)
+	if err != nil {
+		return nil, err
+	}
+	parent := []byte("{}")
+	if m.ParentHeader.MsgID != "" {
+		parent, err = json.Marshal(m.ParentHeader)
+		if err != nil {
+			return nil, err
+		}
+	}
+	meta := m.Metadata
+	if meta == nil {
+		meta = map[string]interface{}{}
+	}
+	metadata, err := json.Marshal(meta)
+	if err != nil {
+		return nil, err
+	}
+	content := []byte(m.Content)
+	if len(content) == 0 {
+		content = []byte("{}")
+	}
+	body := [][]byte{header, parent, metadata, content}
+
+	frames := make([][]byte, 0, len(m.Identities)+6+len(m.Buffers))
+	frames = append(frames, m.Identities...)
+	frames = append(frames, delimiter, s.sign(body))
+	frames = append(frames, body...)
+	frames = append(frames, m.Buffers...)
+	return frames, nil
+}
+
+// Decode verifies the signature on frames and returns
+// the message they carry.
+func (s *Signer) Decode(frames [][]byte) (*Message, error) {
+	i := 0
+	for i < len(frames) && !bytes.Equal(frames[i], delimiter) {
+		i++
+	}
+	if i == len(frames) {
+		return nil, fmt.Errorf("jupyter: no '%s' delimiter in message", delimiter)
+	}
+	if len(frames) < i+6 {
+		return nil, fmt.Errorf("jupyter: short message, %d frames after identities", len(frames)-i)
+	}
+	sig := frames[i+1]
+	body := frames[i+2 : i+6]
+	if s != nil && s.mac != nil {
+		if !hmac.Equal(sig, s.sign(body)) {
+			return nil, fmt.Errorf("jupyter: bad message signature")
+		}
+	}
+	m := &Message{
+		Identities: frames[:i],
+		Content:    json.RawMessage(body[3]),
+		Buffers:    frames[i+6:],
+	}
+	err := json.Unmarshal(body[0], &m.Header)
+	if err != nil {
+		return nil, fmt.Errorf("jupyter: bad header: %v", err)
+	}
+	err = json.Unmarshal(body[1], &m.ParentHeader)
+	if err != nil {
+		return nil, fmt.Errorf("jupyter: bad parent_header: %v", err)
+	}
+	err = json.Unmarshal(body[2], &m.Metadata)
+	if err != nil {
+		return nil, fmt.Errorf("jupyter: bad metadata: %v", err)
+	}
+	return m, nil
+}
@@ -0,0 +1,7 @@
+package jupyter
+
+func panicOn(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,152 @@
+package jupyter
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Channel names the five sockets of a Jupyter kernel.
+type Channel int
+
+const (
+	ShellChannel Channel = iota
+	ControlChannel
+	StdinChannel
+	IOPubChannel
+	HeartbeatChannel
+)
+
+func (c Channel) String() string {
+	switch c {
+	case ShellChannel:
+		return "shell"
+	case ControlChannel:
+		return "control"
+	case StdinChannel:
+		return "stdin"
+	case IOPubChannel:
+		return "iopub"
+	case HeartbeatChannel:
+		return "hb"
+	}
+	return fmt.Sprintf("Channel(%d)", int(c))
+}
+
+// Socket moves multipart messages. Frames received
+// on the shell, control and stdin (router) channels
+// begin with the routing identity of the sender, and
+// frames sent on them must begin with the identity
+// of the recipient. The iopub channel is send-only.
+// On the heartbeat channel, Send replies to whoever
+// sent the last message that Recv returned.
+type Socket interface {
+	Recv() ([][]byte, error)
+	Send(frames [][]byte) error
+	Close() error
+}
+
+// Transport supplies the kernel's sockets. Implementations
+// must allow Send and Recv on the same Socket to be
+// called from different goroutines.
+type Transport interface {
+	Socket(ch Channel) (Socket, error)
+	Close() error
+}
+
+// ErrClosed is returned by Socket methods after Close.
+var ErrClosed = fmt.Errorf("jupyter: socket closed")
+
+/////////////////////////////
+// in-process loopback transport
+/////////////////////////////
+
+// LoopbackTransport joins a Kernel to a stand-in client
+// in the same process, with no network involved. The client
+// end of each channel is available from Client(ch).
+type LoopbackTransport struct {
+	kernel [5]*pipeSocket
+	client [5]*pipeSocket
+}
+
+// LoopbackIdentity is the routing identity that
+// LoopbackTransport stamps on client messages.
+var LoopbackIdentity = []byte("loopback-client")
+
+// NewLoopbackTransport returns a connected Transport.
+func NewLoopbackTransport() *LoopbackTransport {
+	t := &LoopbackTransport{}
+	for ch := ShellChannel; ch <= HeartbeatChannel; ch++ {
+		toKernel := make(chan [][]byte, 100)
+		toClient := make(chan [][]byte, 100)
+		done := make(chan struct{})
+		closer := &sync.Once{}
+		router := ch == ShellChannel || ch == ControlChannel || ch == StdinChannel
+		t.kernel[ch] = &pipeSocket{in: toKernel, out: toClient, done: done, once: closer, stripIdentity: router}
+		t.client[ch] = &pipeSocket{in: toClient, out: toKernel, done: done, once: closer, addIdentity: router}
+	}
+	return t
+}
+
+// Socket returns the kernel end of channel ch.
+func (t *LoopbackTransport) Socket(ch Channel) (Socket, error) {
+	if ch < ShellChannel || ch > HeartbeatChannel {
+		return nil, fmt.Errorf("jupyter: no such channel %v", ch)
+	}
+	return t.kernel[ch], nil
+}
+
+// Client returns the client end of channel ch. Messages
+// sent on it arrive at the kernel as if from a DEALER
+// (or SUB, or REQ) socket with identity LoopbackIdentity.
+func (t *LoopbackTransport) Client(ch Channel) Socket {
+	return t.client[ch]
+}
+
+func (t *LoopbackTransport) Close() error {
+	for _, s := range t.kernel {
+		s.Close()
+	}
+	return nil
+}
+
+type pipeSocket struct {
+	in   chan [][]byte
+	out  chan [][]byte
+	done chan struct{}
+	once *sync.Once
+
+	// the kernel end of a router channel removes the
+	// identity on the way out; the client end adds it on
+	// the way in.
+	stripIdentity bool
+	addIdentity   bool
+}
+
+func (s *pipeSocket) Recv() ([][]byte, error) {
+	select {
+	case m := <-s.in:
+		return m, nil
+	case <-s.done:
+		return nil, ErrClosed
+	}
+}
+
+func (s *pipeSocket) Send(frames [][]byte) error {
+	if s.stripIdentity && len(frames) > 0 {
+		frames = frames[1:]
+	}
+	if s.addIdentity {
+		frames = append([][]byte{LoopbackIdentity}, frames...)
+	}
+	select {
+	case s.out <- frames:
+		return nil
+	case <-s.done:
+		return ErrClosed
+	}
+}
+
+func (s *pipeSocket) Close() error {
+	s.once.Do(func() { close(s.done) })
+	return nil
+}
@@ -0,0 +1,497 @@
+package jupyter
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+)
+
+// A small, dependency free implementation of just
+// enough of ZMTP 3.0 (the ZeroMQ wire protocol, see
+// https://rfc.zeromq.org/spec:23/ZMTP/) with the NULL
+// security mechanism to serve the ROUTER, PUB and REP
+// sockets that a Jupyter kernel binds. Jupyter clients
+// connect with DEALER, SUB and REQ sockets.
+
+const (
+	zmtpFlagMore    = 0x01
+	zmtpFlagLong    = 0x02
+	zmtpFlagCommand = 0x04
+)
+
+// zmtpMaxFrame bounds a frame we read, so a confused
+// peer can't make us allocate without limit.
+const zmtpMaxFrame = 64 << 20
+
+// zmtpPubQueue is how many messages a PUB socket
+// holds for a subscriber that is behind, zmq's
+// default high water mark; past it, the subscriber
+// misses messages, as it would with zmq.
+const zmtpPubQueue = 1000
+
+type zsockKind int
+
+const (
+	zRouter zsockKind = iota
+	zPub
+	zRep
+)
+
+func (k zsockKind) String() string {
+	switch k {
+	case zRouter:
+		return "ROUTER"
+	case zPub:
+		return "PUB"
+	case zRep:
+		return "REP"
+	}
+	return "?"
+}
+
+// NewZmtpTransport listens on the tcp ports named in info.
+func NewZmtpTransport(info *ConnectionInfo) (Transport, error) {
+	t := &zmtpTransport{}
+	for ch := ShellChannel; ch <= HeartbeatChannel; ch++ {
+		kind := zRouter
+		switch ch {
+		case IOPubChannel:
+			kind = zPub
+		case HeartbeatChannel:
+			kind = zRep
+		}
+		s, err := listenZsocket(kind, info.addr(ch))
+		if err != nil {
+			t.Close()
+			return nil, fmt.Errorf("jupyter: %v channel: %v", ch, err)
+		}
+		t.sockets[ch] = s
+	}
+	return t, nil
+}
+
+type zmtpTransport struct {
+	sockets [5]*zsocket
+}
+
+func (t *zmtpTransport) Socket(ch Channel) (Socket, error) {
+	if ch < ShellChannel || ch > HeartbeatChannel {
+		return nil, fmt.Errorf("jupyter: no such channel %v", ch)
+	}
+	return t.sockets[ch], nil
+}
+
+func (t *zmtpTransport) Close() error {
+	for _, s := range t.sockets {
+		if s != nil {
+			s.Close()
+		}
+	}
+	return nil
+}
+
+type zincoming struct {
+	peer   *zpeer
+	frames [][]byte
+}
+
+type zsocket struct {
+	kind zsockKind
+	ln   net.Listener
+
+	mut      sync.Mutex
+	peers    map[string]*zpeer
+	nextAuto uint32
+
+	// rep only: who to answer, and the envelope to answer with.
+	lastPeer     *zpeer
+	lastEnvelope [][]byte
+
+	inbox chan zincoming
+	done  chan struct{}
+	once  sync.Once
+}
+
+type zpeer struct {
+	id   []byte
+	conn net.Conn
+	r    *bufio.Reader
+	wmut sync.Mutex
+
+	// pub only: the messages queued for this
+	// subscriber, and closed when it has gone.
+	outbox chan [][]byte
+	gone   chan struct{}
+}
+
+func listenZsocket(kind zsockKind, addr string) (*zsocket, error) {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	s := &zsocket{
+		kind:     kind,
+		ln:       ln,
+		peers:    make(map[string]*zpeer),
+		nextAuto: 1,
+		inbox:    make(chan zincoming, 100),
+		done:     make(chan struct{}),
+	}
+	go s.acceptLoop()
+	return s, nil
+}
+
+func (s *zsocket) acceptLoop() {
+	for {
+		conn, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		go s.serve(conn)
+	}
+}
+
+func (s *zsocket) serve(conn net.Conn) {
+	p := &zpeer{conn: conn, r: bufio.NewReader(conn)}
+	props, err := p.handshake(s.kind.String())
+	if err != nil {
+		conn.Close()
+		return
+	}
+	s.mut.Lock()
+	p.id = props["Identity"]
+	if len(p.id) == 0 || p.id[0] == 0 {
+		// as libzmq does, generate an identity
+		// starting with a zero byte.
+		p.id = make([]byte, 5)
+		binary.BigEndian.PutUint32(p.id[1:], s.nextAuto)
+		s.nextAuto++
+	}
+	if s.kind == zPub {
+		p.outbox = make(chan [][]byte, zmtpPubQueue)
+		p.gone = make(chan struct{})
+		go p.publish()
+	}
+	s.peers[string(p.id)] = p
+	s.mut.Unlock()
+
+	defer func() {
+		s.mut.Lock()
+		delete(s.peers, string(p.id))
+		s.mut.Unlock()
+		conn.Close()
+		if p.gone != nil {
+			close(p.gone)
+		}
+	}()
+	for {
+		frames, err := p.readMessage()
+		if err != nil {
+			return
+		}
+		if s.kind == zPub {
+			// subscriptions; we publish everything to everyone.
+			continue
+		}
+		select {
+		case s.inbox <- zincoming{peer: p, frames: frames}:
+		case <-s.done:
+			return
+		}
+	}
+}
+
+func (s *zsocket) Recv() ([][]byte, error) {
+	if s.kind == zPub {
+		<-s.done
+		return nil, ErrClosed
+	}
+	select {
+	case in := <-s.inbox:
+		switch s.kind {
+		case zRouter:
+			return append([][]byte{in.peer.id}, in.frames...), nil
+		case zRep:
+			// strip the envelope, through the empty delimiter frame.
+			i := 0
+			for i < len(in.frames) && len(in.frames[i]) > 0 {
+				i++
+			}
+			body := in.frames
+			var env [][]byte
+			if i < len(in.frames) {
+				env = in.frames[:i+1]
+				body = in.frames[i+1:]
+			}
+			s.mut.Lock()
+			s.lastPeer = in.peer
+			s.lastEnvelope = env
+			s.mut.Unlock()
+			return body, nil
+		}
+		return in.frames, nil
+	case <-s.done:
+		return nil, ErrClosed
+	}
+}
+
+func (s *zsocket) Send(frames [][]byte) error {
+	select {
+	case <-s.done:
+		return ErrClosed
+	default:
+	}
+	switch s.kind {
+	case zRouter:
+		if len(frames) < 2 {
+			return fmt.Errorf("jupyter: router send needs an identity frame and a body")
+		}
+		s.mut.Lock()
+		p := s.peers[string(frames[0])]
+		s.mut.Unlock()
+		if p == nil {
+			// like zmq, silently drop messages to unknown peers.
+			return nil
+		}
+		return p.writeMessage(frames[1:])
+	case zPub:
+		s.mut.Lock()
+		peers := make([]*zpeer, 0, len(s.peers))
+		for _, p := range s.peers {
+			peers = append(peers, p)
+		}
+		s.mut.Unlock()
+		for _, p := range peers {
+			// a slow or dead subscriber must not stop the
+			// others: each has its own queue and writer,
+			// and a full queue drops the message.
+			select {
+			case p.outbox <- frames:
+			default:
+			}
+		}
+		return nil
+	case zRep:
+		s.mut.Lock()
+		p := s.lastPeer
+		env := s.lastEnvelope
+		s.lastPeer = nil
+		s.mut.Unlock()
+		if p == nil {
+			return fmt.Errorf("jupyter: rep send without a request")
+		}
+		return p.writeMessage(append(append([][]byte{}, env...), frames...))
+	}
+	return nil
+}
+
+// publish writes the messages queued for the
+// subscriber p, until it goes away.
+func (p *zpeer) publish() {
+	for {
+		select {
+		case frames := <-p.outbox:
+			if p.writeMessage(frames) != nil {
+				p.conn.Close()
+				return
+			}
+		case <-p.gone:
+			return
+		}
+	}
+}
+
+func (s *zsocket) Close() error {
+	s.once.Do(func() {
+		close(s.done)
+		s.ln.Close()
+		s.mut.Lock()
+		for _, p := range s.peers {
+			p.conn.Close()
+		}
+		s.mut.Unlock()
+	})
+	return nil
+}
+
+// handshake exchanges greetings and READY commands,
+// returning the peer's metadata properties.
+func (p *zpeer) handshake(socketType string) (map[string][]byte, error) {
+	var greet [64]byte
+	greet[0] = 0xff
+	greet[9] = 0x7f
+	greet[10] = 3 // major version
+	greet[11] = 0 // minor version
+	copy(greet[12:32], "NULL")
+	greet[32] = 1 // as-server
+	_, err := p.conn.Write(greet[:])
+	if err != nil {
+		return nil, err
+	}
+	var theirs [64]byte
+	_, err = io.ReadFull(p.r, theirs[:])
+	if err != nil {
+		return nil, err
+	}
+	if theirs[0] != 0xff || theirs[9]&1 != 1 {
+		return nil, fmt.Errorf("zmtp: bad greeting signature")
+	}
+	if theirs[10] < 3 {
+		return nil, fmt.Errorf("zmtp: peer speaks ZMTP %d.%d, need 3.0 or later", theirs[10], theirs[11])
+	}
+	if mech := string(bytes.TrimRight(theirs[12:32], "\x00")); mech != "NULL" {
+		return nil, fmt.Errorf("zmtp: unsupported security mechanism '%s'", mech)
+	}
+
+	var ready bytes.Buffer
+	ready.WriteByte(5)
+	ready.WriteString("READY")
+	writeZmtpProperty(&ready, "Socket-Type", []byte(socketType))
+	err = p.writeFrame(ready.Bytes(), zmtpFlagCommand)
+	if err != nil {
+		return nil, err
+	}
+
+	flags, body, err := p.readFrame()
+	if err != nil {
+		return nil, err
+	}
+	if flags&zmtpFlagCommand == 0 || len(body) < 6 || string(body[1:6]) != "READY" {
+		return nil, fmt.Errorf("zmtp: expected READY command")
+	}
+	return parseZmtpProperties(body[6:])
+}
+
+func writeZmtpProperty(w *bytes.Buffer, name string, value []byte) {
+	w.WriteByte(byte(len(name)))
+	w.WriteString(name)
+	var n [4]byte
+	binary.BigEndian.PutUint32(n[:], uint32(len(value)))
+	w.Write(n[:])
+	w.Write(value)
+}
+
+func parseZmtpProperties(b []byte) (map[string][]byte, error) {
+	props := make(map[string][]byte)
+	for len(b) > 0 {
+		n := int(b[0])
+		if len(b) < 1+n+4 {
+			return nil, fmt.Errorf("zmtp: truncated metadata")
+		}
+		name := string(b[1 : 1+n])
+		b = b[1+n:]
+		vn := int(binary.BigEndian.Uint32(b[:4]))
+		b = b[4:]
+		if len(b) < vn {
+			return nil, fmt.Errorf("zmtp: truncated metadata value")
+		}
+		props[name] = b[:vn]
+		b = b[vn:]
+	}
+	return props, nil
+}
+
+func (p *zpeer) readFrame() (flags byte, body []byte, err error) {
+	flags, err = p.r.ReadByte()
+	if err != nil {
+		return
+	}
+	var size uint64
+	if flags&zmtpFlagLong != 0 {
+		var n [8]byte
+		_, err = io.ReadFull(p.r, n[:])
+		if err != nil {
+			return
+		}
+		size = binary.BigEndian.Uint64(n[:])
+	} else {
+		var n byte
+		n, err = p.r.ReadByte()
+		if err != nil {
+			return
+		}
+		size = uint64(n)
+	}
+	if size > zmtpMaxFrame {
+		err = fmt.Errorf("zmtp: frame of %v bytes is too big", size)
+		return
+	}
+	body = make([]byte, size)
+	_, err = io.ReadFull(p.r, body)
+	return
+}
+
+// readMessage returns the next multipart message,
+// skipping any commands (PING, SUBSCRIBE, ...) on the way.
+func (p *zpeer) readMessage() ([][]byte, error) {
+	var frames [][]byte
+	for {
+		flags, body, err := p.readFrame()
+		if err != nil {
+			return nil, err
+		}
+		if flags&zmtpFlagCommand != 0 {
+			if len(body) > 5 && string(body[1:5]) == "PING" {
+				p.answerPing(body)
+			}
+			continue
+		}
+		frames = append(frames, body)
+		if flags&zmtpFlagMore == 0 {
+			return frames, nil
+		}
+	}
+}
+
+// answerPing replies to a ZMTP 3.1 heartbeat with a PONG
+// carrying the ping's context.
+func (p *zpeer) answerPing(ping []byte) {
+	var pong bytes.Buffer
+	pong.WriteByte(4)
+	pong.WriteString("PONG")
+	if len(ping) > 7 {
+		pong.Write(ping[7:])
+	}
+	p.wmut.Lock()
+	p.writeFrame(pong.Bytes(), zmtpFlagCommand)
+	p.wmut.Unlock()
+}
+
+func (p *zpeer) writeFrame(body []byte, flags byte) error {
+	var hdr [9]byte
+	n := 2
+	if len(body) > 255 {
+		flags |= zmtpFlagLong
+		binary.BigEndian.PutUint64(hdr[1:], uint64(len(body)))
+		n = 9
+	} else {
+		hdr[1] = byte(len(body))
+	}
+	hdr[0] = flags
+	_, err := p.conn.Write(hdr[:n])
+	if err != nil {
+		return err
+	}
+	_, err = p.conn.Write(body)
+	return err
+}
+
+func (p *zpeer) writeMessage(frames [][]byte) error {
+	p.wmut.Lock()
+	defer p.wmut.Unlock()
+	for i, f := range frames {
+		var flags byte
+		if i < len(frames)-1 {
+			flags = zmtpFlagMore
+		}
+		err := p.writeFrame(f, flags)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package jupyter
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+// dialZmtp connects to a zsocket as a zmq client would.
+func dialZmtp(addr, socketType, identity string) *zpeer {
+	conn, err := net.Dial("tcp", addr)
+	panicOn(err)
+	p := &zpeer{conn: conn, r: bufio.NewReader(conn)}
+	if identity == "" {
+		_, err = p.handshake(socketType)
+		panicOn(err)
+		return p
+	}
+	// a handshake that also sends our Identity.
+	var greet [64]byte
+	greet[0] = 0xff
+	greet[9] = 0x7f
+	greet[10] = 3
+	copy(greet[12:32], "NULL")
+	_, err = conn.Write(greet[:])
+	panicOn(err)
+	var theirs [64]byte
+	_, err = io.ReadFull(p.r, theirs[:])
+	panicOn(err)
+	var ready bytes.Buffer
+	ready.WriteByte(5)
+	ready.WriteString("READY")
+	writeZmtpProperty(&ready, "Socket-Type", []byte(socketType))
+	writeZmtpProperty(&ready, "Identity", []byte(identity))
+	panicOn(p.writeFrame(ready.Bytes(), zmtpFlagCommand))
+	_, _, err = p.readFrame()
+	panicOn(err)
+	return p
+}
+
+func Test010ZmtpRouterAndRep(t *testing.T) {
+	cv.Convey("the ZMTP router socket tags incoming messages with the sender's identity and routes replies back by it; the rep socket echoes with the envelope restored", t, func() {
+		router, err := listenZsocket(zRouter, "127.0.0.1:0")
+		panicOn(err)
+		defer router.Close()
+
+		dealer := dialZmtp(router.ln.Addr().String(), "DEALER", "client-7")
+		defer dealer.conn.Close()
+
+		long := bytes.Repeat([]byte("x"), 300) // forces a long frame
+		panicOn(dealer.writeMessage([][]byte{[]byte("hello"), long}))
+
+		got, err := router.Recv()
+		panicOn(err)
+		cv.So(len(got), cv.ShouldEqual, 3)
+		cv.So(string(got[0]), cv.ShouldEqual, "client-7")
+		cv.So(string(got[1]), cv.ShouldEqual, "hello")
+		cv.So(got[2], cv.ShouldResemble, long)
+
+		panicOn(router.Send([][]byte{got[0], []byte("world")}))
+		back, err := dealer.readMessage()
+		panicOn(err)
+		cv.So(len(back), cv.ShouldEqual, 1)
+		cv.So(string(back[0]), cv.ShouldEqual, "world")
+
+		rep, err := listenZsocket(zRep, "127.0.0.1:0")
+		panicOn(err)
+		defer rep.Close()
+		req := dialZmtp(rep.ln.Addr().String(), "REQ", "")
+		defer req.conn.Close()
+
+		panicOn(req.writeMessage([][]byte{{}, []byte("ping")}))
+		body, err := rep.Recv()
+		panicOn(err)
+		cv.So(len(body), cv.ShouldEqual, 1)
+		cv.So(string(body[0]), cv.ShouldEqual, "ping")
+		panicOn(rep.Send(body))
+		echo, err := req.readMessage()
+		panicOn(err)
+		cv.So(len(echo), cv.ShouldEqual, 2)
+		cv.So(len(echo[0]), cv.ShouldEqual, 0)
+		cv.So(string(echo[1]), cv.ShouldEqual, "ping")
+	})
+}
+
+func Test011ZmtpBoundsFramesAndSlowSubscribers(t *testing.T) {
+	cv.Convey("a frame longer than zmtpMaxFrame is refused before it is allocated, and a subscriber that doesn't read holds up neither Send nor the other subscribers", t, func() {
+		router, err := listenZsocket(zRouter, "127.0.0.1:0")
+		panicOn(err)
+		defer router.Close()
+
+		// a long frame claiming an exabyte.
+		dealer := dialZmtp(router.ln.Addr().String(), "DEALER", "")
+		defer dealer.conn.Close()
+		var hdr [9]byte
+		hdr[0] = zmtpFlagLong
+		binary.BigEndian.PutUint64(hdr[1:], 1<<60)
+		_, err = dealer.conn.Write(hdr[:])
+		panicOn(err)
+		_, _, err = dealer.readFrame()
+		cv.So(err, cv.ShouldNotBeNil)
+
+		p := &zpeer{r: bufio.NewReader(bytes.NewReader(hdr[:]))}
+		_, _, err = p.readFrame()
+		cv.So(err.Error(), cv.ShouldContainSubstring, "too big")
+
+		pub, err := listenZsocket(zPub, "127.0.0.1:0")
+		panicOn(err)
+		defer pub.Close()
+		stuck := dialZmtp(pub.ln.Addr().String(), "SUB", "")
+		defer stuck.conn.Close()
+		reader := dialZmtp(pub.ln.Addr().String(), "SUB", "")
+		defer reader.conn.Close()
+		for {
+			pub.mut.Lock()
+			n := len(pub.peers)
+			pub.mut.Unlock()
+			if n == 2 {
+				break
+			}
+			time.Sleep(time.Millisecond)
+		}
+
+		// far more than the socket buffers hold, for
+		// the subscriber that never reads.
+		const msgs = 200
+		big := bytes.Repeat([]byte("y"), 64<<10)
+		sent := make(chan bool)
+		go func() {
+			for i := 0; i < msgs; i++ {
+				panicOn(pub.Send([][]byte{[]byte("topic"), big}))
+			}
+			sent <- true
+		}()
+		got := 0
+		for got < msgs {
+			m, err := reader.readMessage()
+			panicOn(err)
+			cv.So(m[1], cv.ShouldResemble, big)
+			got++
+		}
+		select {
+		case <-sent:
+		case <-time.After(10 * time.Second):
+			t.Fatal("Send blocked on the stuck subscriber")
+		}
+		cv.So(got, cv.ShouldEqual, msgs)
+	})
+}
@@ -1,7 +1,7 @@
 /*
 package muse provides for type
 punning (converting) from types.Type
-to reflect.Type.
+to reflect.Type, and back.
 */
 package muse
 
@@ -21,8 +21,14 @@ var pp = verb.PP
 // convert from types.Type to reflect.Type, so
 // that we can wrap Go slices/arrays with Lua
 // proxies from the very start of their creation.
+// Unpun goes the other way.
 
-type Muse struct{}
+type Muse struct {
+	// for Unpun: the named types made so far, and
+	// the stand-in packages that hold them.
+	named map[reflect.Type]*types.Named
+	pkgs  map[string]*types.Package
+}
 
 func NewMuse() *Muse { return &Muse{} }
 
@@ -15,8 +15,6 @@ import (
 	cv "github.com/glycerine/goconvey/convey"
 )
 
-var pp = verb.PP
-
 func init() {
 	verb.Verbose = true
 	verb.VerboseVerbose = true
@@ -0,0 +1,277 @@
+package muse
+
+import (
+	"fmt"
+	"path"
+	"reflect"
+
+	"github.com/gijit/gi/pkg/token"
+	"github.com/gijit/gi/pkg/types"
+)
+
+// Unpun is the reverse of Pun: it converts a
+// reflect.Type to the types.Type that the type
+// checker would have made from the Go declaration.
+// Named types, with their exported methods, are
+// made once per Muse and then reused, so that
+// converting the same reflect.Type twice gives
+// identical types. They live in stand-in packages
+// with the reflect.Type's PkgPath, so they are
+// distinct from any made by importing that package.
+func (m *Muse) Unpun(rt reflect.Type) (tt types.Type, err error) {
+	if rt == nil {
+		return nil, fmt.Errorf("muse.Unpun: nil reflect.Type")
+	}
+	if rt.Name() == "" {
+		return m.unpunUnnamed(rt)
+	}
+	if rt.PkgPath() == "" {
+		// predeclared: bool, int, string, error, ...
+		obj := types.Universe.Lookup(rt.Name())
+		if obj == nil {
+			return nil, fmt.Errorf("muse.Unpun: no predeclared type '%s'", rt.Name())
+		}
+		return obj.Type(), nil
+	}
+	if nt, ok := m.named[rt]; ok {
+		return nt, nil
+	}
+	if m.named == nil {
+		m.named = make(map[reflect.Type]*types.Named)
+	}
+
+	pkg := m.pkgFor(rt.PkgPath())
+	tn := types.NewTypeName(token.NoPos, pkg, rt.Name(), nil)
+	nt := types.NewNamed(tn, nil, nil)
+	pkg.Scope().Replace(tn)
+
+	// register before converting the underlying type and
+	// the methods, which may refer back to rt.
+	m.named[rt] = nt
+
+	under, err := m.unpunUnnamed(rt)
+	if err != nil {
+		m.forget(rt, tn)
+		return nil, err
+	}
+	nt.SetUnderlying(under.Underlying())
+
+	if rt.Kind() == reflect.Interface {
+		// the methods are those of the underlying interface.
+		return nt, nil
+	}
+
+	// value receiver methods are in rt's method set,
+	// and pointer receiver methods only in *rt's.
+	for i := 0; i < rt.NumMethod(); i++ {
+		meth, err := m.unpunMethod(pkg, nt, rt.Method(i))
+		if err != nil {
+			m.forget(rt, tn)
+			return nil, err
+		}
+		nt.AddMethod(meth)
+	}
+	ptr := reflect.PtrTo(rt)
+	for i := 0; i < ptr.NumMethod(); i++ {
+		rm := ptr.Method(i)
+		if _, ok := rt.MethodByName(rm.Name); ok {
+			continue
+		}
+		meth, err := m.unpunMethod(pkg, types.NewPointer(nt), rm)
+		if err != nil {
+			m.forget(rt, tn)
+			return nil, err
+		}
+		nt.AddMethod(meth)
+	}
+	return nt, nil
+}
+
+// unpunUnnamed converts rt by its kind, ignoring
+// any name it has.
+func (m *Muse) unpunUnnamed(rt reflect.Type) (types.Type, error) {
+	switch rt.Kind() {
+	case reflect.Bool:
+		return types.Typ[types.Bool], nil
+	case reflect.Int:
+		return types.Typ[types.Int], nil
+	case reflect.Int8:
+		return types.Typ[types.Int8], nil
+	case reflect.Int16:
+		return types.Typ[types.Int16], nil
+	case reflect.Int32:
+		return types.Typ[types.Int32], nil
+	case reflect.Int64:
+		return types.Typ[types.Int64], nil
+	case reflect.Uint:
+		return types.Typ[types.Uint], nil
+	case reflect.Uint8:
+		return types.Typ[types.Uint8], nil
+	case reflect.Uint16:
+		return types.Typ[types.Uint16], nil
+	case reflect.Uint32:
+		return types.Typ[types.Uint32], nil
+	case reflect.Uint64:
+		return types.Typ[types.Uint64], nil
+	case reflect.Uintptr:
+		return types.Typ[types.Uintptr], nil
+	case reflect.Float32:
+		return types.Typ[types.Float32], nil
+	case reflect.Float64:
+		return types.Typ[types.Float64], nil
+	case reflect.Complex64:
+		return types.Typ[types.Complex64], nil
+	case reflect.Complex128:
+		return types.Typ[types.Complex128], nil
+	case reflect.String:
+		return types.Typ[types.String], nil
+	case reflect.UnsafePointer:
+		return types.Typ[types.UnsafePointer], nil
+
+	case reflect.Ptr:
+		et, err := m.Unpun(rt.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return types.NewPointer(et), nil
+	case reflect.Array:
+		et, err := m.Unpun(rt.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return types.NewArray(et, int64(rt.Len())), nil
+	case reflect.Slice:
+		et, err := m.Unpun(rt.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return types.NewSlice(et), nil
+	case reflect.Map:
+		kt, err := m.Unpun(rt.Key())
+		if err != nil {
+			return nil, err
+		}
+		et, err := m.Unpun(rt.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return types.NewMap(kt, et), nil
+	case reflect.Chan:
+		var dir types.ChanDir
+		switch rt.ChanDir() {
+		case reflect.BothDir:
+			dir = types.SendRecv
+		case reflect.SendDir:
+			dir = types.SendOnly
+		case reflect.RecvDir:
+			dir = types.RecvOnly
+		}
+		et, err := m.Unpun(rt.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return types.NewChan(dir, et), nil
+	case reflect.Func:
+		return m.unpunSignature(nil, rt, 0)
+	case reflect.Struct:
+		nf := rt.NumField()
+		fields := make([]*types.Var, nf)
+		tags := make([]string, nf)
+		for i := 0; i < nf; i++ {
+			f := rt.Field(i)
+			ft, err := m.Unpun(f.Type)
+			if err != nil {
+				return nil, err
+			}
+			fields[i] = types.NewField(token.NoPos, m.pkgFor(f.PkgPath), f.Name, ft, f.Anonymous)
+			tags[i] = string(f.Tag)
+		}
+		return types.NewStruct(fields, tags), nil
+	case reflect.Interface:
+		var methods []*types.Func
+		for i := 0; i < rt.NumMethod(); i++ {
+			rm := rt.Method(i)
+			sig, err := m.unpunSignature(nil, rm.Type, 0)
+			if err != nil {
+				return nil, err
+			}
+			methods = append(methods, types.NewFunc(token.NoPos, m.pkgFor(rm.PkgPath), rm.Name, sig))
+		}
+		return types.NewInterface(methods, nil).Complete(), nil
+	}
+	return nil, fmt.Errorf("muse.Unpun: unimplemented kind '%v' of type '%v'", rt.Kind(), rt)
+}
+
+func (m *Muse) forget(rt reflect.Type, tn *types.TypeName) {
+	delete(m.named, rt)
+	tn.Pkg().Scope().DeleteByName(tn.Name())
+}
+
+// NamedTypes returns the named types Unpun has made,
+// keyed by the reflect.Type each came from.
+func (m *Muse) NamedTypes() map[reflect.Type]*types.Named {
+	r := make(map[reflect.Type]*types.Named, len(m.named))
+	for rt, nt := range m.named {
+		r[rt] = nt
+	}
+	return r
+}
+
+// Package gives the stand-in package for path, where
+// Unpun declares the named types from path, so that
+// other objects may be declared alongside them.
+func (m *Muse) Package(path string) *types.Package {
+	return m.pkgFor(path)
+}
+
+// pkgFor gives the stand-in package for path; an
+// exported field or method has no package, and so
+// gets nil.
+func (m *Muse) pkgFor(pth string) *types.Package {
+	if pth == "" {
+		return nil
+	}
+	if m.pkgs == nil {
+		m.pkgs = make(map[string]*types.Package)
+	}
+	pkg := m.pkgs[pth]
+	if pkg == nil {
+		pkg = types.NewPackage(pth, path.Base(pth))
+		pkg.MarkComplete()
+		m.pkgs[pth] = pkg
+	}
+	return pkg
+}
+
+// unpunMethod converts a method from a named type's
+// reflect method set, whose func type has the
+// receiver as its first parameter.
+func (m *Muse) unpunMethod(pkg *types.Package, recvType types.Type, rm reflect.Method) (*types.Func, error) {
+	recv := types.NewVar(token.NoPos, pkg, "", recvType)
+	sig, err := m.unpunSignature(recv, rm.Type, 1)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewFunc(token.NoPos, pkg, rm.Name, sig), nil
+}
+
+// unpunSignature converts the func type rt, skipping
+// its first skip parameters.
+func (m *Muse) unpunSignature(recv *types.Var, rt reflect.Type, skip int) (*types.Signature, error) {
+	var params, results []*types.Var
+	for i := skip; i < rt.NumIn(); i++ {
+		pt, err := m.Unpun(rt.In(i))
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, types.NewParam(token.NoPos, nil, "", pt))
+	}
+	for i := 0; i < rt.NumOut(); i++ {
+		ot, err := m.Unpun(rt.Out(i))
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, types.NewParam(token.NoPos, nil, "", ot))
+	}
+	return types.NewSignature(recv, types.NewTuple(params...), types.NewTuple(results...), rt.IsVariadic()), nil
+}
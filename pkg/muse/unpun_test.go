@@ -0,0 +1,87 @@
+package muse
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gijit/gi/pkg/types"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+type unpunPoint struct {
+	X, Y float64
+	next *unpunPoint
+}
+
+func (p unpunPoint) Name() string           { return "point" }
+func (p unpunPoint) Sum() float64           { return p.X + p.Y }
+func (p *unpunPoint) Scale(k float64) error { return nil }
+
+type unpunNamer interface {
+	Name() string
+}
+
+func Test004UnpunReflectToTypes(t *testing.T) {
+
+	cv.Convey(`muse.Unpun() should convert reflect.Types back to types.Types`, t, func() {
+		m := NewMuse()
+
+		tt, err := m.Unpun(reflect.TypeOf(map[string][]int64{}))
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(tt.String(), cv.ShouldEqual, "map[string][]int64")
+
+		tt, err = m.Unpun(reflect.TypeOf(func(string, ...int) (bool, error) { return false, nil }))
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(tt.String(), cv.ShouldEqual, "func(string, ...int) (bool, error)")
+
+		tt, err = m.Unpun(reflect.TypeOf(make(<-chan [2]byte)))
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(tt.String(), cv.ShouldEqual, "<-chan [2]uint8")
+
+		tt, err = m.Unpun(reflect.TypeOf((*interface{})(nil)).Elem())
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(tt.String(), cv.ShouldEqual, "interface{}")
+	})
+
+	cv.Convey(`muse.Unpun() should convert a named struct with its fields and methods, once`, t, func() {
+		m := NewMuse()
+
+		tt, err := m.Unpun(reflect.TypeOf(&unpunPoint{}))
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(tt.String(), cv.ShouldEqual, "*github.com/gijit/gi/pkg/muse.unpunPoint")
+
+		named := tt.(*types.Pointer).Elem().(*types.Named)
+		st := named.Underlying().(*types.Struct)
+		cv.So(st.NumFields(), cv.ShouldEqual, 3)
+		cv.So(st.Field(2).Name(), cv.ShouldEqual, "next")
+		cv.So(types.Identical(st.Field(2).Type(), tt), cv.ShouldBeTrue)
+
+		cv.So(named.NumMethods(), cv.ShouldEqual, 3)
+		sum := named.Method(1)
+		cv.So(sum.Name(), cv.ShouldEqual, "Sum")
+		cv.So(sum.Type().String(), cv.ShouldEqual, "func() float64")
+		scale := named.Method(2)
+		cv.So(scale.Name(), cv.ShouldEqual, "Scale")
+		_, ptrRecv := scale.Type().(*types.Signature).Recv().Type().(*types.Pointer)
+		cv.So(ptrRecv, cv.ShouldBeTrue)
+
+		again, err := m.Unpun(reflect.TypeOf(unpunPoint{}))
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(again, cv.ShouldEqual, named)
+	})
+
+	cv.Convey(`muse.Unpun() should convert a named interface, which the named struct can implement`, t, func() {
+		m := NewMuse()
+
+		tt, err := m.Unpun(reflect.TypeOf((*unpunNamer)(nil)).Elem())
+		cv.So(err, cv.ShouldBeNil)
+		iface := tt.Underlying().(*types.Interface)
+		cv.So(iface.NumMethods(), cv.ShouldEqual, 1)
+		cv.So(iface.Method(0).Name(), cv.ShouldEqual, "Name")
+
+		pt, err := m.Unpun(reflect.TypeOf(&unpunPoint{}))
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(types.Implements(pt, iface), cv.ShouldBeTrue)
+	})
+}
@@ -79,5 +79,10 @@ func Eval(fset *token.FileSet, pkg *Package, pos token.Pos, expr string) (TypeAn
 	// evaluate node
 	var x operand
 	check.rawExpr(&x, node, nil)
+	if x.mode != constant_ {
+		// x.val may be left over from a constant operand
+		// of the expression, as in f(3).
+		x.val = nil
+	}
 	return TypeAndValue{x.mode, x.typ, x.val}, err
 }
@@ -44,21 +44,9 @@ func (v *Scope) GobDecode([]byte) error {
 	return nil
 }
 
-// jea debug
-var funcScopesMade int
-
 // NewScope returns a new, empty scope contained in the given parent
 // scope, if any. The comment is for debugging only.
 func NewScope(parent *Scope, pos, end token.Pos, comment, methodName string) (sc *Scope) {
-	// jea debug
-	if comment == "function" {
-		funcScopesMade++
-		pp("funcScopesMade=%v", funcScopesMade)
-		if funcScopesMade == 2 {
-			//panic("where second?")
-		}
-	}
-
 	s := &Scope{parent, nil, nil, pos, end, comment, false, methodName}
 	// don't add children to Universe scope!
 	if parent != nil && parent != Universe {